@@ -10,9 +10,12 @@ import (
 )
 
 var tensorfsCommands = map[string]func(mp *mathParse) error{
-	"cd":    cd,
-	"mkdir": mkdir,
-	"ls":    ls,
+	"cd":       cd,
+	"mkdir":    mkdir,
+	"ls":       ls,
+	"snapshot": snapshot,
+	"restore":  restore,
+	"history":  history,
 }
 
 func cd(mp *mathParse) error {
@@ -48,3 +51,35 @@ func ls(mp *mathParse) error {
 	mp.out.Add(token.RPAREN)
 	return nil
 }
+
+func snapshot(mp *mathParse) error {
+	if len(mp.ewords) == 1 {
+		return errors.New("tensorfs snapshot requires a snapshot name")
+	}
+	mp.out.Add(token.IDENT, "tensorfs.TakeSnapshot")
+	mp.out.Add(token.LPAREN)
+	mp.out.Add(token.STRING, `"`+mp.ewords[1]+`"`)
+	mp.out.Add(token.RPAREN)
+	return nil
+}
+
+func restore(mp *mathParse) error {
+	if len(mp.ewords) == 1 {
+		return errors.New("tensorfs restore requires a snapshot name")
+	}
+	mp.out.Add(token.IDENT, "tensorfs.RestoreSnapshot")
+	mp.out.Add(token.LPAREN)
+	mp.out.Add(token.STRING, `"`+mp.ewords[1]+`"`)
+	mp.out.Add(token.RPAREN)
+	return nil
+}
+
+func history(mp *mathParse) error {
+	mp.out.Add(token.IDENT, "tensorfs.History")
+	mp.out.Add(token.LPAREN)
+	if len(mp.ewords) > 1 {
+		mp.out.Add(token.STRING, `"`+mp.ewords[1]+`"`)
+	}
+	mp.out.Add(token.RPAREN)
+	return nil
+}
@@ -332,6 +332,10 @@ func TestMath(t *testing.T) {
 		{`# x := get("item")`, `x := tensor.Tensor(tensorfs.Get("item"))`},
 		{`# set("item", x)`, `tensorfs.Set("item", x)`},
 		{`# set("item", 5)`, `tensorfs.Set("item", tensor.NewIntScalar(5))`},
+		{`# snapshot run1`, `tensorfs.TakeSnapshot("run1")`},
+		{`# restore run1`, `tensorfs.RestoreSnapshot("run1")`},
+		{`# history`, `tensorfs.History()`},
+		{`# history stats/x`, `tensorfs.History("stats/x")`},
 		{`fmt.Println(#zeros(3,4)#)`, `fmt.Println(tensor.NewFloat64(3, 4))`},
 		{"# totalTime := 100", `totalTime := tensor.Tensor(tensor.NewIntScalar(100))`},
 		{"# driver := zeros(100)", `driver := tensor.Tensor(tensor.NewFloat64(100))`},
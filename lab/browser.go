@@ -24,6 +24,8 @@ import (
 	"cogentcore.org/core/styles"
 	"cogentcore.org/core/tree"
 	"cogentcore.org/lab/goal/goalib"
+	"cogentcore.org/lab/plotcore"
+	"cogentcore.org/lab/tensorfs"
 	"golang.org/x/exp/maps"
 )
 
@@ -83,14 +85,22 @@ type Browser struct { //types:add -setters
 
 	// Splits is the overall [core.Splits] for the browser.
 	Splits *core.Splits
+
+	// watched is the tensorfs FS currently being watched for changes.
+	watched *tensorfs.Node
+
+	// unwatch cancels the watch on the watched FS.
+	unwatch func()
 }
 
-// UpdateFiles Updates the files list.
+// UpdateFiles Updates the files list. If the FS is a [tensorfs.Node],
+// the files list is automatically updated when nodes are added or removed.
 func (br *Browser) UpdateFiles() { //types:add
 	if br.Files == nil {
 		return
 	}
 	files := br.Files
+	br.watchFS()
 	if br.FS != nil {
 		files.SortByModTime = true
 		files.OpenPathFS(br.FS, br.DataRoot)
@@ -99,6 +109,37 @@ func (br *Browser) UpdateFiles() { //types:add
 	}
 }
 
+// watchFS watches the FS for nodes being added or removed if it is
+// a [tensorfs.Node], cancelling any watch on a previous FS.
+func (br *Browser) watchFS() {
+	dfs, _ := br.FS.(*tensorfs.Node)
+	if dfs == br.watched {
+		return
+	}
+	if br.unwatch != nil {
+		br.unwatch()
+		br.unwatch = nil
+	}
+	br.watched = dfs
+	if dfs == nil {
+		return
+	}
+	update := tensorfs.Throttle(plotcore.WatchInterval, func() {
+		files := br.Files
+		if files == nil || files.This == nil {
+			return
+		}
+		files.AsyncLock()
+		br.UpdateFiles()
+		files.AsyncUnlock()
+	})
+	br.unwatch = dfs.Watch(func(ev tensorfs.Event) {
+		if ev.Type == tensorfs.EventAdded || ev.Type == tensorfs.EventRemoved {
+			update(ev)
+		}
+	})
+}
+
 // UpdateScripts updates the Scripts and updates the toolbar.
 func (br *Browser) UpdateScripts() { //types:add
 	redo := (br.Scripts != nil)
@@ -148,39 +148,43 @@ func (fn *FileNode) OpenFile() error {
 	case fn.Info.Cat == fileinfo.Data:
 		switch fn.Info.Known {
 		case fileinfo.Tensor:
-			d := TensorFS(ofn)
-			ts.AsLab().TensorEditor(df, d.Tensor)
+			tsr := TensorFS(ofn).TensorValue()
+			if tsr == nil {
+				core.MessageSnackbar(fn, "No data in tensor")
+				break
+			}
+			ts.AsLab().TensorEditor(df, tsr)
 		case fileinfo.Number:
-			dv := TensorFS(ofn)
-			if dv.Tensor.Len() == 0 {
+			tsr := TensorFS(ofn).TensorValue()
+			if tsr == nil || tsr.Len() == 0 {
 				core.MessageSnackbar(fn, "No data in tensor")
 				break
 			}
-			v := dv.Tensor.Float1D(0)
+			v := tsr.Float1D(0)
 			d := core.NewBody(df)
 			core.NewText(d).SetType(core.TextSupporting).SetText(df)
 			sp := core.NewSpinner(d).SetValue(float32(v))
 			d.AddBottomBar(func(bar *core.Frame) {
 				d.AddCancel(bar)
 				d.AddOK(bar).OnClick(func(e events.Event) {
-					dv.Tensor.SetFloat1D(float64(sp.Value), 0)
+					tsr.SetFloat1D(float64(sp.Value), 0)
 				})
 			})
 			d.RunDialog(fn)
 		case fileinfo.String:
-			dv := TensorFS(ofn)
-			if dv.Tensor.Len() == 0 {
+			tsr := TensorFS(ofn).TensorValue()
+			if tsr == nil || tsr.Len() == 0 {
 				core.MessageSnackbar(fn, "No data in tensor")
 				break
 			}
-			v := dv.Tensor.String1D(0)
+			v := tsr.String1D(0)
 			d := core.NewBody(df)
 			core.NewText(d).SetType(core.TextSupporting).SetText(df)
 			tf := core.NewTextField(d).SetText(v)
 			d.AddBottomBar(func(bar *core.Frame) {
 				d.AddCancel(bar)
 				d.AddOK(bar).OnClick(func(e events.Event) {
-					dv.Tensor.SetString1D(tf.Text(), 0)
+					tsr.SetString1D(tf.Text(), 0)
 				})
 			})
 			d.RunDialog(fn)
@@ -108,6 +108,19 @@ func (ts *Tabs) TensorTable(label string, dt *table.Table) *tensorcore.Table {
 	return tv
 }
 
+// DiffTables recycles a tab with a tensorcore.DiffTables widget
+// to view the differences between tables a and b, computed by
+// [table.Diff] with given key columns and numeric tolerance.
+// Use tv.Diff to get the resulting diff table.
+func (ts *Tabs) DiffTables(label string, a, b *table.Table, keyColumns []string, tolerance float64) (*tensorcore.DiffTables, error) {
+	tv := NewTab(ts, label, func(tab *core.Frame) *tensorcore.DiffTables {
+		return tensorcore.NewDiffTables(tab)
+	})
+	err := tv.SetTables(a, b, keyColumns, tolerance)
+	ts.Update()
+	return tv, err
+}
+
 // TensorEditor recycles a tab with a tensorcore.TensorEditor widget
 // to view given Tensor.
 func (ts *Tabs) TensorEditor(label string, tsr tensor.Tensor) *tensorcore.TensorEditor {
@@ -149,7 +162,11 @@ func (ts *Tabs) GridTensorFS(dfs *tensorfs.Node) *tensorcore.TensorGrid {
 		core.MessageSnackbar(ts, "Use Edit instead of Grid to view a directory")
 		return nil
 	}
-	tsr := dfs.Tensor
+	tsr := dfs.TensorValue()
+	if tsr == nil {
+		core.MessageSnackbar(ts, "No data in tensor")
+		return nil
+	}
 	return ts.TensorGrid(label, tsr)
 }
 
@@ -187,12 +204,22 @@ func (ts *Tabs) NewPlot(label string) *plotcore.Editor {
 }
 
 // PlotTensorFS recycles a tab with a Plot of given [tensorfs.Node].
+// A directory is plotted as a [tensorfs.DirTable], and the plot is
+// automatically updated when the directory changes, via [plotcore.Editor.WatchDir].
 func (ts *Tabs) PlotTensorFS(dfs *tensorfs.Node) *plotcore.Editor {
 	label := DirAndFileNoSlash(dfs.Path()) + " Plot"
 	if dfs.IsDir() {
-		return ts.PlotTable(label, tensorfs.DirTable(dfs, nil))
+		pl := ts.NewPlot(label)
+		if pl != nil {
+			pl.WatchDir(dfs)
+		}
+		return pl
+	}
+	tsr := dfs.TensorValue()
+	if tsr == nil || tsr.NumDims() == 0 {
+		core.MessageSnackbar(ts, "No data in tensor")
+		return nil
 	}
-	tsr := dfs.Tensor
 	dt := table.New(label)
 	dt.Columns.Rows = tsr.DimSize(0)
 	if ix, ok := tsr.(*tensor.Rows); ok {
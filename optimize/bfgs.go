@@ -0,0 +1,205 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+)
+
+// dot returns the dot product of a and b.
+func dot(a, b []float64) float64 {
+	s := 0.0
+	for i, v := range a {
+		s += v * b[i]
+	}
+	return s
+}
+
+// maxAbs returns the largest absolute value in a.
+func maxAbs(a []float64) float64 {
+	m := 0.0
+	for _, v := range a {
+		m = max(m, math.Abs(v))
+	}
+	return m
+}
+
+// lineSearch does a backtracking line search from x along direction d,
+// starting with step size alpha, for a step that satisfies the Armijo
+// sufficient decrease condition, setting xn and returning its function
+// value, or returning false if no such step is found.
+func (o *optimizer) lineSearch(x []float64, f float64, g, d, xn []float64, alpha float64) (float64, bool) {
+	const c1 = 1e-4
+	slope := dot(g, d)
+	for range 50 {
+		for i, v := range x {
+			xn[i] = v + alpha*d[i]
+		}
+		fn := o.eval(xn)
+		if fn <= f+c1*alpha*slope {
+			return fn, true
+		}
+		// minimum of the quadratic interpolation, within [0.1, 0.5] alpha
+		q := -slope * alpha * alpha / (2 * (fn - f - slope*alpha))
+		if math.IsNaN(q) || math.IsInf(fn, 0) {
+			q = 0.1 * alpha
+		}
+		alpha = min(max(q, 0.1*alpha), 0.5*alpha)
+	}
+	return f, false
+}
+
+// quasiNewton runs a quasi-Newton method from x, using the given function to
+// set the search direction d from the gradient g, and the update function
+// to update the inverse Hessian approximation from the step s and change
+// in gradient y. The reset function resets the approximation.
+func (o *optimizer) quasiNewton(x []float64, direction func(g, d []float64), update func(s, y []float64), reset func()) {
+	n := o.n
+	f := o.eval(x)
+	g := make([]float64, n)
+	o.grad(x, g)
+	if o.start(x, f) {
+		return
+	}
+	d := make([]float64, n)
+	xn := make([]float64, n)
+	gn := make([]float64, n)
+	s := make([]float64, n)
+	y := make([]float64, n)
+	fresh := true // whether the approximation was just reset
+	for {
+		if maxAbs(g) <= o.s.GradTolerance {
+			o.res.Converged = true
+			return
+		}
+		direction(g, d)
+		if dot(g, d) >= 0 { // not a descent direction
+			reset()
+			fresh = true
+			direction(g, d)
+		}
+		alpha := 1.0
+		if fresh {
+			alpha = min(1, 1/maxAbs(g))
+		}
+		fn, ok := o.lineSearch(x, f, g, d, xn, alpha)
+		if !ok {
+			if fresh { // no progress along the gradient: at the limit of precision
+				o.res.Converged = true
+				return
+			}
+			reset()
+			fresh = true
+			continue
+		}
+		o.grad(xn, gn)
+		conv := within(fn-f, fn, o.s.FuncTolerance)
+		for i := range n {
+			s[i] = xn[i] - x[i]
+			y[i] = gn[i] - g[i]
+			if !within(s[i], xn[i], o.s.XTolerance) {
+				conv = false
+			}
+		}
+		if dot(s, y) > 1e-10*math.Sqrt(dot(s, s)*dot(y, y)) {
+			update(s, y)
+			fresh = false
+		}
+		copy(x, xn)
+		copy(g, gn)
+		f = fn
+		o.res.Converged = conv || maxAbs(g) <= o.s.GradTolerance
+		if o.step(x, f) || o.res.Converged {
+			return
+		}
+	}
+}
+
+// bfgs runs the BFGS method from x.
+func (o *optimizer) bfgs(x []float64) {
+	n := o.n
+	h := make([]float64, n*n) // inverse Hessian approximation
+	scaled := false
+	reset := func() {
+		for i := range h {
+			h[i] = 0
+		}
+		for i := range n {
+			h[i*n+i] = 1
+		}
+		scaled = false
+	}
+	reset()
+	direction := func(g, d []float64) {
+		for i := range n {
+			d[i] = -dot(h[i*n:(i+1)*n], g)
+		}
+	}
+	hy := make([]float64, n)
+	update := func(s, y []float64) {
+		sy := dot(s, y)
+		if !scaled { // scale the initial identity matrix by sᵀy / yᵀy
+			for i := range n {
+				h[i*n+i] = sy / dot(y, y)
+			}
+			scaled = true
+		}
+		for i := range n {
+			hy[i] = dot(h[i*n:(i+1)*n], y)
+		}
+		yhy := dot(y, hy)
+		r := 1 / sy
+		// H = (I - r s yᵀ) H (I - r y sᵀ) + r s sᵀ
+		for i := range n {
+			for j := range n {
+				h[i*n+j] += r*((1+r*yhy)*s[i]*s[j]) - r*(hy[i]*s[j]+s[i]*hy[j])
+			}
+		}
+	}
+	o.quasiNewton(x, direction, update, reset)
+}
+
+// lbfgs runs the limited memory BFGS method from x.
+func (o *optimizer) lbfgs(x []float64) {
+	n := o.n
+	m := max(o.s.Memory, 1)
+	var ss, ys [][]float64
+	reset := func() {
+		ss, ys = nil, nil
+	}
+	alpha := make([]float64, m)
+	direction := func(g, d []float64) {
+		for i, v := range g {
+			d[i] = -v
+		}
+		k := len(ss)
+		for i := k - 1; i >= 0; i-- {
+			alpha[i] = dot(ss[i], d) / dot(ys[i], ss[i])
+			for j := range n {
+				d[j] -= alpha[i] * ys[i][j]
+			}
+		}
+		if k > 0 {
+			gamma := dot(ss[k-1], ys[k-1]) / dot(ys[k-1], ys[k-1])
+			for j := range n {
+				d[j] *= gamma
+			}
+		}
+		for i := range k {
+			beta := dot(ys[i], d) / dot(ys[i], ss[i])
+			for j := range n {
+				d[j] += (alpha[i] - beta) * ss[i][j]
+			}
+		}
+	}
+	update := func(s, y []float64) {
+		if len(ss) == m {
+			ss, ys = ss[1:], ys[1:]
+		}
+		ss = append(ss, append([]float64(nil), s...))
+		ys = append(ys, append([]float64(nil), y...))
+	}
+	o.quasiNewton(x, direction, update, reset)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"cogentcore.org/lab/base/randx"
+	"gonum.org/v1/gonum/mat"
+)
+
+// cmaes runs the CMA-ES method from x, following Hansen (2016),
+// "The CMA Evolution Strategy: A Tutorial", with samples outside of
+// the bounds repaired by clipping them to the bounds.
+func (o *optimizer) cmaes(x []float64) {
+	n := o.n
+	nf := float64(n)
+	rnd := o.s.Rand
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	lambda := o.s.PopSize
+	if lambda <= 0 {
+		lambda = 4 + int(3*math.Log(nf))
+	}
+	lambda = max(lambda, 2)
+	mu := lambda / 2
+	w := make([]float64, mu)
+	sw, sw2 := 0.0, 0.0
+	for i := range mu {
+		w[i] = math.Log(float64(lambda+1)/2) - math.Log(float64(i+1))
+		sw += w[i]
+	}
+	for i := range w {
+		w[i] /= sw
+		sw2 += w[i] * w[i]
+	}
+	mueff := 1 / sw2
+	cc := (4 + mueff/nf) / (nf + 4 + 2*mueff/nf)
+	cs := (mueff + 2) / (nf + mueff + 5)
+	c1 := 2 / ((nf+1.3)*(nf+1.3) + mueff)
+	cmu := min(1-c1, 2*(mueff-2+1/mueff)/((nf+2)*(nf+2)+mueff))
+	damps := 1 + 2*max(0, math.Sqrt((mueff-1)/(nf+1))-1) + cs
+	chiN := math.Sqrt(nf) * (1 - 1/(4*nf) + 1/(21*nf*nf))
+
+	mean := make([]float64, n)
+	copy(mean, x)
+	best := make([]float64, n)
+	copy(best, x)
+	fbest := o.eval(x)
+	if o.start(best, fbest) {
+		return
+	}
+	sigma := 1.0
+	c := mat.NewSymDense(n, nil)
+	bd := mat.NewDense(n, n, nil)   // B D, for sampling
+	binv := mat.NewDense(n, n, nil) // B D⁻¹ Bᵀ = C^-1/2
+	for i, v := range o.steps(x) {
+		c.SetSym(i, i, v*v)
+		bd.Set(i, i, v)
+		binv.Set(i, i, 1/v)
+	}
+	ps := make([]float64, n)
+	pc := make([]float64, n)
+	ys := make([][]float64, lambda)
+	xs := make([][]float64, lambda)
+	fs := make([]float64, lambda)
+	for k := range lambda {
+		ys[k] = make([]float64, n)
+		xs[k] = make([]float64, n)
+	}
+	z := make([]float64, n)
+	ymean := make([]float64, n)
+	order := make([]int, lambda)
+	dv := make([]float64, n)
+	nhist := 10 + int(math.Ceil(30*nf/float64(lambda)))
+	var hist []float64
+	var es mat.EigenSym
+	var vecs mat.Dense
+	for gen := 1; ; gen++ {
+		for k := range lambda {
+			for i := range n {
+				z[i] = rnd.NormFloat64()
+			}
+			for i := range n {
+				v := 0.0
+				for j := range n {
+					v += bd.At(i, j) * z[j]
+				}
+				xs[k][i] = mean[i] + sigma*v
+			}
+			o.clip(xs[k])
+			for i := range n {
+				ys[k][i] = (xs[k][i] - mean[i]) / sigma
+			}
+			fs[k] = o.eval(xs[k])
+			order[k] = k
+		}
+		sort.SliceStable(order, func(i, j int) bool { return fs[order[i]] < fs[order[j]] })
+		if f0 := fs[order[0]]; f0 < fbest {
+			fbest = f0
+			copy(best, xs[order[0]])
+		}
+
+		for i := range n {
+			ymean[i] = 0
+			for r := range mu {
+				ymean[i] += w[r] * ys[order[r]][i]
+			}
+			mean[i] += sigma * ymean[i]
+		}
+		psn := 0.0
+		for i := range n {
+			v := 0.0
+			for j := range n {
+				v += binv.At(i, j) * ymean[j]
+			}
+			ps[i] = (1-cs)*ps[i] + math.Sqrt(cs*(2-cs)*mueff)*v
+			psn += ps[i] * ps[i]
+		}
+		psn = math.Sqrt(psn)
+		hsig := 0.0
+		if psn/math.Sqrt(1-math.Pow(1-cs, 2*float64(gen)))/chiN < 1.4+2/(nf+1) {
+			hsig = 1
+		}
+		for i := range n {
+			pc[i] = (1-cc)*pc[i] + hsig*math.Sqrt(cc*(2-cc)*mueff)*ymean[i]
+		}
+		dh := (1 - hsig) * cc * (2 - cc)
+		for i := range n {
+			for j := i; j < n; j++ {
+				v := (1-c1-cmu)*c.At(i, j) + c1*(pc[i]*pc[j]+dh*c.At(i, j))
+				for r := range mu {
+					y := ys[order[r]]
+					v += cmu * w[r] * y[i] * y[j]
+				}
+				c.SetSym(i, j, v)
+			}
+		}
+		sigma *= math.Exp((cs / damps) * (psn/chiN - 1))
+
+		if es.Factorize(c, true) {
+			vals := es.Values(nil)
+			es.VectorsTo(&vecs)
+			for k, ev := range vals {
+				dv[k] = math.Sqrt(max(ev, 1e-300))
+				for i := range n {
+					bd.Set(i, k, vecs.At(i, k)*dv[k])
+				}
+			}
+			for i := range n {
+				for j := range n {
+					v := 0.0
+					for k := range n {
+						v += vecs.At(i, k) * vecs.At(j, k) / dv[k]
+					}
+					binv.Set(i, j, v)
+				}
+			}
+		}
+
+		// function values have converged if the best values over recent
+		// generations and all values in this generation are within tolerance
+		hist = append(hist, fs[order[0]])
+		if len(hist) > nhist {
+			hist = hist[1:]
+		}
+		flo, fhi := slices.Min(hist), max(slices.Max(hist), fs[order[lambda-1]])
+		conv := len(hist) == nhist && within(fhi-flo, fbest, o.s.FuncTolerance)
+		xconv := true
+		for i := range n {
+			if !within(sigma*math.Sqrt(c.At(i, i)), mean[i], o.s.XTolerance) {
+				xconv = false
+			}
+		}
+		o.res.Converged = conv || xconv
+		if o.step(best, fbest) || o.res.Converged {
+			return
+		}
+	}
+}
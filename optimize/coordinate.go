@@ -0,0 +1,48 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+// coordinateDescent runs the bounded coordinate search from x.
+func (o *optimizer) coordinateDescent(x []float64) {
+	f := o.eval(x)
+	if o.start(x, f) {
+		return
+	}
+	d := o.steps(x)
+	for {
+		fprev := f
+		for i, xi := range x {
+			improved := false
+			for _, dir := range []float64{1, -1} {
+				xt := min(max(xi+dir*d[i], o.lower[i]), o.upper[i])
+				if xt == xi {
+					continue
+				}
+				x[i] = xt
+				if ft := o.eval(x); ft < f {
+					f = ft
+					improved = true
+					break
+				}
+				x[i] = xi
+			}
+			if improved {
+				d[i] *= 2
+			} else {
+				d[i] *= 0.5
+			}
+		}
+		conv := within(f-fprev, f, o.s.FuncTolerance)
+		for i, v := range x {
+			if !within(d[i], v, o.s.XTolerance) {
+				conv = false
+			}
+		}
+		o.res.Converged = conv
+		if o.step(x, f) || conv {
+			return
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Code generated by "core generate"; DO NOT EDIT.
+
+package optimize
+
+import (
+	"cogentcore.org/core/enums"
+)
+
+var _MethodValues = []Method{0, 1, 2, 3, 4}
+
+// MethodN is the highest valid value for type Method, plus one.
+const MethodN Method = 5
+
+var _MethodValueMap = map[string]Method{`NelderMead`: 0, `BFGS`: 1, `LBFGS`: 2, `CoordinateDescent`: 3, `CMAES`: 4}
+
+var _MethodDescMap = map[Method]string{0: `NelderMead is the Nelder-Mead downhill simplex method, which only uses function values, and is robust for noisy or non-smooth objectives with a moderate number of parameters. It uses the adaptive parameters of Gao and Han (2012).`, 1: `BFGS is the Broyden-Fletcher-Goldfarb-Shanno quasi-Newton method, which uses the gradient to build up an approximation of the inverse Hessian, for fast convergence on smooth objectives.`, 2: `LBFGS is the limited memory BFGS method, which approximates the inverse Hessian from the most recent Memory steps, for smooth objectives with many parameters.`, 3: `CoordinateDescent is a derivative-free coordinate search within bounds, which steps each parameter in turn, increasing its step size after an improvement and decreasing it otherwise.`, 4: `CMAES is the covariance matrix adaptation evolution strategy, which samples a population of parameters from a multivariate normal distribution, adapting its mean and covariance toward the best samples. It is a global method for difficult objectives with local minima, and uses the Settings Rand random source.`}
+
+var _MethodMap = map[Method]string{0: `NelderMead`, 1: `BFGS`, 2: `LBFGS`, 3: `CoordinateDescent`, 4: `CMAES`}
+
+// String returns the string representation of this Method value.
+func (i Method) String() string { return enums.String(i, _MethodMap) }
+
+// SetString sets the Method value from its string representation,
+// and returns an error if the string is invalid.
+func (i *Method) SetString(s string) error { return enums.SetString(i, s, _MethodValueMap, "Method") }
+
+// Int64 returns the Method value as an int64.
+func (i Method) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the Method value from an int64.
+func (i *Method) SetInt64(in int64) { *i = Method(in) }
+
+// Desc returns the description of the Method value.
+func (i Method) Desc() string { return enums.Desc(i, _MethodDescMap) }
+
+// MethodValues returns all possible values for the type Method.
+func MethodValues() []Method { return _MethodValues }
+
+// Values returns all possible values for the type Method.
+func (i Method) Values() []enums.Enum { return enums.Values(_MethodValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i Method) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *Method) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "Method") }
@@ -0,0 +1,39 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"cogentcore.org/lab/table"
+)
+
+// TableLogger returns a function for the Settings Callback that adds
+// a row to the given table for each iteration, with the Iter, Evals and F
+// values of the [Result], and the X parameter values in a column with a
+// cell for each parameter. The columns are added if not already present,
+// so the table can be empty initially. The returned function calls the
+// given callback if it is non-nil, and returns its value.
+func TableLogger(dt *table.Table, callback func(res *Result) bool) func(res *Result) bool {
+	return func(res *Result) bool {
+		if _, err := dt.ColumnTry("Iter"); err != nil {
+			dt.AddIntColumn("Iter")
+			dt.AddIntColumn("Evals")
+			dt.AddFloat64Column("F")
+			dt.AddFloat64Column("X", res.X.Len())
+		}
+		row := dt.NumRows()
+		dt.AddRows(1)
+		dt.Column("Iter").SetIntRow(res.Iters, row, 0)
+		dt.Column("Evals").SetIntRow(res.Evals, row, 0)
+		dt.Column("F").SetFloatRow(res.F, row, 0)
+		xc := dt.Column("X")
+		for i, v := range res.X.Values {
+			xc.SetFloatRow(v, row, i)
+		}
+		if callback != nil {
+			return callback(res)
+		}
+		return false
+	}
+}
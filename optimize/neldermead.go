@@ -0,0 +1,131 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"sort"
+)
+
+// nelderMead runs the Nelder-Mead simplex method from x.
+func (o *optimizer) nelderMead(x []float64) {
+	n := o.n
+	nf := float64(n)
+	// adaptive parameters from Gao and Han (2012), which are the
+	// standard 1, 2, 0.5, 0.5 values for n = 2.
+	alpha, gamma, rho, sigma := 1.0, 1+2/nf, 0.75-1/(2*nf), 1-1/nf
+	if n == 1 {
+		gamma, rho, sigma = 2, 0.5, 0.5
+	}
+
+	pts := make([][]float64, n+1)
+	fs := make([]float64, n+1)
+	pts[0] = x
+	fs[0] = o.eval(x)
+	d := o.steps(x)
+	for i := range n {
+		p := make([]float64, n)
+		copy(p, x)
+		p[i] += d[i]
+		if p[i] > o.upper[i] {
+			p[i] = x[i] - d[i]
+		}
+		o.clip(p)
+		pts[i+1] = p
+		fs[i+1] = o.eval(p)
+	}
+	order := make([]int, n+1)
+	sortSimplex := func() {
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return fs[order[i]] < fs[order[j]] })
+		sp := make([][]float64, n+1)
+		sf := make([]float64, n+1)
+		for i, k := range order {
+			sp[i], sf[i] = pts[k], fs[k]
+		}
+		pts, fs = sp, sf
+	}
+	sortSimplex()
+	if o.start(pts[0], fs[0]) {
+		return
+	}
+
+	cen := make([]float64, n)
+	// point returns the point cen + t * (cen - worst), clipped to the bounds.
+	point := func(t float64) []float64 {
+		p := make([]float64, n)
+		for j := range n {
+			p[j] = cen[j] + t*(cen[j]-pts[n][j])
+		}
+		o.clip(p)
+		return p
+	}
+	for {
+		for j := range n {
+			cen[j] = 0
+			for i := range n {
+				cen[j] += pts[i][j]
+			}
+			cen[j] /= nf
+		}
+		xr := point(alpha)
+		fr := o.eval(xr)
+		switch {
+		case fr < fs[0]:
+			xe := point(alpha * gamma)
+			if fe := o.eval(xe); fe < fr {
+				pts[n], fs[n] = xe, fe
+			} else {
+				pts[n], fs[n] = xr, fr
+			}
+		case fr < fs[n-1]:
+			pts[n], fs[n] = xr, fr
+		default:
+			var xc []float64
+			var fc float64
+			if fr < fs[n] { // outside contraction
+				xc = point(alpha * rho)
+				fc = o.eval(xc)
+				if fc > fr {
+					xc = nil
+				}
+			} else { // inside contraction
+				xc = point(-rho)
+				fc = o.eval(xc)
+				if fc >= fs[n] {
+					xc = nil
+				}
+			}
+			if xc != nil {
+				pts[n], fs[n] = xc, fc
+			} else { // shrink toward the best point
+				for i := 1; i <= n; i++ {
+					for j := range n {
+						pts[i][j] = pts[0][j] + sigma*(pts[i][j]-pts[0][j])
+					}
+					fs[i] = o.eval(pts[i])
+				}
+			}
+		}
+		sortSimplex()
+
+		conv := true
+		for i := 1; i <= n && conv; i++ {
+			if !within(fs[i]-fs[0], fs[0], o.s.FuncTolerance) {
+				conv = false
+			}
+			for j := range n {
+				if !within(pts[i][j]-pts[0][j], pts[0][j], o.s.XTolerance) {
+					conv = false
+				}
+			}
+		}
+		o.res.Converged = conv
+		if o.step(pts[0], fs[0]) || conv {
+			return
+		}
+	}
+}
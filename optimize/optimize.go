@@ -0,0 +1,390 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package optimize provides numerical optimization methods that find
+// the parameters minimizing an objective function, including the
+// Nelder-Mead simplex, BFGS and L-BFGS quasi-Newton methods, bounded
+// coordinate descent, and the CMA-ES evolution strategy.
+package optimize
+
+//go:generate core generate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/tensor"
+)
+
+// Func is an objective function to be minimized, which returns
+// the value of the objective for given parameter values.
+type Func func(x *tensor.Float64) float64
+
+// GradFunc computes the gradient of an objective function
+// with respect to given parameter values, setting the values of grad,
+// which has the same shape as x.
+type GradFunc func(x, grad *tensor.Float64)
+
+// Problem is an optimization problem to be solved by [Minimize].
+type Problem struct {
+
+	// Func is the objective function to minimize.
+	Func Func
+
+	// Grad optionally computes the gradient of Func, which is used by
+	// the [BFGS] and [LBFGS] methods. If nil, the gradient is computed
+	// numerically using central differences.
+	Grad GradFunc
+
+	// Lower and Upper are optional bounds on the parameter values, with
+	// a value for each parameter, which can be -Inf or +Inf for no bound.
+	// Bounds are supported by the [NelderMead], [CoordinateDescent] and
+	// [CMAES] methods, which only evaluate Func within the bounds.
+	// Parameters with equal lower and upper bounds are fixed at that
+	// value, and are excluded from the optimization.
+	Lower, Upper *tensor.Float64
+}
+
+// Method is an optimization method.
+type Method int32 //enums:enum
+
+const (
+	// NelderMead is the Nelder-Mead downhill simplex method, which
+	// only uses function values, and is robust for noisy or
+	// non-smooth objectives with a moderate number of parameters.
+	// It uses the adaptive parameters of Gao and Han (2012).
+	NelderMead Method = iota
+
+	// BFGS is the Broyden-Fletcher-Goldfarb-Shanno quasi-Newton method,
+	// which uses the gradient to build up an approximation of the inverse
+	// Hessian, for fast convergence on smooth objectives.
+	BFGS
+
+	// LBFGS is the limited memory BFGS method, which approximates the
+	// inverse Hessian from the most recent Memory steps, for smooth
+	// objectives with many parameters.
+	LBFGS
+
+	// CoordinateDescent is a derivative-free coordinate search within
+	// bounds, which steps each parameter in turn, increasing its step
+	// size after an improvement and decreasing it otherwise.
+	CoordinateDescent
+
+	// CMAES is the covariance matrix adaptation evolution strategy,
+	// which samples a population of parameters from a multivariate
+	// normal distribution, adapting its mean and covariance toward the
+	// best samples. It is a global method for difficult objectives
+	// with local minima, and uses the Settings Rand random source.
+	CMAES
+)
+
+// Settings are the settings for [Minimize].
+type Settings struct {
+
+	// Method is the optimization method.
+	Method Method
+
+	// MaxIters is the maximum number of iterations, which are the
+	// generations of the population for [CMAES], and sweeps through
+	// all of the parameters for [CoordinateDescent].
+	MaxIters int `default:"1000"`
+
+	// MaxEvals is the maximum number of function evaluations,
+	// including those for numerical gradients, if > 0.
+	MaxEvals int
+
+	// FuncTolerance is the tolerance on changes in the function value
+	// for convergence, relative to its magnitude if > 1.
+	FuncTolerance float64 `default:"1e-8"`
+
+	// XTolerance is the tolerance on changes in the parameter values
+	// for convergence, relative to their magnitude if > 1.
+	XTolerance float64 `default:"1e-8"`
+
+	// GradTolerance is the tolerance on the largest absolute value
+	// of the gradient for convergence of the [BFGS] and [LBFGS] methods.
+	GradTolerance float64 `default:"1e-6"`
+
+	// Step is the initial step size for each parameter, relative to the
+	// magnitude of the initial parameter value if > 1, or to the range
+	// between the bounds if both are set. It determines the size of the
+	// initial simplex for [NelderMead], the initial steps for
+	// [CoordinateDescent], and the initial standard deviation for [CMAES].
+	Step float64 `default:"0.1"`
+
+	// Memory is the number of recent steps used by [LBFGS].
+	Memory int `default:"10"`
+
+	// PopSize is the population size for [CMAES],
+	// which is 4 + 3 ln(n) for n parameters if 0.
+	PopSize int
+
+	// Rand is the random number source for [CMAES],
+	// which uses the global source if nil.
+	Rand randx.Rand
+
+	// Callback is an optional function called with the current [Result]
+	// at the start and after each iteration, which can return true to stop
+	// the optimization. See [TableLogger] to record progress in a table.
+	Callback func(res *Result) bool
+}
+
+// NewSettings returns new Settings with default values.
+func NewSettings() *Settings {
+	s := &Settings{}
+	s.Defaults()
+	return s
+}
+
+func (s *Settings) Defaults() {
+	s.MaxIters = 1000
+	s.FuncTolerance = 1e-8
+	s.XTolerance = 1e-8
+	s.GradTolerance = 1e-6
+	s.Step = 0.1
+	s.Memory = 10
+}
+
+// Result is the result of [Minimize], which is also passed to the
+// Settings Callback with the current state of the optimization.
+type Result struct {
+
+	// Method is the optimization method used.
+	Method Method
+
+	// X are the best parameter values found.
+	X *tensor.Float64
+
+	// F is the value of the objective function at X.
+	F float64
+
+	// Iters is the number of iterations completed.
+	Iters int
+
+	// Evals is the number of function evaluations,
+	// including those for numerical gradients.
+	Evals int
+
+	// GradEvals is the number of gradient evaluations.
+	GradEvals int
+
+	// Converged is whether the optimization converged according to the
+	// tolerances, as opposed to stopping at MaxIters or MaxEvals,
+	// or by the Callback.
+	Converged bool
+}
+
+// String returns a summary of the result.
+func (res *Result) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: F = %.6g, Iters = %d, Evals = %d, Converged = %v\n", res.Method, res.F, res.Iters, res.Evals, res.Converged)
+	fmt.Fprintf(&b, "X = %.6g\n", res.X.Values)
+	return b.String()
+}
+
+// Minimize finds the parameters that minimize the objective function of
+// the given problem, starting from the initial values x0, using the
+// method and other settings in s, or the default settings if nil.
+// The initial values are clipped to the bounds, if set.
+// It returns an error if the problem is not valid for the method,
+// but not if it fails to converge, as reported in [Result.Converged].
+func Minimize(p *Problem, x0 *tensor.Float64, s *Settings) (*Result, error) {
+	if s == nil {
+		s = NewSettings()
+	}
+	if p.Func == nil {
+		return nil, errors.New("optimize: the Problem must have a Func")
+	}
+	n := x0.Len()
+	if n == 0 {
+		return nil, errors.New("optimize: there must be at least one parameter")
+	}
+	for _, b := range []*tensor.Float64{p.Lower, p.Upper} {
+		if b != nil && b.Len() != n {
+			return nil, fmt.Errorf("optimize: bounds must have a value for each of the %d parameters", n)
+		}
+	}
+	bounded := p.Lower != nil || p.Upper != nil
+	if bounded && (s.Method == BFGS || s.Method == LBFGS) {
+		return nil, fmt.Errorf("optimize: bounds are not supported by the %s method", s.Method)
+	}
+	o := &optimizer{p: p, s: s, n: n}
+	o.lower = make([]float64, n)
+	o.upper = make([]float64, n)
+	for i := range n {
+		o.lower[i], o.upper[i] = math.Inf(-1), math.Inf(1)
+		if p.Lower != nil {
+			o.lower[i] = p.Lower.Values[i]
+		}
+		if p.Upper != nil {
+			o.upper[i] = p.Upper.Values[i]
+		}
+		if !(o.lower[i] <= o.upper[i]) {
+			return nil, fmt.Errorf("optimize: lower bound %g is above upper bound %g for parameter %d", o.lower[i], o.upper[i], i)
+		}
+	}
+	o.res = &Result{Method: s.Method, X: tensor.NewFloat64(x0.ShapeSizes()...)}
+	o.xt = tensor.NewFloat64(x0.ShapeSizes()...)
+	x := make([]float64, n)
+	copy(x, x0.Values)
+	o.clip(x)
+	copy(o.xt.Values, x)
+	x = o.exclude(x)
+	if o.n == 0 { // all parameters are fixed
+		o.res.Converged = true
+		o.start(x, o.eval(x))
+		return o.res, nil
+	}
+	switch s.Method {
+	case NelderMead:
+		o.nelderMead(x)
+	case BFGS:
+		o.bfgs(x)
+	case LBFGS:
+		o.lbfgs(x)
+	case CoordinateDescent:
+		o.coordinateDescent(x)
+	case CMAES:
+		o.cmaes(x)
+	default:
+		return nil, fmt.Errorf("optimize: invalid method %s", s.Method)
+	}
+	return o.res, nil
+}
+
+// optimizer has the state for running an optimization method.
+type optimizer struct {
+	p   *Problem
+	s   *Settings
+	n   int
+	res *Result
+
+	// lower and upper are the bounds, which are infinite if not set.
+	lower, upper []float64
+
+	// free are the indexes of the parameters that are not fixed by equal
+	// bounds, if any are fixed, in which case the methods only operate
+	// on these parameters, and n is the number of them.
+	free []int
+
+	// xt and gt are tensors used for calling the problem functions.
+	xt, gt *tensor.Float64
+}
+
+// exclude removes any parameters that are fixed by equal bounds from
+// given full parameter values, and from the bounds, setting free and n.
+// It returns the remaining parameter values.
+func (o *optimizer) exclude(x []float64) []float64 {
+	for i := range x {
+		if o.lower[i] < o.upper[i] {
+			o.free = append(o.free, i)
+		}
+	}
+	if len(o.free) == len(x) {
+		o.free = nil
+		return x
+	}
+	o.n = len(o.free)
+	xf := make([]float64, o.n)
+	lower, upper := make([]float64, o.n), make([]float64, o.n)
+	for k, i := range o.free {
+		xf[k], lower[k], upper[k] = x[i], o.lower[i], o.upper[i]
+	}
+	o.lower, o.upper = lower, upper
+	return xf
+}
+
+// expand sets the full parameter values in dst from the given values
+// of the parameters being optimized, leaving the fixed values in dst.
+func (o *optimizer) expand(dst, x []float64) {
+	if o.free == nil {
+		copy(dst, x)
+		return
+	}
+	for k, i := range o.free {
+		dst[i] = x[k]
+	}
+}
+
+// eval returns the function value for given parameters.
+func (o *optimizer) eval(x []float64) float64 {
+	o.expand(o.xt.Values, x)
+	o.res.Evals++
+	return o.p.Func(o.xt)
+}
+
+// grad sets the gradient for given parameters, using central
+// differences if the problem does not have a Grad function.
+func (o *optimizer) grad(x, g []float64) {
+	o.res.GradEvals++
+	if o.p.Grad != nil {
+		o.expand(o.xt.Values, x)
+		if o.gt == nil {
+			o.gt = tensor.NewFloat64(o.xt.ShapeSizes()...)
+		}
+		o.p.Grad(o.xt, o.gt)
+		copy(g, o.gt.Values)
+		return
+	}
+	xh := make([]float64, o.n)
+	copy(xh, x)
+	for i, v := range x {
+		h := 6e-6 * max(math.Abs(v), 1) // cube root of machine epsilon
+		xh[i] = v + h
+		fp := o.eval(xh)
+		xh[i] = v - h
+		fm := o.eval(xh)
+		xh[i] = v
+		g[i] = (fp - fm) / (2 * h)
+	}
+}
+
+// clip clips given parameters to the bounds.
+func (o *optimizer) clip(x []float64) {
+	for i, v := range x {
+		x[i] = min(max(v, o.lower[i]), o.upper[i])
+	}
+}
+
+// steps returns the initial step size for each parameter.
+func (o *optimizer) steps(x []float64) []float64 {
+	d := make([]float64, o.n)
+	for i, v := range x {
+		if r := o.upper[i] - o.lower[i]; !math.IsInf(r, 0) {
+			d[i] = o.s.Step * r
+		} else {
+			d[i] = o.s.Step * max(math.Abs(v), 1)
+		}
+	}
+	return d
+}
+
+// start records the initial parameters and function value,
+// and calls the Callback, returning true to stop.
+func (o *optimizer) start(x []float64, f float64) bool {
+	copy(o.res.X.Values, o.xt.Values)
+	o.expand(o.res.X.Values, x)
+	o.res.F = f
+	return o.s.Callback != nil && o.s.Callback(o.res)
+}
+
+// step records the best parameters and function value at the end of
+// an iteration, and calls the Callback, returning true to stop because
+// of the Callback or the iteration or evaluation limits.
+func (o *optimizer) step(x []float64, f float64) bool {
+	o.res.Iters++
+	if o.start(x, f) {
+		return true
+	}
+	return o.res.Iters >= o.s.MaxIters || (o.s.MaxEvals > 0 && o.res.Evals >= o.s.MaxEvals)
+}
+
+// within returns whether the difference d is within tolerance tol,
+// relative to the magnitude of v if > 1.
+func within(d, v, tol float64) bool {
+	return math.Abs(d) <= tol*max(math.Abs(v), 1)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// rosenbrock is the n-dimensional Rosenbrock function,
+// with a minimum of 0 at (1, 1, ...).
+func rosenbrock(x *tensor.Float64) float64 {
+	f := 0.0
+	v := x.Values
+	for i := range len(v) - 1 {
+		a, b := 1-v[i], v[i+1]-v[i]*v[i]
+		f += a*a + 100*b*b
+	}
+	return f
+}
+
+func rosenbrockGrad(x, grad *tensor.Float64) {
+	v, g := x.Values, grad.Values
+	for i := range g {
+		g[i] = 0
+	}
+	for i := range len(v) - 1 {
+		b := v[i+1] - v[i]*v[i]
+		g[i] += -2*(1-v[i]) - 400*v[i]*b
+		g[i+1] += 200 * b
+	}
+}
+
+func assertMin(t *testing.T, res *Result, want []float64, tol float64) {
+	t.Helper()
+	assert.True(t, res.Converged, res.Method.String())
+	for i, v := range want {
+		assert.InDelta(t, v, res.X.Values[i], tol, res.Method.String())
+	}
+}
+
+func TestRosenbrock(t *testing.T) {
+	x0 := tensor.NewFloat64FromValues(-1.2, 1)
+	for _, m := range []Method{NelderMead, BFGS, LBFGS, CMAES} {
+		s := NewSettings()
+		s.Method = m
+		s.Rand = randx.NewSysRand(1)
+		s.MaxIters = 5000
+		res, err := Minimize(&Problem{Func: rosenbrock}, x0, s)
+		assert.NoError(t, err)
+		assertMin(t, res, []float64{1, 1}, 1e-3)
+		assert.Less(t, res.F, 1e-6, m.String())
+		assert.Equal(t, []float64{-1.2, 1}, x0.Values) // not modified
+	}
+
+	// analytic gradient in higher dimensions
+	x0 = tensor.NewFloat64(10)
+	for _, m := range []Method{BFGS, LBFGS} {
+		s := NewSettings()
+		s.Method = m
+		ngrad := 0
+		grad := func(x, g *tensor.Float64) {
+			ngrad++
+			rosenbrockGrad(x, g)
+		}
+		res, err := Minimize(&Problem{Func: rosenbrock, Grad: grad}, x0, s)
+		assert.NoError(t, err)
+		assertMin(t, res, []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, 1e-5)
+		assert.Equal(t, ngrad, res.GradEvals)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	// a quadratic with its unconstrained minimum at (3, -2) outside of the bounds
+	quad := func(x *tensor.Float64) float64 {
+		a, b := x.Values[0]-3, x.Values[1]+2
+		return a*a + 2*b*b + a*b
+	}
+	p := &Problem{Func: quad, Lower: tensor.NewFloat64FromValues(-1, -1), Upper: tensor.NewFloat64FromValues(2, 1)}
+	x0 := tensor.NewFloat64FromValues(0, 0)
+	// minimum on the boundary: x = 2, y minimizes 2(y+2)² - (y+2) => y = -1.75, clipped to -1
+	for _, m := range []Method{NelderMead, CoordinateDescent, CMAES} {
+		s := NewSettings()
+		s.Method = m
+		s.Rand = randx.NewSysRand(2)
+		res, err := Minimize(p, x0, s)
+		assert.NoError(t, err)
+		assertMin(t, res, []float64{2, -1}, 1e-5)
+	}
+
+	// interior minimum
+	p.Upper.Values[0] = 5
+	p.Lower.Values[1] = -5
+	s := NewSettings()
+	s.Method = CoordinateDescent
+	res, err := Minimize(p, x0, s)
+	assert.NoError(t, err)
+	assertMin(t, res, []float64{3, -2}, 1e-4)
+
+	s.Method = BFGS
+	_, err = Minimize(p, x0, s)
+	assert.Error(t, err)
+	p.Lower.Values[0] = 6
+	s.Method = NelderMead
+	_, err = Minimize(p, x0, s)
+	assert.Error(t, err)
+	_, err = Minimize(&Problem{Func: quad, Lower: tensor.NewFloat64(3)}, x0, s)
+	assert.Error(t, err)
+	_, err = Minimize(&Problem{}, x0, s)
+	assert.Error(t, err)
+}
+
+func TestFixedBounds(t *testing.T) {
+	// the middle parameter is fixed at 0.5 by equal bounds
+	quad := func(x *tensor.Float64) float64 {
+		a, b, c := x.Values[0]-1, x.Values[1]-2, x.Values[2]+1
+		return a*a + b*b + c*c + a*b
+	}
+	p := &Problem{Func: quad, Lower: tensor.NewFloat64FromValues(-5, 0.5, -5), Upper: tensor.NewFloat64FromValues(5, 0.5, 5)}
+	x0 := tensor.NewFloat64FromValues(0, 0, 0)
+	// with b = -1.5, a minimizes a² - 1.5a => a = 1.75
+	for _, m := range []Method{NelderMead, CoordinateDescent, CMAES} {
+		s := NewSettings()
+		s.Method = m
+		s.Rand = randx.NewSysRand(4)
+		res, err := Minimize(p, x0, s)
+		assert.NoError(t, err)
+		assertMin(t, res, []float64{1.75, 0.5, -1}, 1e-4)
+		assert.Equal(t, 0.5, res.X.Values[1])
+	}
+
+	// all fixed
+	p.Lower.Values[0], p.Upper.Values[0] = 1, 1
+	p.Lower.Values[2], p.Upper.Values[2] = -1, -1
+	res, err := Minimize(p, x0, nil)
+	assert.NoError(t, err)
+	assertMin(t, res, []float64{1, 0.5, -1}, 0)
+	assert.Equal(t, 2.25, res.F)
+	assert.Equal(t, 1, res.Evals)
+}
+
+func TestCMAESMultimodal(t *testing.T) {
+	// the Rastrigin function has many local minima, with the global minimum of 0 at 0
+	rastrigin := func(x *tensor.Float64) float64 {
+		f := 10 * float64(x.Len())
+		for _, v := range x.Values {
+			f += v*v - 10*math.Cos(2*math.Pi*v)
+		}
+		return f
+	}
+	p := &Problem{Func: rastrigin, Lower: tensor.NewFloat64FromValues(-5, -5, -5), Upper: tensor.NewFloat64FromValues(5, 5, 5)}
+	s := NewSettings()
+	s.Method = CMAES
+	s.Step = 0.3
+	s.PopSize = 50
+	s.Rand = randx.NewSysRand(3)
+	res, err := Minimize(p, tensor.NewFloat64FromValues(3, -2, 4), s)
+	assert.NoError(t, err)
+	assertMin(t, res, []float64{0, 0, 0}, 1e-4)
+}
+
+func TestTableLogger(t *testing.T) {
+	dt := table.New()
+	s := NewSettings()
+	s.MaxIters = 20
+	stop := 0
+	s.Callback = TableLogger(dt, func(res *Result) bool {
+		stop++
+		return stop > 10
+	})
+	res, err := Minimize(&Problem{Func: rosenbrock}, tensor.NewFloat64FromValues(-1.2, 1), s)
+	assert.NoError(t, err)
+	assert.False(t, res.Converged)
+	assert.Equal(t, 10, res.Iters)
+	assert.Equal(t, 11, dt.NumRows()) // including the start
+	assert.Equal(t, []int{11, 2}, dt.Column("X").ShapeSizes())
+	assert.Equal(t, 0, dt.Column("Iter").IntRow(0, 0))
+	assert.Equal(t, 10, dt.Column("Iter").IntRow(10, 0))
+	assert.Equal(t, res.F, dt.Column("F").FloatRow(10, 0))
+	assert.Equal(t, res.X.Values[1], dt.Column("X").FloatRow(10, 1))
+	assert.LessOrEqual(t, dt.Column("F").FloatRow(10, 0), dt.Column("F").FloatRow(0, 0))
+
+	s.Callback = nil
+	s.MaxEvals = 50
+	res, err = Minimize(&Problem{Func: rosenbrock}, tensor.NewFloat64FromValues(-1.2, 1), s)
+	assert.NoError(t, err)
+	assert.False(t, res.Converged)
+	assert.Less(t, res.Evals, 60)
+}
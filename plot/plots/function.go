@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plots
+
+import (
+	"fmt"
+
+	"cogentcore.org/core/math32/minmax"
+	"cogentcore.org/lab/plot"
+)
+
+// Function draws a line for a function of X, evaluated at N evenly
+// spaced points over the X range of the plot (as determined by the
+// other plotters or the X axis range style), or over the Min, Max
+// range if set. It is typically used to overlay a fitted curve on
+// the data, e.g., from the Eval method of a stats/fit Result.
+type Function struct {
+	XY
+
+	// Fun is the function to plot.
+	Fun func(x float64) float64
+
+	// N is the number of points to evaluate the function at.
+	N int
+
+	// Min and Max are the range of X values over which to evaluate
+	// the function, if Min < Max, instead of the X range of the plot.
+	Min, Max float64
+}
+
+// NewFunction adds a Function plotter to the given plot, drawing
+// a line for given function of X. Add it after the plotters for the
+// data, so that the function is evaluated over the range of the data.
+func NewFunction(plt *plot.Plot, fun func(x float64) float64) *Function {
+	fn := &Function{}
+	if err := fn.SetData(fun); err != nil {
+		return nil
+	}
+	fn.N = 200
+	fn.Defaults()
+	fn.Style.Line.On = plot.On
+	fn.Style.Point.On = plot.Off
+	plt.Add(fn)
+	return fn
+}
+
+// SetData sets the function, which must be a func(x float64) float64.
+func (fn *Function) SetData(data any) error {
+	fun, ok := data.(func(x float64) float64)
+	if !ok || fun == nil {
+		return fmt.Errorf("plots.Function: data must be a func(x float64) float64, not %T", data)
+	}
+	fn.Fun = fun
+	return nil
+}
+
+// Styler adds a style function to set style parameters.
+func (fn *Function) Styler(f func(s *plot.Style)) *Function {
+	fn.stylers.Add(f)
+	return fn
+}
+
+// UpdateRange evaluates the function over the current X range,
+// and then updates the given ranges with the resulting values.
+func (fn *Function) UpdateRange(plt *plot.Plot) {
+	rng := minmax.F64{Min: fn.Min, Max: fn.Max}
+	if !(fn.Min < fn.Max) {
+		rng = plt.X.Range
+		plot.RangeLogic(plt.Style.OutOfRange, &rng, &plt.Style.XAxis.Range)
+		if !(rng.Min < rng.Max) {
+			rng = minmax.F64{Min: 0, Max: 1}
+		}
+	}
+	n := max(fn.N, 2)
+	fn.X = make(plot.Values, n)
+	fn.Y = make(plot.Values, n)
+	for i := range n {
+		x := rng.Min + rng.Range()*float64(i)/float64(n-1)
+		fn.X[i] = x
+		fn.Y[i] = fn.Fun(x)
+	}
+	fn.XY.UpdateRange(plt)
+}
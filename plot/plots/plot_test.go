@@ -339,6 +339,41 @@ func TestScatter(t *testing.T) {
 	}
 }
 
+func TestFunction(t *testing.T) {
+	data := sinDataXY()
+
+	plt := plot.New()
+	plt.Title.Text = "Test Function"
+	plt.X.Label.Text = "X Axis"
+	plt.Y.Label.Text = "Y Axis"
+
+	l1 := NewScatter(plt, data)
+	if l1 == nil {
+		t.Fatal("bad data")
+	}
+	fn := NewFunction(plt, func(x float64) float64 {
+		return 50 + 40*math.Sin((x/40)*math.Pi)
+	})
+	if fn == nil {
+		t.Fatal("bad function")
+	}
+	imagex.Assert(t, plt.RenderImage(), "function")
+	if fn.X[0] >= 0 || fn.X[fn.N-1] <= 100 || len(fn.Y) != fn.N {
+		t.Error("function not evaluated over the X range of the data")
+	}
+
+	fn.Min, fn.Max = 20, 60
+	fn.Style.Line.Color = colors.Uniform(colors.Red)
+	imagex.Assert(t, plt.RenderImage(), "function-range")
+	if fn.X[0] != 20 || fn.X[fn.N-1] != 60 {
+		t.Error("function not evaluated over the Min, Max range")
+	}
+
+	if NewFunction(plt, nil) != nil {
+		t.Error("nil function should not be added")
+	}
+}
+
 func TestBubble(t *testing.T) {
 	rnd := randx.NewSysRand(23)
 	for i := range 2 {
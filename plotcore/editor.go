@@ -16,6 +16,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"cogentcore.org/core/base/errors"
@@ -37,6 +38,7 @@ import (
 	"cogentcore.org/lab/table"
 	"cogentcore.org/lab/tensor"
 	"cogentcore.org/lab/tensorcore"
+	"cogentcore.org/lab/tensorfs"
 	"golang.org/x/exp/maps"
 )
 
@@ -68,8 +70,15 @@ type Editor struct { //types:add
 	columnsFrame      *core.Frame
 	plotWidget        *Plot
 	plotStyleModified map[string]bool
+
+	// unwatch cancels the current [Editor.WatchDir], if any.
+	unwatch func()
 }
 
+// WatchInterval is the minimum interval between updates of an [Editor]
+// in response to changes in the directory set by [Editor.WatchDir].
+var WatchInterval = 200 * time.Millisecond
+
 func (pl *Editor) CopyFieldsFrom(frm tree.Node) {
 	fr := frm.(*Editor)
 	pl.Frame.CopyFieldsFrom(&fr.Frame)
@@ -153,6 +162,54 @@ func (pl *Editor) SetTable(tab *table.Table) *Editor {
 	return pl
 }
 
+// WatchDir sets the table to a [tensorfs.DirTableView] of given [tensorfs]
+// directory, and watches it for changes using [tensorfs.Node.Watch],
+// cancelling any previous watch. When nodes are added, removed, or replaced,
+// the table is rebuilt, and when values are updated (via [tensorfs.Node.SendUpdate]),
+// the number of rows is updated and the plot is updated, at most once per
+// [WatchInterval]. The column tensors are never resized, so the values can
+// be written by other goroutines while they are plotted.
+func (pl *Editor) WatchDir(dir *tensorfs.Node) *Editor {
+	pl.Unwatch()
+	pl.SetTable(tensorfs.DirTableView(dir, nil))
+	var changed atomic.Bool
+	update := tensorfs.Throttle(WatchInterval, func() {
+		if pl.This == nil {
+			return
+		}
+		dt := tensorfs.DirTableView(dir, nil)
+		pl.AsyncLock()
+		if changed.Swap(false) || pl.table == nil || !slices.Equal(pl.table.Columns.Keys, dt.Columns.Keys) {
+			pl.SetTable(dt)
+			pl.AsyncUnlock()
+			return
+		}
+		pl.table.Columns.Rows = dt.Columns.Rows // our own view, not shared
+		pl.AsyncUnlock()
+		pl.GoUpdatePlot()
+	})
+	pl.unwatch = dir.Watch(func(ev tensorfs.Event) {
+		if ev.Type != tensorfs.EventUpdated {
+			changed.Store(true)
+		}
+		update(ev)
+	})
+	return pl
+}
+
+// Unwatch cancels any current [Editor.WatchDir].
+func (pl *Editor) Unwatch() {
+	if pl.unwatch != nil {
+		pl.unwatch()
+		pl.unwatch = nil
+	}
+}
+
+func (pl *Editor) Destroy() {
+	pl.Unwatch()
+	pl.Frame.Destroy()
+}
+
 // SetSlice sets the table to a [table.NewSliceTable] from the given slice.
 // Optional styler functions are used for each struct field in sequence,
 // and any can contain global plot style. See [BasicStylers] for example.
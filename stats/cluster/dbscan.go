@@ -0,0 +1,71 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/tensor"
+)
+
+// DBSCAN clusters the rows (items) of given data tensor by density, using
+// the DBSCAN algorithm (Ester et al., 1996). Items with at least minPts
+// items (including themselves) within distance eps, according to given
+// distance metric (which must increase with distance), are core items,
+// and clusters are formed by core items within eps of each other, along
+// with the other items within eps of them. The remaining items are noise,
+// labeled -1. The number of clusters is determined by the data.
+// The neighbors of each item are computed as needed, without storing
+// a distance matrix, so this takes O(n²) distance computations.
+func DBSCAN(data tensor.Tensor, eps float64, minPts int, distMetric metric.Metrics) (*Partition, error) {
+	xs, csz := items(data)
+	n := len(xs)
+	dist, err := distFunc(xs, distMetric)
+	if err != nil {
+		return nil, err
+	}
+	neighbors := func(i int) []int {
+		var nb []int
+		for j := range n {
+			if dist(i, j) <= eps {
+				nb = append(nb, j)
+			}
+		}
+		return nb
+	}
+	const unvisited, noise = -2, -1
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = unvisited
+	}
+	k := 0
+	for i := range n {
+		if labels[i] != unvisited {
+			continue
+		}
+		nb := neighbors(i)
+		if len(nb) < minPts {
+			labels[i] = noise
+			continue
+		}
+		labels[i] = k
+		queue := nb
+		for len(queue) > 0 {
+			j := queue[0]
+			queue = queue[1:]
+			if labels[j] == noise { // border item
+				labels[j] = k
+			}
+			if labels[j] != unvisited {
+				continue
+			}
+			labels[j] = k
+			if jn := neighbors(j); len(jn) >= minPts {
+				queue = append(queue, jn...)
+			}
+		}
+		k++
+	}
+	return newPartition(xs, labels, k, csz), nil
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/tensor"
+)
+
+// Partition is the result of partitional clustering of the rows
+// (items) of a data tensor into K clusters, by [KMeans], [KMedoids]
+// or [DBSCAN]. Unlike agglomerative clustering with [Cluster], these
+// methods do not require a distance matrix over all pairs of items.
+type Partition struct {
+
+	// Labels are the cluster index for each item (row of the data),
+	// which is -1 for noise items that are not in any cluster with [DBSCAN].
+	Labels *tensor.Int
+
+	// Centroids are the centers of the clusters, with a row for each cluster
+	// and the cell shape of the data. They are the means of the items in each
+	// cluster for [KMeans] and [DBSCAN], and the medoid items for [KMedoids].
+	Centroids *tensor.Float64
+
+	// Medoids are the indexes of the medoid items for [KMedoids].
+	Medoids []int
+
+	// Sizes are the number of items in each cluster.
+	Sizes []int
+
+	// Inertia is the sum of the squared Euclidean distances of the items
+	// to their cluster centroids for [KMeans] and [DBSCAN] (excluding noise),
+	// and the sum of the distances to their medoids for [KMedoids].
+	Inertia float64
+
+	// Iters is the number of iterations used by the best run of
+	// [KMeans] or [KMedoids].
+	Iters int
+}
+
+// K returns the number of clusters.
+func (p *Partition) K() int {
+	return len(p.Sizes)
+}
+
+// maxIters is the maximum number of iterations for KMeans and KMedoids.
+const maxIters = 300
+
+// ErrTooFew is returned when there are fewer items than clusters.
+var ErrTooFew = errors.New("cluster: number of clusters must be between 1 and the number of items")
+
+// items returns the rows of given data tensor as float64 slices,
+// along with the cell shape.
+func items(data tensor.Tensor) ([][]float64, []int) {
+	n, cells := data.Shape().RowCellSize()
+	if data.NumDims() == 1 {
+		n, cells = data.Len(), 1
+	}
+	xs := make([][]float64, n)
+	for i := range n {
+		xs[i] = make([]float64, cells)
+		for j := range cells {
+			xs[i][j] = data.Float1D(i*cells + j)
+		}
+	}
+	csz := []int{1}
+	if data.NumDims() > 1 {
+		csz = data.ShapeSizes()[1:]
+	}
+	return xs, csz
+}
+
+// distFunc returns a function computing the distance between items
+// i and j using given metric, which must increase with distance.
+func distFunc(xs [][]float64, m metric.Metrics) (func(i, j int) float64, error) {
+	if !m.Increasing() {
+		return nil, fmt.Errorf("cluster: metric %s must increase with distance, e.g., use InvCorrelation instead of Correlation", m)
+	}
+	switch m {
+	case metric.MetricL2Norm:
+		return func(i, j int) float64 { return math.Sqrt(sqDist(xs[i], xs[j])) }, nil
+	case metric.MetricSumSquares:
+		return func(i, j int) float64 { return sqDist(xs[i], xs[j]) }, nil
+	case metric.MetricL1Norm:
+		return func(i, j int) float64 {
+			d := 0.0
+			for k, v := range xs[i] {
+				d += math.Abs(v - xs[j][k])
+			}
+			return d
+		}, nil
+	}
+	fun := m.Func()
+	ts := make([]*tensor.Float64, len(xs))
+	for i, x := range xs {
+		ts[i] = tensor.NewFloat64FromValues(x...)
+	}
+	return func(i, j int) float64 { return fun(ts[i], ts[j]).Float1D(0) }, nil
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []float64) float64 {
+	d := 0.0
+	for k, v := range a {
+		e := v - b[k]
+		d += e * e
+	}
+	return d
+}
+
+// newPartition returns a new Partition for n items and k clusters with
+// given cell shape, with the centroids set to the means of the items
+// in each cluster, and the inertia to their squared distance from them.
+func newPartition(xs [][]float64, labels []int, k int, csz []int) *Partition {
+	p := &Partition{Sizes: make([]int, k)}
+	p.Labels = tensor.NewInt(len(xs))
+	p.Centroids = tensor.NewFloat64(append([]int{k}, csz...)...)
+	cells := len(p.Centroids.Values) / max(k, 1)
+	for i, l := range labels {
+		p.Labels.Values[i] = l
+		if l < 0 {
+			continue
+		}
+		p.Sizes[l]++
+		for j, v := range xs[i] {
+			p.Centroids.Values[l*cells+j] += v
+		}
+	}
+	for l, sz := range p.Sizes {
+		for j := range cells {
+			p.Centroids.Values[l*cells+j] /= float64(max(sz, 1))
+		}
+	}
+	for i, l := range labels {
+		if l >= 0 {
+			p.Inertia += sqDist(xs[i], p.Centroids.Values[l*cells:(l+1)*cells])
+		}
+	}
+	return p
+}
+
+// seeds returns k initial cluster centers chosen from the items by
+// the k-means++ method, where each successive center is chosen with
+// probability proportional to the given distance weighting of its
+// distance to the nearest center chosen so far.
+func seeds(n, k int, dist func(i, j int) float64, weight func(d float64) float64, rnd randx.Rand) []int {
+	centers := []int{rnd.Intn(n)}
+	nearest := make([]float64, n)
+	for i := range n {
+		nearest[i] = weight(dist(i, centers[0]))
+	}
+	for len(centers) < k {
+		sum := 0.0
+		for _, d := range nearest {
+			sum += d
+		}
+		c := -1
+		if sum > 0 {
+			r := rnd.Float64() * sum
+			for i, d := range nearest {
+				r -= d
+				if r < 0 && d > 0 {
+					c = i
+					break
+				}
+			}
+		}
+		if c < 0 { // all remaining items are at existing centers
+			for i, d := range nearest {
+				if d == 0 && !slices.Contains(centers, i) {
+					c = i
+					break
+				}
+			}
+		}
+		centers = append(centers, c)
+		for i := range n {
+			nearest[i] = min(nearest[i], weight(dist(i, c)))
+		}
+	}
+	return centers
+}
+
+// KMeans clusters the rows (items) of given data tensor into k clusters,
+// minimizing the sum of squared Euclidean distances of the items to the
+// means (centroids) of their clusters, using Lloyd's algorithm.
+// The initial centroids are chosen by the k-means++ method, and the
+// clustering is run restarts times (at least once) from different initial
+// centroids, returning the result with the lowest inertia.
+// The random number source can be nil to use the global source.
+func KMeans(data tensor.Tensor, k, restarts int, rnd randx.Rand) (*Partition, error) {
+	xs, csz := items(data)
+	n := len(xs)
+	if k < 1 || k > n {
+		return nil, ErrTooFew
+	}
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	sq := func(i, j int) float64 { return sqDist(xs[i], xs[j]) }
+	ident := func(d float64) float64 { return d }
+	var best *Partition
+	for range max(restarts, 1) {
+		cs := seeds(n, k, sq, ident, rnd)
+		cents := make([][]float64, k)
+		for c, i := range cs {
+			cents[c] = append([]float64(nil), xs[i]...)
+		}
+		labels := make([]int, n)
+		for i := range labels {
+			labels[i] = -1
+		}
+		iters := 0
+		for iters = 1; iters <= maxIters; iters++ {
+			changed := false
+			for i, x := range xs {
+				l, ld := 0, math.Inf(1)
+				for c, cent := range cents {
+					if d := sqDist(x, cent); d < ld {
+						l, ld = c, d
+					}
+				}
+				if l != labels[i] {
+					labels[i] = l
+					changed = true
+				}
+			}
+			if !changed {
+				break
+			}
+			p := newPartition(xs, labels, k, csz)
+			cells := len(xs[0])
+			for c := range k {
+				if p.Sizes[c] == 0 { // empty cluster: move to the item farthest from its centroid
+					far, fd := 0, -1.0
+					for i, x := range xs {
+						if d := sqDist(x, cents[labels[i]]); d > fd && p.Sizes[labels[i]] > 1 {
+							far, fd = i, d
+						}
+					}
+					p.Sizes[labels[far]]--
+					labels[far] = c
+					p.Sizes[c] = 1
+					copy(cents[c], xs[far])
+					continue
+				}
+				copy(cents[c], p.Centroids.Values[c*cells:(c+1)*cells])
+			}
+		}
+		p := newPartition(xs, labels, k, csz)
+		p.Iters = min(iters, maxIters)
+		if best == nil || p.Inertia < best.Inertia {
+			best = p
+		}
+	}
+	return best, nil
+}
+
+// KMedoids clusters the rows (items) of given data tensor into k clusters,
+// minimizing the sum of the distances of the items to the medoid of their
+// cluster, which is the item with the lowest total distance to the other
+// items in the cluster, using the given distance metric, which must
+// increase with distance. It alternates between assigning items to the
+// nearest medoid and updating the medoids, starting from medoids chosen
+// by the k-means++ method, and is run restarts times (at least once),
+// returning the result with the lowest total distance (Inertia).
+// The random number source can be nil to use the global source.
+func KMedoids(data tensor.Tensor, k int, distMetric metric.Metrics, restarts int, rnd randx.Rand) (*Partition, error) {
+	xs, csz := items(data)
+	n := len(xs)
+	if k < 1 || k > n {
+		return nil, ErrTooFew
+	}
+	dist, err := distFunc(xs, distMetric)
+	if err != nil {
+		return nil, err
+	}
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	square := func(d float64) float64 { return d * d }
+	var best *Partition
+	var bestMeds []int
+	for range max(restarts, 1) {
+		meds := seeds(n, k, dist, square, rnd)
+		labels := make([]int, n)
+		cost := 0.0
+		iters := 0
+		for iters = 1; iters <= maxIters; iters++ {
+			cost = 0
+			members := make([][]int, k)
+			for i := range xs {
+				l, ld := 0, math.Inf(1)
+				for c, m := range meds {
+					if i == m {
+						l, ld = c, 0
+						break
+					}
+					if d := dist(i, m); d < ld {
+						l, ld = c, d
+					}
+				}
+				labels[i] = l
+				members[l] = append(members[l], i)
+				cost += ld
+			}
+			changed := false
+			for c, mem := range members {
+				bm, bd := meds[c], math.Inf(1)
+				for _, i := range mem {
+					d := 0.0
+					for _, j := range mem {
+						if i != j {
+							d += dist(i, j)
+						}
+					}
+					if d < bd || (d == bd && i == meds[c]) {
+						bm, bd = i, d
+					}
+				}
+				if bm != meds[c] {
+					meds[c] = bm
+					changed = true
+				}
+			}
+			if !changed {
+				break
+			}
+		}
+		if best == nil || cost < best.Inertia {
+			best = &Partition{Inertia: cost, Iters: min(iters, maxIters)}
+			best.Labels = tensor.NewIntFromValues(labels...)
+			bestMeds = append([]int(nil), meds...)
+		}
+	}
+	best.Medoids = bestMeds
+	best.Sizes = make([]int, k)
+	for _, l := range best.Labels.Values {
+		best.Sizes[l]++
+	}
+	best.Centroids = tensor.NewFloat64(append([]int{k}, csz...)...)
+	cells := len(xs[0])
+	for c, m := range bestMeds {
+		copy(best.Centroids.Values[c*cells:(c+1)*cells], xs[m])
+	}
+	return best, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/core/base/iox/imagex"
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// blobs returns n 2D points around each of the given centers,
+// with normally distributed noise of given standard deviation.
+func blobs(centers [][2]float64, n int, sd float64, seed int64) *tensor.Float64 {
+	rnd := randx.NewSysRand(seed)
+	data := tensor.NewFloat64(len(centers)*n, 2)
+	for c, ctr := range centers {
+		for i := range n {
+			r := c*n + i
+			data.Set(ctr[0]+sd*rnd.NormFloat64(), r, 0)
+			data.Set(ctr[1]+sd*rnd.NormFloat64(), r, 1)
+		}
+	}
+	return data
+}
+
+var blobCenters = [][2]float64{{0, 0}, {6, 1}, {2, 7}}
+
+// assertBlobs checks that the labels match the blobs, up to a permutation.
+func assertBlobs(t *testing.T, labels *tensor.Int, n int) {
+	t.Helper()
+	seen := map[int]bool{}
+	for c := range blobCenters {
+		l := labels.Values[c*n]
+		assert.False(t, seen[l], "blob %d", c)
+		seen[l] = true
+		for i := range n {
+			assert.Equal(t, l, labels.Values[c*n+i], "blob %d item %d", c, i)
+		}
+	}
+}
+
+func TestKMeans(t *testing.T) {
+	data := blobs(blobCenters, 30, 0.5, 1)
+	p, err := KMeans(data, 3, 5, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assertBlobs(t, p.Labels, 30)
+	assert.Equal(t, 3, p.K())
+	assert.Equal(t, []int{30, 30, 30}, p.Sizes)
+	assert.Equal(t, []int{3, 2}, p.Centroids.ShapeSizes())
+	for c := range 3 {
+		l := p.Labels.Values[c*30]
+		assert.InDelta(t, blobCenters[c][0], p.Centroids.Value(l, 0), 0.3)
+		assert.InDelta(t, blobCenters[c][1], p.Centroids.Value(l, 1), 0.3)
+	}
+	// inertia is about n * 2 * sd²
+	assert.InDelta(t, 90*2*0.25, p.Inertia, 10)
+
+	p1, err := KMeans(data, 1, 1, nil)
+	assert.NoError(t, err)
+	assert.Greater(t, p1.Inertia, p.Inertia)
+	_, err = KMeans(data, 91, 1, nil)
+	assert.ErrorIs(t, err, ErrTooFew)
+}
+
+func TestKMedoids(t *testing.T) {
+	data := blobs(blobCenters, 20, 0.5, 2)
+	for _, m := range []metric.Metrics{metric.MetricL2Norm, metric.MetricL1Norm, metric.MetricSumSquares} {
+		p, err := KMedoids(data, 3, m, 3, randx.NewSysRand(2))
+		assert.NoError(t, err)
+		assertBlobs(t, p.Labels, 20)
+		for c, md := range p.Medoids {
+			assert.Equal(t, c, p.Labels.Values[md])
+			assert.Equal(t, data.Value(md, 0), p.Centroids.Value(c, 0))
+			assert.Equal(t, data.Value(md, 1), p.Centroids.Value(c, 1))
+		}
+	}
+	_, err := KMedoids(data, 3, metric.MetricCorrelation, 1, nil)
+	assert.Error(t, err)
+}
+
+func TestDBSCAN(t *testing.T) {
+	data := blobs(blobCenters, 30, 0.4, 3)
+	// add two outliers
+	data.SetShapeSizes(92, 2)
+	data.Set(20, 90, 0)
+	data.Set(-20, 91, 1)
+	p, err := DBSCAN(data, 1, 4, metric.MetricL2Norm)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.K())
+	assertBlobs(t, p.Labels, 30)
+	assert.Equal(t, -1, p.Labels.Values[90])
+	assert.Equal(t, -1, p.Labels.Values[91])
+	assert.Equal(t, 90, p.Sizes[0]+p.Sizes[1]+p.Sizes[2])
+
+	p, err = DBSCAN(data, 0.001, 2, metric.MetricL2Norm)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, p.K())
+}
+
+func TestSilhouette(t *testing.T) {
+	data := tensor.NewFloat64FromValues(0, 1, 10, 11, 30)
+	labels := tensor.NewIntFromValues(0, 0, 1, 1, -1)
+	scores, err := SilhouetteScores(data, labels, metric.MetricL2Norm)
+	assert.NoError(t, err)
+	assert.InDelta(t, (10.5-1)/10.5, scores.Values[0], 1e-12)
+	assert.InDelta(t, (9.5-1)/9.5, scores.Values[1], 1e-12)
+	assert.InDelta(t, (9.5-1)/9.5, scores.Values[2], 1e-12)
+	assert.True(t, math.IsNaN(scores.Values[4]))
+	s, err := Silhouette(data, labels, metric.MetricL2Norm)
+	assert.NoError(t, err)
+	assert.InDelta(t, ((10.5-1)/10.5+(9.5-1)/9.5)/2, s, 1e-12)
+
+	data2 := blobs(blobCenters, 20, 0.5, 4)
+	p, ks, err := SelectK(data2, 2, 6, 3, randx.NewSysRand(4))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.K())
+	assert.Equal(t, 5, ks.Len())
+	for i, v := range ks.Values {
+		if i != 1 {
+			assert.Less(t, v, ks.Values[1])
+		}
+	}
+}
+
+func TestPlotPartition(t *testing.T) {
+	dt := table.New()
+	err := dt.OpenCSV("testdata/faces.dat", tensor.Tab)
+	assert.NoError(t, err)
+	in := dt.Column("Input")
+	p, err := KMeans(in, 3, 5, randx.NewSysRand(1))
+	assert.NoError(t, err)
+
+	pt := table.New()
+	PlotPartition(pt, p, in, dt.Column("Name"))
+	assert.Equal(t, 12, pt.NumRows())
+	plt, err := plot.NewTablePlot(pt)
+	assert.NoError(t, err)
+	imagex.Assert(t, plt.RenderImage(), "partition.png")
+}
@@ -5,6 +5,9 @@
 package cluster
 
 import (
+	"strconv"
+
+	"cogentcore.org/lab/matrix"
 	"cogentcore.org/lab/plot"
 	"cogentcore.org/lab/plotcore"
 	"cogentcore.org/lab/stats/metric"
@@ -67,6 +70,89 @@ func Plot(pt *table.Table, root *Node, dmat, labels tensor.Tensor) {
 	})
 }
 
+// PlotPartitionFromTable creates a scatter plot of the clusters of the given
+// [Partition] in given [plotcore.Editor] plot, for the data from given data
+// table in column dataColumn, with optional labels from labelColumn
+// (which can be ""). See [PlotPartition] for details.
+func PlotPartitionFromTable(plt *plotcore.Editor, dt *table.Table, p *Partition, dataColumn, labelColumn string) {
+	pt := table.New()
+	var labels tensor.Tensor
+	if labelColumn != "" {
+		labels = dt.Column(labelColumn)
+	}
+	PlotPartition(pt, p, dt.Column(dataColumn), labels)
+	plt.SetTable(pt)
+}
+
+// PlotPartition sets the rows of given data table to render a scatter plot
+// of the rows (items) of the given data, with a different color for each
+// cluster of the given [Partition], when plotted with a standard plotting
+// package. For data with more than one cell per row, the X and Y values
+// are the projections of the data onto the first two principal components,
+// i.e., the eigenvectors of the covariance matrix with the highest variance.
+// The labels for each item are optional, and can be nil.
+func PlotPartition(pt *table.Table, p *Partition, data, labels tensor.Tensor) {
+	pt.DeleteAll()
+	n, cells := data.Shape().RowCellSize()
+	if data.NumDims() == 1 {
+		n, cells = data.Len(), 1
+	}
+	xc := pt.AddFloat64Column("X")
+	yc := pt.AddFloat64Column("Y")
+	cc := pt.AddStringColumn("Cluster")
+	lc := pt.AddStringColumn("Label")
+	pt.SetNumRows(n)
+	if cells == 1 {
+		for i := range n {
+			xc.SetFloat1D(data.Float1D(i), i)
+		}
+	} else {
+		covar := metric.CovarianceMatrix(metric.Covariance, data)
+		vecs, _ := matrix.SVD(covar)
+		px := matrix.ProjectOnMatrixColumn(vecs, data, tensor.NewIntScalar(0))
+		py := matrix.ProjectOnMatrixColumn(vecs, data, tensor.NewIntScalar(1))
+		for i := range n {
+			xc.SetFloat1D(px.Float1D(i), i)
+			yc.SetFloat1D(py.Float1D(i), i)
+		}
+	}
+	for i := range n {
+		l := p.Labels.Value1D(i)
+		if l < 0 {
+			cc.SetString1D("Noise", i)
+		} else {
+			cc.SetString1D(strconv.Itoa(l), i)
+		}
+		if labels != nil && labels.Len() > i {
+			lc.SetString1D(labels.String1D(i), i)
+		}
+	}
+
+	plot.SetFirstStyler(xc, func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	plot.SetFirstStyler(yc, func(s *plot.Style) {
+		s.On = true
+		s.Role = plot.Y
+		s.Plot.LinesOn = plot.Off
+		s.Plot.PointsOn = plot.On
+	})
+	plot.SetFirstStyler(cc, func(s *plot.Style) {
+		s.On = true
+		s.Role = plot.Split
+	})
+	if labels != nil {
+		plot.SetFirstStyler(lc, func(s *plot.Style) {
+			s.On = true
+			s.Role = plot.Label
+			s.Plotter = "Labels"
+			s.NoLegend = true
+			s.Text.Offset.Y.Dp(8)
+			s.Text.Offset.X.Dp(2)
+		})
+	}
+}
+
 // Plot sets the rows of given data table to trace out lines with labels that
 // will render this node in a cluster plot when plotted with a standard plotting package.
 // The lines double-back on themselves to form a continuous line to be plotted.
@@ -0,0 +1,123 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/tensor"
+)
+
+// SilhouetteScores returns the silhouette score of each row (item) of
+// given data tensor for given cluster labels, using given distance metric
+// (which must increase with distance). The score is (b - a) / max(a, b),
+// where a is the mean distance of the item to the other items in its
+// cluster, and b is the lowest mean distance to the items in another
+// cluster, ranging from -1 to 1, with higher values for items that are
+// well matched to their own cluster. It is 0 for items in clusters of
+// size 1, and NaN for noise items (label -1).
+func SilhouetteScores(data tensor.Tensor, labels *tensor.Int, distMetric metric.Metrics) (*tensor.Float64, error) {
+	xs, _ := items(data)
+	dist, err := distFunc(xs, distMetric)
+	if err != nil {
+		return nil, err
+	}
+	k := 0
+	for _, l := range labels.Values {
+		k = max(k, l+1)
+	}
+	n := len(xs)
+	sizes := make([]int, k)
+	for _, l := range labels.Values {
+		if l >= 0 {
+			sizes[l]++
+		}
+	}
+	scores := tensor.NewFloat64(n)
+	sums := make([]float64, k)
+	for i := range n {
+		li := labels.Values[i]
+		if li < 0 {
+			scores.Values[i] = math.NaN()
+			continue
+		}
+		if sizes[li] == 1 {
+			continue
+		}
+		for c := range sums {
+			sums[c] = 0
+		}
+		for j := range n {
+			if lj := labels.Values[j]; lj >= 0 && j != i {
+				sums[lj] += dist(i, j)
+			}
+		}
+		a := sums[li] / float64(sizes[li]-1)
+		b := math.Inf(1)
+		for c, s := range sums {
+			if c != li && sizes[c] > 0 {
+				b = min(b, s/float64(sizes[c]))
+			}
+		}
+		if !math.IsInf(b, 1) && max(a, b) > 0 {
+			scores.Values[i] = (b - a) / max(a, b)
+		}
+	}
+	return scores, nil
+}
+
+// Silhouette returns the mean silhouette score over the rows (items) of
+// given data tensor for given cluster labels, excluding noise items,
+// using given distance metric (see [SilhouetteScores]). Higher values
+// indicate better separated clusters, so it can be used to choose the
+// number of clusters, as in [SelectK].
+func Silhouette(data tensor.Tensor, labels *tensor.Int, distMetric metric.Metrics) (float64, error) {
+	scores, err := SilhouetteScores(data, labels, distMetric)
+	if err != nil {
+		return 0, err
+	}
+	sum, n := 0.0, 0
+	for _, s := range scores.Values {
+		if !math.IsNaN(s) {
+			sum += s
+			n++
+		}
+	}
+	if n == 0 {
+		return math.NaN(), nil
+	}
+	return sum / float64(n), nil
+}
+
+// SelectK runs [KMeans] for each number of clusters from kMin to kMax
+// (where kMin is at least 2), and returns the result with the highest
+// mean [Silhouette] score using the Euclidean distance, along with
+// the scores for each k, starting at kMin.
+func SelectK(data tensor.Tensor, kMin, kMax, restarts int, rnd randx.Rand) (*Partition, *tensor.Float64, error) {
+	kMin = max(kMin, 2)
+	if kMax < kMin {
+		return nil, nil, ErrTooFew
+	}
+	scores := tensor.NewFloat64(kMax - kMin + 1)
+	var best *Partition
+	bestScore := math.Inf(-1)
+	for k := kMin; k <= kMax; k++ {
+		p, err := KMeans(data, k, restarts, rnd)
+		if err != nil {
+			return nil, nil, err
+		}
+		s, err := Silhouette(data, p.Labels, metric.MetricL2Norm)
+		if err != nil {
+			return nil, nil, err
+		}
+		scores.Values[k-kMin] = s
+		if s > bestScore {
+			best, bestScore = p, s
+		}
+	}
+	return best, scores, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dist
+
+import (
+	"math"
+
+	"cogentcore.org/lab/base/randx"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Normal is the normal (Gaussian) distribution.
+type Normal struct {
+
+	// Mu is the mean.
+	Mu float64
+
+	// Sigma is the standard deviation, which must be > 0.
+	Sigma float64
+}
+
+func (d Normal) dist() distuv.Normal         { return distuv.Normal{Mu: d.Mu, Sigma: d.Sigma} }
+func (d Normal) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Normal) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Normal) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Normal) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Normal) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d Normal) Mean() float64               { return d.Mu }
+func (d Normal) Variance() float64           { return d.Sigma * d.Sigma }
+func (d Normal) Std() float64                { return d.Sigma }
+func (d Normal) Rand(rnd randx.Rand) float64 { return randx.GaussianGen(d.Mu, d.Sigma, source(rnd)) }
+
+// StudentT is Student's t distribution, with location 0 and scale 1.
+type StudentT struct {
+
+	// Nu is the degrees of freedom, which must be > 0.
+	Nu float64
+}
+
+func (d StudentT) dist() distuv.StudentsT     { return distuv.StudentsT{Mu: 0, Sigma: 1, Nu: d.Nu} }
+func (d StudentT) PDF(x float64) float64      { return d.dist().Prob(x) }
+func (d StudentT) LogPDF(x float64) float64   { return d.dist().LogProb(x) }
+func (d StudentT) CDF(x float64) float64      { return d.dist().CDF(x) }
+func (d StudentT) Survival(x float64) float64 { return d.dist().Survival(x) }
+func (d StudentT) Quantile(p float64) float64 { return d.dist().Quantile(p) }
+func (d StudentT) Mean() float64              { return d.dist().Mean() }
+func (d StudentT) Variance() float64          { return d.dist().Variance() }
+func (d StudentT) Std() float64               { return math.Sqrt(d.Variance()) }
+
+func (d StudentT) Rand(rnd randx.Rand) float64 {
+	rnd = source(rnd)
+	return rnd.NormFloat64() / math.Sqrt(randx.GammaGen(d.Nu/2, 0.5, rnd)/d.Nu)
+}
+
+// ChiSquared is the chi-squared distribution of the sum of the squares
+// of K independent standard normal values.
+type ChiSquared struct {
+
+	// K is the degrees of freedom, which must be > 0.
+	K float64
+}
+
+func (d ChiSquared) dist() distuv.ChiSquared     { return distuv.ChiSquared{K: d.K} }
+func (d ChiSquared) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d ChiSquared) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d ChiSquared) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d ChiSquared) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d ChiSquared) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d ChiSquared) Mean() float64               { return d.K }
+func (d ChiSquared) Variance() float64           { return 2 * d.K }
+func (d ChiSquared) Std() float64                { return math.Sqrt(d.Variance()) }
+func (d ChiSquared) Rand(rnd randx.Rand) float64 { return randx.GammaGen(d.K/2, 0.5, source(rnd)) }
+
+// F is the F distribution of the ratio of two chi-squared values,
+// each divided by its degrees of freedom.
+type F struct {
+
+	// D1 is the numerator degrees of freedom, which must be > 0.
+	D1 float64
+
+	// D2 is the denominator degrees of freedom, which must be > 0.
+	D2 float64
+}
+
+func (d F) dist() distuv.F             { return distuv.F{D1: d.D1, D2: d.D2} }
+func (d F) PDF(x float64) float64      { return d.dist().Prob(x) }
+func (d F) LogPDF(x float64) float64   { return d.dist().LogProb(x) }
+func (d F) CDF(x float64) float64      { return d.dist().CDF(x) }
+func (d F) Survival(x float64) float64 { return d.dist().Survival(x) }
+func (d F) Quantile(p float64) float64 { return d.dist().Quantile(p) }
+func (d F) Mean() float64              { return d.dist().Mean() }
+func (d F) Variance() float64          { return d.dist().Variance() }
+func (d F) Std() float64               { return math.Sqrt(d.Variance()) }
+
+func (d F) Rand(rnd randx.Rand) float64 {
+	rnd = source(rnd)
+	return (randx.GammaGen(d.D1/2, 0.5, rnd) / d.D1) / (randx.GammaGen(d.D2/2, 0.5, rnd) / d.D2)
+}
+
+// Gamma is the gamma distribution.
+type Gamma struct {
+
+	// Shape is the shape parameter (alpha or k), which must be > 0.
+	Shape float64
+
+	// Rate is the rate parameter (beta), which is the inverse of
+	// the scale (theta), and must be > 0.
+	Rate float64
+}
+
+func (d Gamma) dist() distuv.Gamma          { return distuv.Gamma{Alpha: d.Shape, Beta: d.Rate} }
+func (d Gamma) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Gamma) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Gamma) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Gamma) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Gamma) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d Gamma) Mean() float64               { return d.Shape / d.Rate }
+func (d Gamma) Variance() float64           { return d.Shape / (d.Rate * d.Rate) }
+func (d Gamma) Std() float64                { return math.Sqrt(d.Variance()) }
+func (d Gamma) Rand(rnd randx.Rand) float64 { return randx.GammaGen(d.Shape, d.Rate, source(rnd)) }
+
+// Beta is the beta distribution on [0, 1].
+type Beta struct {
+
+	// Alpha is the first shape parameter, which must be > 0.
+	Alpha float64
+
+	// Beta is the second shape parameter, which must be > 0.
+	Beta float64
+}
+
+func (d Beta) dist() distuv.Beta           { return distuv.Beta{Alpha: d.Alpha, Beta: d.Beta} }
+func (d Beta) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Beta) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Beta) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Beta) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Beta) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d Beta) Mean() float64               { return d.Alpha / (d.Alpha + d.Beta) }
+func (d Beta) Variance() float64           { return d.dist().Variance() }
+func (d Beta) Std() float64                { return math.Sqrt(d.Variance()) }
+func (d Beta) Rand(rnd randx.Rand) float64 { return randx.BetaGen(d.Alpha, d.Beta, source(rnd)) }
+
+// Exponential is the exponential distribution.
+type Exponential struct {
+
+	// Rate is the rate parameter (lambda), which is the inverse of
+	// the mean, and must be > 0.
+	Rate float64
+}
+
+func (d Exponential) dist() distuv.Exponential    { return distuv.Exponential{Rate: d.Rate} }
+func (d Exponential) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Exponential) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Exponential) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Exponential) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Exponential) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d Exponential) Mean() float64               { return 1 / d.Rate }
+func (d Exponential) Variance() float64           { return 1 / (d.Rate * d.Rate) }
+func (d Exponential) Std() float64                { return 1 / d.Rate }
+func (d Exponential) Rand(rnd randx.Rand) float64 { return source(rnd).ExpFloat64() / d.Rate }
+
+// Uniform is the continuous uniform distribution on [Min, Max].
+type Uniform struct {
+
+	// Min is the minimum value.
+	Min float64
+
+	// Max is the maximum value, which must be > Min.
+	Max float64
+}
+
+func (d Uniform) dist() distuv.Uniform        { return distuv.Uniform{Min: d.Min, Max: d.Max} }
+func (d Uniform) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Uniform) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Uniform) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Uniform) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Uniform) Quantile(p float64) float64  { return d.dist().Quantile(p) }
+func (d Uniform) Mean() float64               { return (d.Min + d.Max) / 2 }
+func (d Uniform) Variance() float64           { return (d.Max - d.Min) * (d.Max - d.Min) / 12 }
+func (d Uniform) Std() float64                { return math.Sqrt(d.Variance()) }
+func (d Uniform) Rand(rnd randx.Rand) float64 { return d.Min + (d.Max-d.Min)*source(rnd).Float64() }
@@ -0,0 +1,62 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dist
+
+import (
+	"math"
+
+	"cogentcore.org/lab/base/randx"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Binomial is the binomial distribution of the number of successes
+// in N independent trials, each with probability P of success.
+// The PDF methods return the probability mass, which is 0 for
+// values that are not integers.
+type Binomial struct {
+
+	// N is the number of trials.
+	N float64
+
+	// P is the probability of success on each trial.
+	P float64
+}
+
+func (d Binomial) dist() distuv.Binomial       { return distuv.Binomial{N: d.N, P: d.P} }
+func (d Binomial) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Binomial) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Binomial) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Binomial) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Binomial) Mean() float64               { return d.N * d.P }
+func (d Binomial) Variance() float64           { return d.N * d.P * (1 - d.P) }
+func (d Binomial) Std() float64                { return math.Sqrt(d.Variance()) }
+func (d Binomial) Rand(rnd randx.Rand) float64 { return randx.BinomialGen(d.N, d.P, source(rnd)) }
+
+func (d Binomial) Quantile(p float64) float64 {
+	return discreteQuantile(d.CDF, p, 0, d.N)
+}
+
+// Poisson is the Poisson distribution of the number of events in an
+// interval, with a mean of Lambda events. The PDF methods return the
+// probability mass, which is 0 for values that are not integers.
+type Poisson struct {
+
+	// Lambda is the mean number of events, which must be > 0.
+	Lambda float64
+}
+
+func (d Poisson) dist() distuv.Poisson        { return distuv.Poisson{Lambda: d.Lambda} }
+func (d Poisson) PDF(x float64) float64       { return d.dist().Prob(x) }
+func (d Poisson) LogPDF(x float64) float64    { return d.dist().LogProb(x) }
+func (d Poisson) CDF(x float64) float64       { return d.dist().CDF(x) }
+func (d Poisson) Survival(x float64) float64  { return d.dist().Survival(x) }
+func (d Poisson) Mean() float64               { return d.Lambda }
+func (d Poisson) Variance() float64           { return d.Lambda }
+func (d Poisson) Std() float64                { return math.Sqrt(d.Lambda) }
+func (d Poisson) Rand(rnd randx.Rand) float64 { return randx.PoissonGen(d.Lambda, source(rnd)) }
+
+func (d Poisson) Quantile(p float64) float64 {
+	return discreteQuantile(d.CDF, p, 0, math.Inf(1))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dist provides standard probability distributions, with methods
+// for the probability density (or mass), cumulative distribution, quantile,
+// moments and random sampling, and tensor-vectorized versions of the
+// density, distribution and quantile functions registered in [tensor.Funcs].
+package dist
+
+import (
+	"math"
+
+	"cogentcore.org/lab/base/randx"
+)
+
+// Dist is a univariate probability distribution.
+type Dist interface {
+
+	// PDF returns the probability density at x, or the probability
+	// mass for discrete distributions.
+	PDF(x float64) float64
+
+	// LogPDF returns the natural log of [Dist.PDF] at x,
+	// which is more accurate for computing likelihoods.
+	LogPDF(x float64) float64
+
+	// CDF returns the cumulative distribution function at x,
+	// which is the probability of a value <= x.
+	CDF(x float64) float64
+
+	// Survival returns the survival function at x, which is the
+	// probability of a value > x, = 1 - CDF(x), computed without the
+	// loss of precision of the subtraction for small probabilities,
+	// as needed for p-values.
+	Survival(x float64) float64
+
+	// Quantile returns the inverse of [Dist.CDF]: the value x with
+	// the given cumulative probability p. For discrete distributions,
+	// it is the smallest value with CDF(x) >= p.
+	Quantile(p float64) float64
+
+	// Mean returns the mean (expected value).
+	Mean() float64
+
+	// Variance returns the variance.
+	Variance() float64
+
+	// Std returns the standard deviation.
+	Std() float64
+
+	// Rand returns a random sample from the distribution, using given
+	// random number source, which can be nil to use the global source.
+	Rand(rnd randx.Rand) float64
+}
+
+// epsilon is the machine epsilon for float64.
+const epsilon = 0x1p-52
+
+// source returns given random number source,
+// or the global source if it is nil.
+func source(rnd randx.Rand) randx.Rand {
+	if rnd == nil {
+		return randx.NewGlobalRand()
+	}
+	return rnd
+}
+
+// discreteQuantile returns the smallest integer x in [lo, hi] with
+// cdf(x) >= p, where hi can be +Inf, using a binary search.
+// As in R, p is reduced by a small relative amount, so that rounding
+// errors in cdf do not result in the next value for exact probabilities.
+func discreteQuantile(cdf func(x float64) float64, p, lo, hi float64) float64 {
+	switch {
+	case math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return lo
+	case p == 1:
+		return hi
+	}
+	p *= 1 - 64*epsilon
+	if math.IsInf(hi, 1) {
+		hi = max(lo, 1)
+		for cdf(hi) < p {
+			lo = hi + 1
+			hi *= 2
+		}
+	}
+	for lo < hi {
+		mid := math.Floor((lo + hi) / 2)
+		if cdf(mid) >= p {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
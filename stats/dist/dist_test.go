@@ -0,0 +1,122 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dist
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinuous(t *testing.T) {
+	tol := 1e-6
+	n := Normal{Mu: 0, Sigma: 1}
+	assert.InDelta(t, 0.3989422804, n.PDF(0), tol)
+	assert.InDelta(t, math.Log(n.PDF(1)), n.LogPDF(1), tol)
+	assert.InDelta(t, 0.9750021, n.CDF(1.96), tol)
+	assert.InDelta(t, 1.959964, n.Quantile(0.975), tol)
+	assert.InDelta(t, 1-n.CDF(3), n.Survival(3), 1e-12)
+
+	st := StudentT{Nu: 10}
+	assert.InDelta(t, 2.228139, st.Quantile(0.975), tol)
+	assert.InDelta(t, 0.975, st.CDF(2.228139), tol)
+	assert.InDelta(t, 10.0/8, st.Variance(), tol)
+
+	cs := ChiSquared{K: 2}
+	assert.InDelta(t, 1-math.Exp(-1.5), cs.CDF(3), tol)
+	assert.InDelta(t, 5.991465, cs.Quantile(0.95), tol)
+
+	f := F{D1: 2, D2: 8}
+	assert.InDelta(t, 0.00011066, f.Survival(35), 1e-8)
+	assert.InDelta(t, 8.0/6, f.Mean(), tol)
+
+	g := Gamma{Shape: 2, Rate: 0.5}
+	assert.Equal(t, 4.0, g.Mean())
+	assert.Equal(t, 8.0, g.Variance())
+	assert.InDelta(t, 1-math.Exp(-1)*2, g.CDF(2), tol)
+
+	b := Beta{Alpha: 2, Beta: 3}
+	assert.InDelta(t, 0.4, b.Mean(), tol)
+	assert.InDelta(t, 1.5, b.PDF(0.5), tol)
+
+	e := Exponential{Rate: 2}
+	assert.Equal(t, 0.5, e.Mean())
+	assert.InDelta(t, math.Ln2/2, e.Quantile(0.5), tol)
+
+	u := Uniform{Min: 1, Max: 3}
+	assert.Equal(t, 0.5, u.CDF(2))
+	assert.Equal(t, 1.5, u.Quantile(0.25))
+	assert.Equal(t, 1.0/3, u.Variance())
+
+	for _, d := range []Dist{n, st, cs, f, g, b, e, u} {
+		for _, p := range []float64{0.01, 0.3, 0.5, 0.9} {
+			assert.InDelta(t, p, d.CDF(d.Quantile(p)), 1e-6)
+		}
+	}
+}
+
+func TestDiscrete(t *testing.T) {
+	b := Binomial{N: 10, P: 0.5}
+	assert.InDelta(t, 252.0/1024, b.PDF(5), 1e-12)
+	assert.InDelta(t, 638.0/1024, b.CDF(5), 1e-12)
+	assert.Equal(t, 0.0, b.PDF(5.5))
+	assert.Equal(t, 5.0, b.Quantile(0.5))
+	assert.Equal(t, 5.0, b.Quantile(638.0/1024))
+	assert.Equal(t, 6.0, b.Quantile(0.6231))
+	assert.Equal(t, 0.0, b.Quantile(0))
+	assert.Equal(t, 10.0, b.Quantile(1))
+	assert.Equal(t, 2.5, b.Variance())
+
+	p := Poisson{Lambda: 3}
+	assert.InDelta(t, 4.5*math.Exp(-3), p.PDF(2), 1e-12)
+	assert.Equal(t, 3.0, p.Quantile(0.5))
+	assert.Equal(t, 8.0, p.Quantile(0.995))
+	assert.Equal(t, 9.0, p.Quantile(0.997))
+	assert.True(t, math.IsInf(p.Quantile(1), 1))
+	assert.True(t, math.IsNaN(p.Quantile(2)))
+}
+
+func TestRand(t *testing.T) {
+	rnd := randx.NewSysRand(1)
+	dists := []Dist{Normal{Mu: 1, Sigma: 2}, StudentT{Nu: 10}, ChiSquared{K: 3}, F{D1: 5, D2: 20},
+		Gamma{Shape: 2, Rate: 0.5}, Beta{Alpha: 2, Beta: 3}, Binomial{N: 20, P: 0.3},
+		Poisson{Lambda: 4}, Exponential{Rate: 2}, Uniform{Min: -1, Max: 1}}
+	n := 50000
+	for _, d := range dists {
+		sum, ss := 0.0, 0.0
+		for range n {
+			x := d.Rand(rnd)
+			sum += x
+			ss += x * x
+		}
+		mean := sum / float64(n)
+		vr := ss/float64(n) - mean*mean
+		assert.InDelta(t, d.Mean(), mean, 4*d.Std()/math.Sqrt(float64(n)), "%T", d)
+		assert.InDelta(t, d.Variance(), vr, 0.1*d.Variance(), "%T", d)
+	}
+	assert.NotPanics(t, func() { Normal{Sigma: 1}.Rand(nil) })
+}
+
+func TestFuncs(t *testing.T) {
+	x := tensor.NewFloat64FromValues(-1, 0, 1.96)
+	cdf := NormalCDF(x, tensor.NewFloat64Scalar(0), tensor.NewFloat64Scalar(1))
+	assert.Equal(t, []int{3}, cdf.ShapeSizes())
+	assert.InDelta(t, 0.5, cdf.Float1D(1), 1e-12)
+	assert.InDelta(t, 0.9750021, cdf.Float1D(2), 1e-6)
+
+	// per-value parameters
+	pdf := PoissonPDF(tensor.NewFloat64FromValues(2, 2), tensor.NewFloat64FromValues(3, 4))
+	assert.InDelta(t, 4.5*math.Exp(-3), pdf.Float1D(0), 1e-12)
+	assert.InDelta(t, 8*math.Exp(-4), pdf.Float1D(1), 1e-12)
+
+	q := ChiSquaredQuantile(tensor.NewFloat64Scalar(0.95), tensor.NewFloat64Scalar(2))
+	assert.InDelta(t, 5.991465, q.Float1D(0), 1e-6)
+
+	assert.Nil(t, NormalPDF(x, tensor.NewFloat64FromValues(0, 1), tensor.NewFloat64Scalar(1)))
+	assert.Contains(t, tensor.Funcs, "dist.BetaLogPDF")
+}
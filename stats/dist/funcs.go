@@ -0,0 +1,383 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dist
+
+import (
+	"fmt"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+)
+
+func init() {
+	tensor.AddFunc("dist.NormalPDF", NormalPDF)
+	tensor.AddFunc("dist.NormalLogPDF", NormalLogPDF)
+	tensor.AddFunc("dist.NormalCDF", NormalCDF)
+	tensor.AddFunc("dist.NormalQuantile", NormalQuantile)
+	tensor.AddFunc("dist.StudentTPDF", StudentTPDF)
+	tensor.AddFunc("dist.StudentTLogPDF", StudentTLogPDF)
+	tensor.AddFunc("dist.StudentTCDF", StudentTCDF)
+	tensor.AddFunc("dist.StudentTQuantile", StudentTQuantile)
+	tensor.AddFunc("dist.ChiSquaredPDF", ChiSquaredPDF)
+	tensor.AddFunc("dist.ChiSquaredLogPDF", ChiSquaredLogPDF)
+	tensor.AddFunc("dist.ChiSquaredCDF", ChiSquaredCDF)
+	tensor.AddFunc("dist.ChiSquaredQuantile", ChiSquaredQuantile)
+	tensor.AddFunc("dist.FPDF", FPDF)
+	tensor.AddFunc("dist.FLogPDF", FLogPDF)
+	tensor.AddFunc("dist.FCDF", FCDF)
+	tensor.AddFunc("dist.FQuantile", FQuantile)
+	tensor.AddFunc("dist.GammaPDF", GammaPDF)
+	tensor.AddFunc("dist.GammaLogPDF", GammaLogPDF)
+	tensor.AddFunc("dist.GammaCDF", GammaCDF)
+	tensor.AddFunc("dist.GammaQuantile", GammaQuantile)
+	tensor.AddFunc("dist.BetaPDF", BetaPDF)
+	tensor.AddFunc("dist.BetaLogPDF", BetaLogPDF)
+	tensor.AddFunc("dist.BetaCDF", BetaCDF)
+	tensor.AddFunc("dist.BetaQuantile", BetaQuantile)
+	tensor.AddFunc("dist.BinomialPDF", BinomialPDF)
+	tensor.AddFunc("dist.BinomialLogPDF", BinomialLogPDF)
+	tensor.AddFunc("dist.BinomialCDF", BinomialCDF)
+	tensor.AddFunc("dist.BinomialQuantile", BinomialQuantile)
+	tensor.AddFunc("dist.PoissonPDF", PoissonPDF)
+	tensor.AddFunc("dist.PoissonLogPDF", PoissonLogPDF)
+	tensor.AddFunc("dist.PoissonCDF", PoissonCDF)
+	tensor.AddFunc("dist.PoissonQuantile", PoissonQuantile)
+	tensor.AddFunc("dist.ExponentialPDF", ExponentialPDF)
+	tensor.AddFunc("dist.ExponentialLogPDF", ExponentialLogPDF)
+	tensor.AddFunc("dist.ExponentialCDF", ExponentialCDF)
+	tensor.AddFunc("dist.ExponentialQuantile", ExponentialQuantile)
+	tensor.AddFunc("dist.UniformPDF", UniformPDF)
+	tensor.AddFunc("dist.UniformLogPDF", UniformLogPDF)
+	tensor.AddFunc("dist.UniformCDF", UniformCDF)
+	tensor.AddFunc("dist.UniformQuantile", UniformQuantile)
+}
+
+// vectorize returns a Float64 tensor with the shape of x, with the result of
+// calling given method on the distribution made by given function from the
+// parameter values for each value of x. Each parameter tensor must have either
+// one value, which is used for all values of x, or the same number of values as x.
+func vectorize(fun func(d Dist, x float64) float64, mk func(p []float64) Dist, x tensor.Tensor, pars ...tensor.Tensor) tensor.Values {
+	n := x.Len()
+	for _, p := range pars {
+		if p.Len() != 1 && p.Len() != n {
+			errors.Log(fmt.Errorf("dist: parameters must have 1 or %d values, not %d", n, p.Len()))
+			return nil
+		}
+	}
+	out := tensor.NewFloat64(x.ShapeSizes()...)
+	tensor.VectorizeThreaded(50, func(tsr ...tensor.Tensor) int { return n },
+		func(idx int, tsr ...tensor.Tensor) {
+			p := make([]float64, len(pars))
+			for i, pt := range pars {
+				if pt.Len() == 1 {
+					p[i] = pt.Float1D(0)
+				} else {
+					p[i] = pt.Float1D(idx)
+				}
+			}
+			out.Values[idx] = fun(mk(p), tsr[0].Float1D(idx))
+		}, x)
+	return out
+}
+
+func newNormal(p []float64) Dist { return Normal{Mu: p[0], Sigma: p[1]} }
+
+// NormalPDF returns the probability density of the [Normal] distribution
+// at each value of x, for given mu and sigma parameters,
+// which can each be a scalar or have the same number of values as x.
+func NormalPDF(x, mu, sigma tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newNormal, x, mu, sigma)
+}
+
+// NormalLogPDF returns the log of the probability density of the [Normal] distribution
+// at each value of x, for given mu and sigma parameters,
+// which can each be a scalar or have the same number of values as x.
+func NormalLogPDF(x, mu, sigma tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newNormal, x, mu, sigma)
+}
+
+// NormalCDF returns the cumulative distribution function of the [Normal] distribution
+// at each value of x, for given mu and sigma parameters,
+// which can each be a scalar or have the same number of values as x.
+func NormalCDF(x, mu, sigma tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newNormal, x, mu, sigma)
+}
+
+// NormalQuantile returns the quantile (inverse CDF) of the [Normal] distribution
+// at each probability in prob, for given mu and sigma parameters,
+// which can each be a scalar or have the same number of values as prob.
+func NormalQuantile(prob, mu, sigma tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newNormal, prob, mu, sigma)
+}
+
+func newStudentT(p []float64) Dist { return StudentT{Nu: p[0]} }
+
+// StudentTPDF returns the probability density of the [StudentT] distribution
+// at each value of x, for given nu parameter,
+// which can be a scalar or have the same number of values as x.
+func StudentTPDF(x, nu tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newStudentT, x, nu)
+}
+
+// StudentTLogPDF returns the log of the probability density of the [StudentT] distribution
+// at each value of x, for given nu parameter,
+// which can be a scalar or have the same number of values as x.
+func StudentTLogPDF(x, nu tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newStudentT, x, nu)
+}
+
+// StudentTCDF returns the cumulative distribution function of the [StudentT] distribution
+// at each value of x, for given nu parameter,
+// which can be a scalar or have the same number of values as x.
+func StudentTCDF(x, nu tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newStudentT, x, nu)
+}
+
+// StudentTQuantile returns the quantile (inverse CDF) of the [StudentT] distribution
+// at each probability in prob, for given nu parameter,
+// which can be a scalar or have the same number of values as prob.
+func StudentTQuantile(prob, nu tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newStudentT, prob, nu)
+}
+
+func newChiSquared(p []float64) Dist { return ChiSquared{K: p[0]} }
+
+// ChiSquaredPDF returns the probability density of the [ChiSquared] distribution
+// at each value of x, for given k parameter,
+// which can be a scalar or have the same number of values as x.
+func ChiSquaredPDF(x, k tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newChiSquared, x, k)
+}
+
+// ChiSquaredLogPDF returns the log of the probability density of the [ChiSquared] distribution
+// at each value of x, for given k parameter,
+// which can be a scalar or have the same number of values as x.
+func ChiSquaredLogPDF(x, k tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newChiSquared, x, k)
+}
+
+// ChiSquaredCDF returns the cumulative distribution function of the [ChiSquared] distribution
+// at each value of x, for given k parameter,
+// which can be a scalar or have the same number of values as x.
+func ChiSquaredCDF(x, k tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newChiSquared, x, k)
+}
+
+// ChiSquaredQuantile returns the quantile (inverse CDF) of the [ChiSquared] distribution
+// at each probability in prob, for given k parameter,
+// which can be a scalar or have the same number of values as prob.
+func ChiSquaredQuantile(prob, k tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newChiSquared, prob, k)
+}
+
+func newF(p []float64) Dist { return F{D1: p[0], D2: p[1]} }
+
+// FPDF returns the probability density of the [F] distribution
+// at each value of x, for given d1 and d2 parameters,
+// which can each be a scalar or have the same number of values as x.
+func FPDF(x, d1, d2 tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newF, x, d1, d2)
+}
+
+// FLogPDF returns the log of the probability density of the [F] distribution
+// at each value of x, for given d1 and d2 parameters,
+// which can each be a scalar or have the same number of values as x.
+func FLogPDF(x, d1, d2 tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newF, x, d1, d2)
+}
+
+// FCDF returns the cumulative distribution function of the [F] distribution
+// at each value of x, for given d1 and d2 parameters,
+// which can each be a scalar or have the same number of values as x.
+func FCDF(x, d1, d2 tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newF, x, d1, d2)
+}
+
+// FQuantile returns the quantile (inverse CDF) of the [F] distribution
+// at each probability in prob, for given d1 and d2 parameters,
+// which can each be a scalar or have the same number of values as prob.
+func FQuantile(prob, d1, d2 tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newF, prob, d1, d2)
+}
+
+func newGamma(p []float64) Dist { return Gamma{Shape: p[0], Rate: p[1]} }
+
+// GammaPDF returns the probability density of the [Gamma] distribution
+// at each value of x, for given shape and rate parameters,
+// which can each be a scalar or have the same number of values as x.
+func GammaPDF(x, shape, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newGamma, x, shape, rate)
+}
+
+// GammaLogPDF returns the log of the probability density of the [Gamma] distribution
+// at each value of x, for given shape and rate parameters,
+// which can each be a scalar or have the same number of values as x.
+func GammaLogPDF(x, shape, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newGamma, x, shape, rate)
+}
+
+// GammaCDF returns the cumulative distribution function of the [Gamma] distribution
+// at each value of x, for given shape and rate parameters,
+// which can each be a scalar or have the same number of values as x.
+func GammaCDF(x, shape, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newGamma, x, shape, rate)
+}
+
+// GammaQuantile returns the quantile (inverse CDF) of the [Gamma] distribution
+// at each probability in prob, for given shape and rate parameters,
+// which can each be a scalar or have the same number of values as prob.
+func GammaQuantile(prob, shape, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newGamma, prob, shape, rate)
+}
+
+func newBeta(p []float64) Dist { return Beta{Alpha: p[0], Beta: p[1]} }
+
+// BetaPDF returns the probability density of the [Beta] distribution
+// at each value of x, for given alpha and beta parameters,
+// which can each be a scalar or have the same number of values as x.
+func BetaPDF(x, alpha, beta tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newBeta, x, alpha, beta)
+}
+
+// BetaLogPDF returns the log of the probability density of the [Beta] distribution
+// at each value of x, for given alpha and beta parameters,
+// which can each be a scalar or have the same number of values as x.
+func BetaLogPDF(x, alpha, beta tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newBeta, x, alpha, beta)
+}
+
+// BetaCDF returns the cumulative distribution function of the [Beta] distribution
+// at each value of x, for given alpha and beta parameters,
+// which can each be a scalar or have the same number of values as x.
+func BetaCDF(x, alpha, beta tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newBeta, x, alpha, beta)
+}
+
+// BetaQuantile returns the quantile (inverse CDF) of the [Beta] distribution
+// at each probability in prob, for given alpha and beta parameters,
+// which can each be a scalar or have the same number of values as prob.
+func BetaQuantile(prob, alpha, beta tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newBeta, prob, alpha, beta)
+}
+
+func newBinomial(p []float64) Dist { return Binomial{N: p[0], P: p[1]} }
+
+// BinomialPDF returns the probability mass of the [Binomial] distribution
+// at each value of x, for given n and p parameters,
+// which can each be a scalar or have the same number of values as x.
+func BinomialPDF(x, n, p tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newBinomial, x, n, p)
+}
+
+// BinomialLogPDF returns the log of the probability mass of the [Binomial] distribution
+// at each value of x, for given n and p parameters,
+// which can each be a scalar or have the same number of values as x.
+func BinomialLogPDF(x, n, p tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newBinomial, x, n, p)
+}
+
+// BinomialCDF returns the cumulative distribution function of the [Binomial] distribution
+// at each value of x, for given n and p parameters,
+// which can each be a scalar or have the same number of values as x.
+func BinomialCDF(x, n, p tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newBinomial, x, n, p)
+}
+
+// BinomialQuantile returns the quantile (inverse CDF) of the [Binomial] distribution
+// at each probability in prob, for given n and p parameters,
+// which can each be a scalar or have the same number of values as prob.
+func BinomialQuantile(prob, n, p tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newBinomial, prob, n, p)
+}
+
+func newPoisson(p []float64) Dist { return Poisson{Lambda: p[0]} }
+
+// PoissonPDF returns the probability mass of the [Poisson] distribution
+// at each value of x, for given lambda parameter,
+// which can be a scalar or have the same number of values as x.
+func PoissonPDF(x, lambda tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newPoisson, x, lambda)
+}
+
+// PoissonLogPDF returns the log of the probability mass of the [Poisson] distribution
+// at each value of x, for given lambda parameter,
+// which can be a scalar or have the same number of values as x.
+func PoissonLogPDF(x, lambda tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newPoisson, x, lambda)
+}
+
+// PoissonCDF returns the cumulative distribution function of the [Poisson] distribution
+// at each value of x, for given lambda parameter,
+// which can be a scalar or have the same number of values as x.
+func PoissonCDF(x, lambda tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newPoisson, x, lambda)
+}
+
+// PoissonQuantile returns the quantile (inverse CDF) of the [Poisson] distribution
+// at each probability in prob, for given lambda parameter,
+// which can be a scalar or have the same number of values as prob.
+func PoissonQuantile(prob, lambda tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newPoisson, prob, lambda)
+}
+
+func newExponential(p []float64) Dist { return Exponential{Rate: p[0]} }
+
+// ExponentialPDF returns the probability density of the [Exponential] distribution
+// at each value of x, for given rate parameter,
+// which can be a scalar or have the same number of values as x.
+func ExponentialPDF(x, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newExponential, x, rate)
+}
+
+// ExponentialLogPDF returns the log of the probability density of the [Exponential] distribution
+// at each value of x, for given rate parameter,
+// which can be a scalar or have the same number of values as x.
+func ExponentialLogPDF(x, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newExponential, x, rate)
+}
+
+// ExponentialCDF returns the cumulative distribution function of the [Exponential] distribution
+// at each value of x, for given rate parameter,
+// which can be a scalar or have the same number of values as x.
+func ExponentialCDF(x, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newExponential, x, rate)
+}
+
+// ExponentialQuantile returns the quantile (inverse CDF) of the [Exponential] distribution
+// at each probability in prob, for given rate parameter,
+// which can be a scalar or have the same number of values as prob.
+func ExponentialQuantile(prob, rate tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newExponential, prob, rate)
+}
+
+func newUniform(p []float64) Dist { return Uniform{Min: p[0], Max: p[1]} }
+
+// UniformPDF returns the probability density of the [Uniform] distribution
+// at each value of x, for given min and max parameters,
+// which can each be a scalar or have the same number of values as x.
+func UniformPDF(x, min, max tensor.Tensor) tensor.Values {
+	return vectorize(Dist.PDF, newUniform, x, min, max)
+}
+
+// UniformLogPDF returns the log of the probability density of the [Uniform] distribution
+// at each value of x, for given min and max parameters,
+// which can each be a scalar or have the same number of values as x.
+func UniformLogPDF(x, min, max tensor.Tensor) tensor.Values {
+	return vectorize(Dist.LogPDF, newUniform, x, min, max)
+}
+
+// UniformCDF returns the cumulative distribution function of the [Uniform] distribution
+// at each value of x, for given min and max parameters,
+// which can each be a scalar or have the same number of values as x.
+func UniformCDF(x, min, max tensor.Tensor) tensor.Values {
+	return vectorize(Dist.CDF, newUniform, x, min, max)
+}
+
+// UniformQuantile returns the quantile (inverse CDF) of the [Uniform] distribution
+// at each probability in prob, for given min and max parameters,
+// which can each be a scalar or have the same number of values as prob.
+func UniformQuantile(prob, min, max tensor.Tensor) tensor.Values {
+	return vectorize(Dist.Quantile, newUniform, prob, min, max)
+}
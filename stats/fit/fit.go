@@ -0,0 +1,381 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fit provides nonlinear least squares curve fitting using the
+// Levenberg-Marquardt algorithm, for arbitrary model functions and a
+// library of common built-in models.
+package fit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/tensor"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrTooFew is returned when there are not more valid observations
+	// than parameters to fit.
+	ErrTooFew = errors.New("fit: not enough observations for the number of parameters")
+
+	// ErrSingular is returned when the parameters cannot be determined
+	// from the data, because the model does not depend on some parameter
+	// (or combination of parameters) at the fitted values.
+	ErrSingular = errors.New("fit: parameters are not identifiable from the data")
+)
+
+// Func is a model function that returns the value of the model
+// at given x for given parameters.
+type Func func(x float64, params []float64) float64
+
+// Options are the options for fitting, used by [Options.Curve]
+// and [Options.Model].
+type Options struct {
+
+	// MaxIters is the maximum number of iterations.
+	MaxIters int `default:"200"`
+
+	// Tolerance is the tolerance for stopping: the fit has converged when
+	// the relative decrease in the sum of squared residuals, or the relative
+	// change in every parameter, is less than this value.
+	Tolerance float64 `default:"1e-10"`
+
+	// Lambda is the initial damping factor of the Levenberg-Marquardt
+	// algorithm, which is decreased by a factor of 10 after each step that
+	// reduces the sum of squared residuals, and increased by a factor of 10
+	// otherwise. Larger values take smaller steps along the gradient, while
+	// smaller values take Gauss-Newton steps.
+	Lambda float64 `default:"0.001"`
+
+	// Weights are optional weights for each observation, e.g., 1 / variance,
+	// with the same number of values as x and y. Observations with a weight
+	// of 0 or NaN are excluded.
+	Weights tensor.Tensor
+}
+
+// NewOptions returns new Options with default values.
+func NewOptions() *Options {
+	o := &Options{}
+	o.Defaults()
+	return o
+}
+
+func (o *Options) Defaults() {
+	o.MaxIters = 200
+	o.Tolerance = 1e-10
+	o.Lambda = 0.001
+}
+
+// Result is the result of fitting a model function to data.
+type Result struct {
+
+	// Func is the model function that was fit.
+	Func Func
+
+	// Model is the built-in model that was fit, if fit using [Model.Fit]
+	// or [Options.Model], and nil otherwise.
+	Model *Model
+
+	// Names are the names of the parameters, from the Model,
+	// or P0, P1, etc. otherwise.
+	Names []string
+
+	// Params are the fitted parameter values.
+	Params []float64
+
+	// StdErr are the asymptotic standard errors of the parameters,
+	// which are the square roots of the diagonal of Cov.
+	StdErr []float64
+
+	// Cov is the asymptotic covariance matrix of the parameters,
+	// estimated as Sigma² (Jᵀ W J)⁻¹, where J is the Jacobian of the
+	// model function with respect to the parameters at the fitted values.
+	Cov *tensor.Float64
+
+	// Fitted are the values of the fitted model for each x value,
+	// which are NaN for excluded observations.
+	Fitted *tensor.Float64
+
+	// Residuals are the residuals y - Fitted for each observation,
+	// which are NaN for excluded observations.
+	Residuals *tensor.Float64
+
+	// SSE is the (weighted) sum of the squared residuals.
+	SSE float64
+
+	// R2 is the proportion of the (weighted) variance in y
+	// accounted for by the model, 1 - SSE / SST.
+	R2 float64
+
+	// Sigma is the residual standard error, sqrt(SSE / DF).
+	Sigma float64
+
+	// N is the number of observations used in the fit.
+	N int
+
+	// DF is the residual degrees of freedom, N minus the number of parameters.
+	DF int
+
+	// Iters is the number of iterations used.
+	Iters int
+
+	// Converged is whether the fit converged within MaxIters iterations.
+	Converged bool
+}
+
+// Curve fits the model function to the data y as a function of x,
+// which must be 1D with the same number of values, starting from the
+// given initial parameter values, using default [Options].
+// Observations with missing (NaN) values are excluded.
+// Good initial values are important for nonlinear models, which can
+// have multiple local minima.
+func Curve(fun Func, x, y tensor.Tensor, params []float64) (*Result, error) {
+	return NewOptions().Curve(fun, x, y, params)
+}
+
+// Curve fits the model function to the data y as a function of x,
+// which must be 1D with the same number of values, starting from the
+// given initial parameter values, using these options.
+// Observations with missing (NaN) values are excluded.
+func (o *Options) Curve(fun Func, x, y tensor.Tensor, params []float64) (*Result, error) {
+	if len(params) == 0 {
+		return nil, errors.New("fit: initial parameter values must be provided")
+	}
+	names := make([]string, len(params))
+	for i := range names {
+		names[i] = fmt.Sprintf("P%d", i)
+	}
+	return o.fit(fun, names, x, y, params)
+}
+
+// fit fits the model function to the data.
+func (o *Options) fit(fun Func, names []string, x, y tensor.Tensor, params []float64) (*Result, error) {
+	n := x.Len()
+	if y.Len() != n || (o.Weights != nil && o.Weights.Len() != n) {
+		return nil, fmt.Errorf("fit: number of values in y (%d) and weights must equal the number in x (%d)", y.Len(), n)
+	}
+	for _, v := range params {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("fit: initial parameter values %v must be finite", params)
+		}
+	}
+	var xs, ys, ws []float64
+	var rows []int
+	for i := range n {
+		xv, yv, wv := x.Float1D(i), y.Float1D(i), 1.0
+		if o.Weights != nil {
+			wv = o.Weights.Float1D(i)
+		}
+		if math.IsNaN(xv) || math.IsNaN(yv) || math.IsNaN(wv) || wv <= 0 {
+			continue
+		}
+		xs = append(xs, xv)
+		ys = append(ys, yv)
+		ws = append(ws, wv)
+		rows = append(rows, i)
+	}
+	m, p := len(xs), len(params)
+	if m <= p {
+		return nil, ErrTooFew
+	}
+
+	b := make([]float64, p)
+	copy(b, params)
+	sse := func(b []float64) float64 {
+		s := 0.0
+		for k, xv := range xs {
+			r := ys[k] - fun(xv, b)
+			s += ws[k] * r * r
+		}
+		return s
+	}
+	res := &Result{Func: fun, Names: names}
+	cur := sse(b)
+	if math.IsNaN(cur) || math.IsInf(cur, 0) {
+		return nil, fmt.Errorf("fit: model is not finite at the initial parameter values %v", params)
+	}
+	lambda := o.Lambda
+	nb := make([]float64, p)
+	g := mat.NewVecDense(p, nil)
+	step := mat.NewVecDense(p, nil)
+	for res.Iters = 1; res.Iters <= o.MaxIters; res.Iters++ {
+		jac := jacobian(fun, xs, b)
+		a := normalMatrix(jac, ws)
+		for j := range p {
+			v := 0.0
+			for k, xv := range xs {
+				v += jac.At(k, j) * ws[k] * (ys[k] - fun(xv, b))
+			}
+			g.SetVec(j, v)
+		}
+		improved := false
+		for lambda <= 1e16 {
+			damped := mat.NewSymDense(p, nil)
+			damped.CopySym(a)
+			for j := range p {
+				d := a.At(j, j)
+				damped.SetSym(j, j, d+lambda*max(d, 1e-12))
+			}
+			var ch mat.Cholesky
+			if ch.Factorize(damped) && ch.SolveVecTo(step, g) == nil {
+				for j := range p {
+					nb[j] = b[j] + step.AtVec(j)
+				}
+				if ns := sse(nb); ns <= cur {
+					improved = true
+					small := true
+					for j := range p {
+						if math.Abs(step.AtVec(j)) > o.Tolerance*(math.Abs(b[j])+o.Tolerance) {
+							small = false
+						}
+					}
+					conv := small || cur-ns <= o.Tolerance*ns
+					copy(b, nb)
+					cur = ns
+					lambda = max(lambda/10, 1e-12)
+					if conv {
+						res.Converged = true
+					}
+					break
+				}
+			}
+			lambda *= 10
+		}
+		if !improved { // no downhill step at machine precision: at the minimum
+			res.Converged = true
+		}
+		if res.Converged {
+			break
+		}
+	}
+	res.Iters = min(res.Iters, o.MaxIters)
+
+	res.Params = b
+	res.N = m
+	res.DF = m - p
+	res.SSE = cur
+	res.Sigma = math.Sqrt(cur / float64(res.DF))
+	sw, swy := 0.0, 0.0
+	for k, v := range ys {
+		sw += ws[k]
+		swy += ws[k] * v
+	}
+	sst := 0.0
+	for k, v := range ys {
+		d := v - swy/sw
+		sst += ws[k] * d * d
+	}
+	res.R2 = 1 - cur/sst
+
+	a := normalMatrix(jacobian(fun, xs, b), ws)
+	at := tensor.NewFloat64(p, p)
+	for i := range p {
+		for j := range p {
+			at.Values[i*p+j] = a.At(i, j)
+		}
+	}
+	res.Cov = tensor.NewFloat64(p, p)
+	if err := matrix.InverseOut(at, res.Cov); err != nil {
+		return nil, ErrSingular
+	}
+	s2 := res.Sigma * res.Sigma
+	res.StdErr = make([]float64, p)
+	for i, v := range res.Cov.Values {
+		res.Cov.Values[i] = s2 * v
+	}
+	for j := range p {
+		res.StdErr[j] = math.Sqrt(res.Cov.Values[j*p+j])
+	}
+
+	res.Fitted = tensor.NewFloat64(n)
+	res.Residuals = tensor.NewFloat64(n)
+	for i := range n {
+		res.Fitted.Values[i] = math.NaN()
+		res.Residuals.Values[i] = math.NaN()
+	}
+	for k, i := range rows {
+		f := fun(xs[k], b)
+		res.Fitted.Values[i] = f
+		res.Residuals.Values[i] = ys[k] - f
+	}
+	return res, nil
+}
+
+// jacobian returns the Jacobian matrix of the model function with respect
+// to the parameters at each x value, using central differences.
+func jacobian(fun Func, xs, b []float64) *mat.Dense {
+	p := len(b)
+	jac := mat.NewDense(len(xs), p, nil)
+	pb := make([]float64, p)
+	copy(pb, b)
+	for j := range p {
+		h := 6e-6 * max(math.Abs(b[j]), 1e-3) // cube root of machine epsilon
+		pb[j] = b[j] + h
+		hp := pb[j] - b[j]
+		for k, xv := range xs {
+			jac.Set(k, j, fun(xv, pb))
+		}
+		pb[j] = b[j] - h
+		hm := b[j] - pb[j]
+		for k, xv := range xs {
+			jac.Set(k, j, (jac.At(k, j)-fun(xv, pb))/(hp+hm))
+		}
+		pb[j] = b[j]
+	}
+	return jac
+}
+
+// normalMatrix returns Jᵀ W J for given Jacobian and weights.
+func normalMatrix(jac *mat.Dense, ws []float64) *mat.SymDense {
+	m, p := jac.Dims()
+	a := mat.NewSymDense(p, nil)
+	for i := range p {
+		for j := i; j < p; j++ {
+			v := 0.0
+			for k := range m {
+				v += ws[k] * jac.At(k, i) * jac.At(k, j)
+			}
+			a.SetSym(i, j, v)
+		}
+	}
+	return a
+}
+
+// Eval returns the value of the fitted model at given x,
+// e.g., for plotting the fitted curve with plots.NewFunction.
+func (res *Result) Eval(x float64) float64 {
+	return res.Func(x, res.Params)
+}
+
+// Predict returns the values of the fitted model for each value of x.
+func (res *Result) Predict(x tensor.Tensor) *tensor.Float64 {
+	n := x.Len()
+	out := tensor.NewFloat64(n)
+	for i := range n {
+		out.Values[i] = res.Eval(x.Float1D(i))
+	}
+	return out
+}
+
+// String returns a summary of the fit, with a row for each parameter.
+func (res *Result) String() string {
+	var b strings.Builder
+	if res.Model != nil {
+		b.WriteString(res.Model.String() + "\n")
+	}
+	wd := len("Param")
+	for _, nm := range res.Names {
+		wd = max(wd, len(nm))
+	}
+	fmt.Fprintf(&b, "%-*s  %10s  %10s\n", wd, "Param", "Estimate", "Std Err")
+	for j, nm := range res.Names {
+		fmt.Fprintf(&b, "%-*s  %10.4g  %10.4g\n", wd, nm, res.Params[j], res.StdErr[j])
+	}
+	fmt.Fprintf(&b, "Sigma = %.4g, DF = %d, R2 = %.4g, N = %d, Iters = %d, Converged = %v\n", res.Sigma, res.DF, res.R2, res.N, res.Iters, res.Converged)
+	return b.String()
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fit
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/glm"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// modelData returns n values of the function over [lo, hi] with
+// normally distributed noise of given standard deviation.
+func modelData(fun Func, params []float64, n int, lo, hi, sd float64, seed int64) (x, y *tensor.Float64) {
+	rnd := randx.NewSysRand(seed)
+	x, y = tensor.NewFloat64(n), tensor.NewFloat64(n)
+	for i := range n {
+		xv := lo + (hi-lo)*float64(i)/float64(n-1)
+		x.Values[i] = xv
+		y.Values[i] = fun(xv, params) + sd*rnd.NormFloat64()
+	}
+	return
+}
+
+func TestCurveLinear(t *testing.T) {
+	// a straight line fit must match ordinary least squares
+	line := func(x float64, p []float64) float64 { return p[0] + p[1]*x }
+	x, y := modelData(line, []float64{2, 0.5}, 30, 0, 10, 0.3, 1)
+	res, err := Curve(line, x, y, []float64{0, 0})
+	assert.NoError(t, err)
+	assert.True(t, res.Converged)
+
+	lm := glm.NewLinearModel()
+	assert.NoError(t, lm.Fit(x, y, nil))
+	for j := range 2 {
+		assert.InDelta(t, lm.Coeff[j], res.Params[j], 1e-8)
+		assert.InDelta(t, lm.StdErr[j], res.StdErr[j], 1e-6)
+	}
+	assert.InDelta(t, lm.R2, res.R2, 1e-10)
+	assert.InDelta(t, lm.Sigma, res.Sigma, 1e-10)
+	assert.Equal(t, []string{"P0", "P1"}, res.Names)
+	assert.Equal(t, 28, res.DF)
+	assert.InDelta(t, res.Cov.Value(0, 1), res.Cov.Value(1, 0), 1e-15)
+	for i := range 30 {
+		assert.InDelta(t, y.Values[i]-res.Fitted.Values[i], res.Residuals.Values[i], 1e-15)
+	}
+}
+
+func TestModels(t *testing.T) {
+	tests := []struct {
+		model  *Model
+		params []float64
+		lo, hi float64
+	}{
+		{Exponential, []float64{5, 0.3, 1}, 0, 20},
+		{Exponential, []float64{-3, 0.8, 2}, 1, 8},
+		{Logistic, []float64{4, 1.5, 3, 0.5}, -2, 10},
+		{Logistic, []float64{-1, 0.5, 10, 1}, 0, 20},
+		{Gaussian, []float64{3, 2, 0.7, 1}, -2, 6},
+		{Gaussian, []float64{-2, 5, 2, 0}, 0, 10},
+		{PowerLaw, []float64{4, 1.2, 0.5}, 0.5, 20},
+		{PowerLaw, []float64{0.5, -0.5, 1}, 1, 100},
+	}
+	for _, tc := range tests {
+		x, y := modelData(tc.model.Func, tc.params, 60, tc.lo, tc.hi, 0.02, 2)
+		res, err := tc.model.Fit(x, y)
+		assert.NoError(t, err, tc.model.Name)
+		assert.True(t, res.Converged, tc.model.Name)
+		assert.Equal(t, tc.model, res.Model)
+		assert.Equal(t, tc.model.ParamNames, res.Names)
+		assert.Greater(t, res.R2, 0.99, tc.model.Name)
+		for j, p := range tc.params {
+			// within 4 standard errors of the true values
+			assert.InDelta(t, p, res.Params[j], 4*res.StdErr[j], "%s %s", tc.model.Name, res.Names[j])
+		}
+		assert.InDelta(t, 0.02, res.Sigma, 0.005, tc.model.Name)
+		assert.InDelta(t, res.Fitted.Values[10], res.Eval(x.Values[10]), 1e-15)
+	}
+
+	// exact data is recovered exactly
+	x, y := modelData(Logistic.Func, []float64{4, 1.5, 3, 0.5}, 20, -2, 10, 0, 0)
+	res, err := Logistic.Fit(x, y)
+	assert.NoError(t, err)
+	for j, p := range []float64{4, 1.5, 3, 0.5} {
+		assert.InDelta(t, p, res.Params[j], 1e-6)
+	}
+	assert.InDelta(t, 1, res.R2, 1e-12)
+}
+
+func TestCurveMissing(t *testing.T) {
+	x, y := modelData(Exponential.Func, []float64{5, 0.3, 1}, 40, 0, 20, 0.05, 3)
+	xm, ym := x.Clone().(*tensor.Float64), y.Clone().(*tensor.Float64)
+	ym.Values[3] = math.NaN()
+	xm.Values[7] = math.NaN()
+	res, err := Exponential.Fit(xm, ym)
+	assert.NoError(t, err)
+	assert.Equal(t, 38, res.N)
+	assert.True(t, math.IsNaN(res.Fitted.Values[3]))
+	assert.True(t, math.IsNaN(res.Residuals.Values[7]))
+
+	// zero weights are equivalent to missing values
+	w := tensor.NewFloat64(40)
+	for i := range w.Values {
+		if i != 3 && i != 7 {
+			w.Values[i] = 1
+		}
+	}
+	opts := NewOptions()
+	opts.Weights = w
+	wres, err := opts.Model(Exponential, x, y)
+	assert.NoError(t, err)
+	assert.Equal(t, 38, wres.N)
+	for j := range 3 {
+		assert.InDelta(t, res.Params[j], wres.Params[j], 1e-8)
+		assert.InDelta(t, res.StdErr[j], wres.StdErr[j], 1e-6)
+	}
+
+	_, err = Exponential.Fit(tensor.NewFloat64FromValues(1, 2, 3), tensor.NewFloat64FromValues(3, 2, 1))
+	assert.ErrorIs(t, err, ErrTooFew)
+	_, err = Curve(Exponential.Func, x, tensor.NewFloat64(3), []float64{1, 1, 1})
+	assert.Error(t, err)
+
+	// the model does not depend on the second parameter
+	_, err = Curve(func(x float64, p []float64) float64 { return p[0] * x }, x, y, []float64{1, 1})
+	assert.ErrorIs(t, err, ErrSingular)
+}
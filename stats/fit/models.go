@@ -0,0 +1,251 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fit
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// Model is a model function with named parameters and a function to
+// guess initial parameter values from the data, so that it can be fit
+// without providing initial values, using [Model.Fit].
+type Model struct {
+
+	// Name is the name of the model.
+	Name string
+
+	// Expr is the expression for the model in terms of x and the
+	// parameter names, for documentation.
+	Expr string
+
+	// ParamNames are the names of the parameters.
+	ParamNames []string
+
+	// Func is the model function.
+	Func Func
+
+	// Guess returns initial parameter values for given x, y data,
+	// which are sorted by x and have no missing values.
+	Guess func(x, y []float64) []float64
+}
+
+// String returns the name and expression of the model.
+func (m *Model) String() string {
+	return m.Name + ": y = " + m.Expr
+}
+
+// Fit fits the model to the data y as a function of x, which must be
+// 1D with the same number of values, starting from initial parameter
+// values guessed from the data, using default [Options].
+// Use [Curve] with the model Func to start from other parameter values.
+func (m *Model) Fit(x, y tensor.Tensor) (*Result, error) {
+	return NewOptions().Model(m, x, y)
+}
+
+// Model fits the given model to the data y as a function of x, which
+// must be 1D with the same number of values, starting from initial
+// parameter values guessed from the data, using these options.
+func (o *Options) Model(m *Model, x, y tensor.Tensor) (*Result, error) {
+	n := x.Len()
+	if y.Len() != n {
+		return nil, fmt.Errorf("fit: number of values in y (%d) must equal the number in x (%d)", y.Len(), n)
+	}
+	var pts [][2]float64
+	for i := range n {
+		xv, yv := x.Float1D(i), y.Float1D(i)
+		if o.Weights != nil && !(o.Weights.Float1D(i) > 0) {
+			continue
+		}
+		if !math.IsNaN(xv) && !math.IsNaN(yv) {
+			pts = append(pts, [2]float64{xv, yv})
+		}
+	}
+	if len(pts) <= len(m.ParamNames) {
+		return nil, ErrTooFew
+	}
+	sort.SliceStable(pts, func(i, j int) bool { return pts[i][0] < pts[j][0] })
+	xs := make([]float64, len(pts))
+	ys := make([]float64, len(pts))
+	for i, pt := range pts {
+		xs[i], ys[i] = pt[0], pt[1]
+	}
+	res, err := o.fit(m.Func, m.ParamNames, x, y, m.Guess(xs, ys))
+	if err != nil {
+		return nil, err
+	}
+	res.Model = m
+	return res, nil
+}
+
+var (
+	// Exponential is exponential decay (Rate > 0) or growth (Rate < 0)
+	// toward a baseline: Amp * exp(-Rate * x) + Base.
+	Exponential = &Model{
+		Name:       "Exponential",
+		Expr:       "Amp * exp(-Rate * x) + Base",
+		ParamNames: []string{"Amp", "Rate", "Base"},
+		Func: func(x float64, p []float64) float64 {
+			return p[0]*math.Exp(-p[1]*x) + p[2]
+		},
+		Guess: guessExponential,
+	}
+
+	// Logistic is a sigmoid function rising (Slope > 0) or falling
+	// (Slope < 0) from Base to Base + Amp, centered at Mid:
+	// Base + Amp / (1 + exp(-Slope * (x - Mid))).
+	Logistic = &Model{
+		Name:       "Logistic",
+		Expr:       "Base + Amp / (1 + exp(-Slope * (x - Mid)))",
+		ParamNames: []string{"Amp", "Slope", "Mid", "Base"},
+		Func: func(x float64, p []float64) float64 {
+			return p[3] + p[0]/(1+math.Exp(-p[1]*(x-p[2])))
+		},
+		Guess: guessLogistic,
+	}
+
+	// Gaussian is a Gaussian peak (or dip, for Amp < 0) with given
+	// Mean and standard deviation Sigma, on a baseline:
+	// Base + Amp * exp(-(x - Mean)^2 / (2 * Sigma^2)).
+	Gaussian = &Model{
+		Name:       "Gaussian",
+		Expr:       "Base + Amp * exp(-(x - Mean)^2 / (2 * Sigma^2))",
+		ParamNames: []string{"Amp", "Mean", "Sigma", "Base"},
+		Func: func(x float64, p []float64) float64 {
+			d := (x - p[1]) / p[2]
+			return p[3] + p[0]*math.Exp(-0.5*d*d)
+		},
+		Guess: guessGaussian,
+	}
+
+	// PowerLaw is a power law decay (Exp > 0) or growth (Exp < 0)
+	// for positive x, with a baseline: Amp * x^(-Exp) + Base.
+	PowerLaw = &Model{
+		Name:       "PowerLaw",
+		Expr:       "Amp * x^(-Exp) + Base",
+		ParamNames: []string{"Amp", "Exp", "Base"},
+		Func: func(x float64, p []float64) float64 {
+			return p[0]*math.Pow(x, -p[1]) + p[2]
+		},
+		Guess: guessPowerLaw,
+	}
+
+	// Models are the built-in models.
+	Models = []*Model{Exponential, Logistic, Gaussian, PowerLaw}
+)
+
+// ends returns the mean y values of the first and last tenth of the
+// data (at least one point each).
+func ends(y []float64) (first, last float64) {
+	n := len(y)
+	k := max(n/10, 1)
+	for i := range k {
+		first += y[i]
+		last += y[n-1-i]
+	}
+	return first / float64(k), last / float64(k)
+}
+
+// crossing returns the first x value, searching from the start of the data
+// (or from the end if reverse), where y crosses given level, using linear
+// interpolation, and NaN if it does not cross.
+func crossing(x, y []float64, level float64, reverse bool) float64 {
+	n := len(x)
+	for k := 1; k < n; k++ {
+		i, j := k-1, k
+		if reverse {
+			i, j = n-k, n-k-1
+		}
+		if (y[i]-level)*(y[j]-level) <= 0 && y[i] != y[j] {
+			return x[i] + (level-y[i])*(x[j]-x[i])/(y[j]-y[i])
+		}
+	}
+	return math.NaN()
+}
+
+// span returns the range of the sorted x values, which is 1 if 0.
+func span(x []float64) float64 {
+	if r := x[len(x)-1] - x[0]; r > 0 {
+		return r
+	}
+	return 1
+}
+
+func guessExponential(x, y []float64) []float64 {
+	first, base := ends(y)
+	rate := 3 / span(x)
+	if h := crossing(x, y, base+0.5*(first-base), false); !math.IsNaN(h) && h > x[0] {
+		rate = math.Ln2 / (h - x[0])
+	}
+	return []float64{(first - base) * math.Exp(rate*x[0]), rate, base}
+}
+
+func guessLogistic(x, y []float64) []float64 {
+	base, last := ends(y)
+	amp := last - base
+	mid := crossing(x, y, base+0.5*amp, false)
+	if math.IsNaN(mid) {
+		mid = x[0] + 0.5*span(x)
+	}
+	slope := 8 / span(x)
+	lo, hi := crossing(x, y, base+0.25*amp, false), crossing(x, y, base+0.75*amp, true)
+	if w := hi - lo; w > 0 {
+		slope = 2 * math.Log(3) / w
+	}
+	return []float64{amp, slope, mid, base}
+}
+
+func guessGaussian(x, y []float64) []float64 {
+	first, last := ends(y)
+	base := 0.5 * (first + last)
+	peak := 0
+	for i, v := range y {
+		if math.Abs(v-base) > math.Abs(y[peak]-base) {
+			peak = i
+		}
+	}
+	amp := y[peak] - base
+	half := base + 0.5*amp
+	sigma := span(x) / 6
+	lo := crossing(x[:peak+1], y[:peak+1], half, true)
+	hi := crossing(x[peak:], y[peak:], half, false)
+	switch {
+	case !math.IsNaN(lo) && !math.IsNaN(hi):
+		sigma = (hi - lo) / 2.3548
+	case !math.IsNaN(lo):
+		sigma = (x[peak] - lo) / 1.1774
+	case !math.IsNaN(hi):
+		sigma = (hi - x[peak]) / 1.1774
+	}
+	return []float64{amp, x[peak], max(sigma, 1e-3*span(x)), base}
+}
+
+// guessPowerLaw uses linear regression of log(y - Base) on log(x),
+// for a Base just below the minimum y value.
+func guessPowerLaw(x, y []float64) []float64 {
+	lo, hi := slices.Min(y), slices.Max(y)
+	base := lo - 0.1*(hi-lo) - 1e-10
+	var n, sx, sy, sxx, sxy float64
+	for i, xv := range x {
+		if xv <= 0 {
+			continue
+		}
+		lx, ly := math.Log(xv), math.Log(y[i]-base)
+		n++
+		sx += lx
+		sy += ly
+		sxx += lx * lx
+		sxy += lx * ly
+	}
+	if n < 2 || n*sxx-sx*sx == 0 {
+		return []float64{hi - lo, 1, base}
+	}
+	slope := (n*sxy - sx*sy) / (n*sxx - sx*sx)
+	return []float64{math.Exp((sy - slope*sx) / n), -slope, base}
+}
@@ -0,0 +1,46 @@
+// Code generated by "core generate"; DO NOT EDIT.
+
+package glm
+
+import (
+	"cogentcore.org/core/enums"
+)
+
+var _FamilyValues = []Family{0, 1, 2}
+
+// FamilyN is the highest valid value for type Family, plus one.
+const FamilyN Family = 3
+
+var _FamilyValueMap = map[string]Family{`Gaussian`: 0, `Binomial`: 1, `Poisson`: 2}
+
+var _FamilyDescMap = map[Family]string{0: `Gaussian has normally distributed errors with an identity link, which is equivalent to the [LinearModel].`, 1: `Binomial has binomially distributed responses with the logit link, i.e., logistic regression. The response is the proportion of successes in [0, 1], e.g., 0 or 1 for binary data such as accuracy, and the weights are the number of trials.`, 2: `Poisson has Poisson distributed counts with the log link.`}
+
+var _FamilyMap = map[Family]string{0: `Gaussian`, 1: `Binomial`, 2: `Poisson`}
+
+// String returns the string representation of this Family value.
+func (i Family) String() string { return enums.String(i, _FamilyMap) }
+
+// SetString sets the Family value from its string representation,
+// and returns an error if the string is invalid.
+func (i *Family) SetString(s string) error { return enums.SetString(i, s, _FamilyValueMap, "Family") }
+
+// Int64 returns the Family value as an int64.
+func (i Family) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the Family value from an int64.
+func (i *Family) SetInt64(in int64) { *i = Family(in) }
+
+// Desc returns the description of the Family value.
+func (i Family) Desc() string { return enums.Desc(i, _FamilyDescMap) }
+
+// FamilyValues returns all possible values for the type Family.
+func FamilyValues() []Family { return _FamilyValues }
+
+// Values returns all possible values for the type Family.
+func (i Family) Values() []enums.Enum { return enums.Values(_FamilyValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i Family) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *Family) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "Family") }
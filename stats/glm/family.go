@@ -0,0 +1,136 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+//go:generate core generate
+
+import (
+	"math"
+)
+
+// Family is the error distribution family of a [GeneralizedModel],
+// which determines the link function relating the linear predictor
+// to the mean of the response, and the variance as a function of the mean.
+type Family int32 //enums:enum
+
+const (
+	// Gaussian has normally distributed errors with an identity link,
+	// which is equivalent to the [LinearModel].
+	Gaussian Family = iota
+
+	// Binomial has binomially distributed responses with the logit link,
+	// i.e., logistic regression. The response is the proportion of successes
+	// in [0, 1], e.g., 0 or 1 for binary data such as accuracy,
+	// and the weights are the number of trials.
+	Binomial
+
+	// Poisson has Poisson distributed counts with the log link.
+	Poisson
+)
+
+// link returns the linear predictor for given mean.
+func (f Family) link(mu float64) float64 {
+	switch f {
+	case Binomial:
+		return math.Log(mu / (1 - mu))
+	case Poisson:
+		return math.Log(mu)
+	}
+	return mu
+}
+
+// linkInv returns the mean for given linear predictor.
+func (f Family) linkInv(eta float64) float64 {
+	switch f {
+	case Binomial:
+		const thresh = 30
+		eta = min(max(eta, -thresh), thresh)
+		return 1 / (1 + math.Exp(-eta))
+	case Poisson:
+		return max(math.Exp(eta), epsilon)
+	}
+	return eta
+}
+
+// muEta returns the derivative of the mean with respect to the linear predictor.
+func (f Family) muEta(eta float64) float64 {
+	switch f {
+	case Binomial:
+		mu := f.linkInv(eta)
+		return max(mu*(1-mu), epsilon)
+	case Poisson:
+		return max(math.Exp(eta), epsilon)
+	}
+	return 1
+}
+
+// variance returns the variance of the response as a function of the mean.
+func (f Family) variance(mu float64) float64 {
+	switch f {
+	case Binomial:
+		return mu * (1 - mu)
+	case Poisson:
+		return mu
+	}
+	return 1
+}
+
+// start returns the initial mean for given response and weight.
+func (f Family) start(y, w float64) float64 {
+	switch f {
+	case Binomial:
+		return (w*y + 0.5) / (w + 1)
+	case Poisson:
+		return y + 0.1
+	}
+	return y
+}
+
+// deviance returns the deviance of given response and weight for given mean.
+func (f Family) deviance(y, mu, w float64) float64 {
+	switch f {
+	case Binomial:
+		return 2 * w * (ylogy(y, mu) + ylogy(1-y, 1-mu))
+	case Poisson:
+		return 2 * w * (ylogy(y, mu) - (y - mu))
+	}
+	d := y - mu
+	return w * d * d
+}
+
+// logLik returns the log likelihood of given response and weight for given mean,
+// with the given dispersion for the Gaussian family.
+func (f Family) logLik(y, mu, w, disp float64) float64 {
+	switch f {
+	case Binomial:
+		n := math.Round(w)
+		k := math.Round(w * y)
+		return lchoose(n, k) + k*math.Log(mu) + (n-k)*math.Log(1-mu)
+	case Poisson:
+		lg, _ := math.Lgamma(y + 1)
+		return w * (y*math.Log(mu) - mu - lg)
+	}
+	d := y - mu
+	return 0.5 * (math.Log(w/(2*math.Pi*disp)) - w*d*d/disp)
+}
+
+// epsilon is the minimum value for means and derivatives.
+const epsilon = 2.220446e-16
+
+// ylogy returns y * log(y / mu), which is 0 for y = 0.
+func ylogy(y, mu float64) float64 {
+	if y <= 0 {
+		return 0
+	}
+	return y * math.Log(y/mu)
+}
+
+// lchoose returns the log of the binomial coefficient n choose k.
+func lchoose(n, k float64) float64 {
+	a, _ := math.Lgamma(n + 1)
+	b, _ := math.Lgamma(k + 1)
+	c, _ := math.Lgamma(n - k + 1)
+	return a - b - c
+}
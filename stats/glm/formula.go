@@ -0,0 +1,408 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+)
+
+// Formula is a model formula in the Wilkinson-Rogers notation used by R,
+// e.g., "Err ~ Epoch + Cond", specifying a response (dependent) variable
+// as a linear function of terms made from columns of a [table.Table].
+// The supported operators on the right hand side are:
+//   - a + b includes both terms.
+//   - a:b is the interaction of a and b, i.e., their product.
+//   - a*b expands to a + b + a:b (and so on for more factors).
+//   - - 1 or + 0 removes the intercept, and - a removes term a.
+//
+// Numeric columns are used directly, with a term for each cell of
+// multi-cell columns. String columns are categorical, using treatment
+// (dummy) coding relative to the first level in sorted order,
+// with empty strings as missing values.
+type Formula struct {
+
+	// Response is the name of the response (dependent) variable column.
+	Response string
+
+	// Terms are the terms of the model, each of which is a list of one
+	// or more column names whose values are multiplied (an interaction).
+	Terms [][]string
+
+	// Intercept is whether the model includes an intercept term.
+	Intercept bool
+
+	// Levels are the sorted levels of each categorical (string) column,
+	// which are set from the data by the first call to [Formula.Design],
+	// so that the same coding is used for subsequent calls, e.g., to
+	// predict values for new data.
+	Levels map[string][]string
+}
+
+// ParseFormula parses the given formula string, e.g., "Err ~ Epoch + Cond".
+// Column names cannot contain spaces or the formula operators.
+func ParseFormula(formula string) (*Formula, error) {
+	lhs, rhs, ok := strings.Cut(formula, "~")
+	if !ok || strings.Contains(rhs, "~") {
+		return nil, fmt.Errorf("glm: formula %q must have exactly one ~", formula)
+	}
+	f := &Formula{Response: strings.TrimSpace(lhs), Intercept: true}
+	if !validName(f.Response) {
+		return nil, fmt.Errorf("glm: invalid response %q in formula %q", f.Response, formula)
+	}
+	var remove [][]string
+	sign := 1
+	start := 0
+	rhs += "+"
+	for i, c := range rhs {
+		if c != '+' && c != '-' {
+			continue
+		}
+		part := strings.TrimSpace(rhs[start:i])
+		start = i + 1
+		if part == "" {
+			if i > 0 && strings.TrimSpace(rhs[:i]) != "" {
+				return nil, fmt.Errorf("glm: missing term in formula %q", formula)
+			}
+		} else {
+			switch part {
+			case "1":
+				f.Intercept = sign > 0
+			case "0":
+				f.Intercept = sign < 0
+			default:
+				terms, err := expandTerm(part)
+				if err != nil {
+					return nil, fmt.Errorf("glm: %w in formula %q", err, formula)
+				}
+				if sign > 0 {
+					f.Terms = append(f.Terms, terms...)
+				} else {
+					remove = append(remove, terms...)
+				}
+			}
+		}
+		sign = 1
+		if c == '-' {
+			sign = -1
+		}
+	}
+	var terms [][]string
+	for _, t := range f.Terms {
+		if !containsTerm(terms, t) && !containsTerm(remove, t) {
+			terms = append(terms, t)
+		}
+	}
+	// lower order terms come first, as in R
+	slices.SortStableFunc(terms, func(a, b []string) int { return len(a) - len(b) })
+	f.Terms = terms
+	return f, nil
+}
+
+// expandTerm expands a term with * and : operators into its component terms.
+func expandTerm(part string) ([][]string, error) {
+	var factors [][]string
+	for _, fs := range strings.Split(part, "*") {
+		var vars []string
+		for _, v := range strings.Split(fs, ":") {
+			v = strings.TrimSpace(v)
+			if !validName(v) {
+				return nil, fmt.Errorf("invalid term %q", part)
+			}
+			vars = append(vars, v)
+		}
+		factors = append(factors, vars)
+	}
+	var terms [][]string
+	for sub := 1; sub < 1<<len(factors); sub++ {
+		var t []string
+		for i, vars := range factors {
+			if sub&(1<<i) == 0 {
+				continue
+			}
+			for _, v := range vars {
+				if !slices.Contains(t, v) {
+					t = append(t, v)
+				}
+			}
+		}
+		terms = append(terms, t)
+	}
+	slices.SortStableFunc(terms, func(a, b []string) int { return len(a) - len(b) })
+	return terms, nil
+}
+
+// validName returns whether given column name is valid in a formula.
+func validName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " \t~+-*:()")
+}
+
+// containsTerm returns whether given list of terms contains the given term,
+// with the same variables in any order.
+func containsTerm(terms [][]string, term []string) bool {
+	for _, t := range terms {
+		if len(t) != len(term) {
+			continue
+		}
+		same := true
+		for _, v := range term {
+			if !slices.Contains(t, v) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the formula in standard notation.
+func (f *Formula) String() string {
+	var terms []string
+	if !f.Intercept {
+		terms = append(terms, "0")
+	}
+	for _, t := range f.Terms {
+		terms = append(terms, strings.Join(t, ":"))
+	}
+	if len(terms) == 0 {
+		terms = append(terms, "1")
+	}
+	return f.Response + " ~ " + strings.Join(terms, " + ")
+}
+
+// ResponseValues returns the values of the response variable from given
+// table, which must be a numeric column with one value per row.
+func (f *Formula) ResponseValues(dt *table.Table) (*tensor.Float64, error) {
+	col, err := dt.ColumnTry(f.Response)
+	if err != nil {
+		return nil, err
+	}
+	if col.IsString() {
+		return nil, fmt.Errorf("glm: response %q must be numeric", f.Response)
+	}
+	if _, cells := col.Shape().RowCellSize(); cells != 1 {
+		return nil, fmt.Errorf("glm: response %q must have one value per row", f.Response)
+	}
+	n := dt.NumRows()
+	y := tensor.NewFloat64(n)
+	for i := range n {
+		y.Values[i] = col.FloatRow(i, 0)
+	}
+	return y, nil
+}
+
+// Design returns the design matrix for the terms of the formula applied
+// to given table, with a row for each row of the table and a column for each
+// coefficient of the model, along with the names of the coefficients.
+// The intercept is the first column, named "Intercept", if included.
+// Interactions are named with the component names joined by ":", and
+// categorical levels are named by the column name followed by the level,
+// e.g., "CondB". Missing values are NaN.
+func (f *Formula) Design(dt *table.Table) (*tensor.Float64, []string, error) {
+	n := dt.NumRows()
+	var cols [][]float64
+	var names []string
+	if f.Intercept {
+		one := make([]float64, n)
+		for i := range one {
+			one[i] = 1
+		}
+		cols = append(cols, one)
+		names = append(names, "Intercept")
+	}
+	for _, t := range f.Terms {
+		tcols := [][]float64{nil}
+		tnames := []string{""}
+		for _, v := range t {
+			vcols, vnames, err := f.variable(dt, v)
+			if err != nil {
+				return nil, nil, err
+			}
+			var pcols [][]float64
+			var pnames []string
+			for i, tc := range tcols {
+				for j, vc := range vcols {
+					pc := slices.Clone(vc)
+					if tc != nil {
+						for r := range pc {
+							pc[r] *= tc[r]
+						}
+					}
+					pcols = append(pcols, pc)
+					if tnames[i] == "" {
+						pnames = append(pnames, vnames[j])
+					} else {
+						pnames = append(pnames, tnames[i]+":"+vnames[j])
+					}
+				}
+			}
+			tcols, tnames = pcols, pnames
+		}
+		cols = append(cols, tcols...)
+		names = append(names, tnames...)
+	}
+	x := tensor.NewFloat64(n, len(cols))
+	for j, c := range cols {
+		for i, v := range c {
+			x.Values[i*len(cols)+j] = v
+		}
+	}
+	return x, names, nil
+}
+
+// variable returns the design matrix columns for given table column.
+func (f *Formula) variable(dt *table.Table, name string) ([][]float64, []string, error) {
+	col, err := dt.ColumnTry(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	n := dt.NumRows()
+	_, cells := col.Shape().RowCellSize()
+	if !col.IsString() {
+		cols := make([][]float64, cells)
+		names := make([]string, cells)
+		for c := range cells {
+			cols[c] = make([]float64, n)
+			for i := range n {
+				cols[c][i] = col.FloatRow(i, c)
+			}
+			names[c] = name
+			if cells > 1 {
+				names[c] = fmt.Sprintf("%s[%d]", name, c)
+			}
+		}
+		return cols, names, nil
+	}
+	if cells != 1 {
+		return nil, nil, fmt.Errorf("glm: categorical column %q must have one value per row", name)
+	}
+	levels, ok := f.Levels[name]
+	if !ok {
+		for i := range n {
+			if s := col.StringRow(i, 0); s != "" && !slices.Contains(levels, s) {
+				levels = append(levels, s)
+			}
+		}
+		slices.Sort(levels)
+		if f.Levels == nil {
+			f.Levels = map[string][]string{}
+		}
+		f.Levels[name] = levels
+	}
+	if len(levels) < 2 {
+		return nil, nil, fmt.Errorf("glm: categorical column %q must have at least 2 levels", name)
+	}
+	cols := make([][]float64, len(levels)-1)
+	names := make([]string, len(levels)-1)
+	for l := range cols {
+		cols[l] = make([]float64, n)
+		names[l] = name + levels[l+1]
+	}
+	for i := range n {
+		li := slices.Index(levels, col.StringRow(i, 0))
+		for l := range cols {
+			switch {
+			case li < 0:
+				cols[l][i] = math.NaN()
+			case li == l+1:
+				cols[l][i] = 1
+			}
+		}
+	}
+	return cols, names, nil
+}
+
+// designTensor returns the design matrix for independent variables x,
+// which is 1D for a single variable, or has the variables in the inner
+// dimension(s), with an initial column of 1s for the intercept if included,
+// along with the names of the coefficients.
+func designTensor(x tensor.Tensor, intercept bool) (*tensor.Float64, []string) {
+	n, nv := x.Shape().RowCellSize()
+	if x.NumDims() == 1 {
+		n, nv = x.Len(), 1
+	}
+	off := 0
+	var names []string
+	if intercept {
+		off = 1
+		names = append(names, "Intercept")
+	}
+	for i := range nv {
+		names = append(names, fmt.Sprintf("X%d", i))
+	}
+	p := nv + off
+	dx := tensor.NewFloat64(n, p)
+	for i := range n {
+		if intercept {
+			dx.Values[i*p] = 1
+		}
+		for j := range nv {
+			dx.Values[i*p+off+j] = x.Float1D(i*nv + j)
+		}
+	}
+	return dx, names
+}
+
+// observations returns the rows of given design matrix, values and
+// optional weights (1 if nil) that have no missing (NaN) values and
+// a positive weight, along with the indexes of these rows.
+// It returns [ErrTooFew] if there are not more rows than columns.
+func observations(x *tensor.Float64, y, w tensor.Tensor) (*tensor.Float64, []float64, []float64, []int, error) {
+	n, p := x.DimSize(0), x.DimSize(1)
+	if y.Len() != n || (w != nil && w.Len() != n) {
+		return nil, nil, nil, nil, fmt.Errorf("glm: number of values in y (%d) and weights must equal the number of rows in x (%d)", y.Len(), n)
+	}
+	var rows []int
+	var pw []float64
+	for i := range n {
+		wi := 1.0
+		if w != nil {
+			wi = w.Float1D(i)
+		}
+		ok := !math.IsNaN(y.Float1D(i)) && !math.IsNaN(wi) && wi > 0
+		for j := range p {
+			if math.IsNaN(x.Values[i*p+j]) {
+				ok = false
+			}
+		}
+		if ok {
+			rows = append(rows, i)
+			pw = append(pw, wi)
+		}
+	}
+	m := len(rows)
+	if m <= p {
+		return nil, nil, nil, nil, ErrTooFew
+	}
+	xs := tensor.NewFloat64(m, p)
+	ys := make([]float64, m)
+	for k, i := range rows {
+		copy(xs.Values[k*p:(k+1)*p], x.Values[i*p:(i+1)*p])
+		ys[k] = y.Float1D(i)
+	}
+	return xs, ys, pw, rows, nil
+}
+
+// mulCoeff returns the product of the design matrix and the coefficients.
+func mulCoeff(x *tensor.Float64, coeff []float64) *tensor.Float64 {
+	n, p := x.DimSize(0), x.DimSize(1)
+	out := tensor.NewFloat64(n)
+	for i := range n {
+		v := 0.0
+		for j := range p {
+			v += x.Values[i*p+j] * coeff[j]
+		}
+		out.Values[i] = v
+	}
+	return out
+}
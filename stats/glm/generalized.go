@@ -0,0 +1,346 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GeneralizedModel is a generalized linear model of a single dependent
+// variable, with a response distribution and link function given by the
+// [Family], e.g., logistic regression for binary data with [Binomial],
+// or log-linear regression for counts with [Poisson]. It is fit by
+// iteratively reweighted least squares (IRLS), using the QR decomposition
+// as in [LinearModel]. Make a NewGeneralizedModel and then use
+// [GeneralizedModel.Fit] on tensors, or [GeneralizedModel.FitTable] with
+// a [Formula] on the columns of a [table.Table]. See [Multinomial] for
+// responses with more than two categories.
+// Observations with missing (NaN) values are excluded from the fit.
+type GeneralizedModel struct {
+
+	// Formula is the model formula used by [GeneralizedModel.FitTable],
+	// which is nil for models fit with [GeneralizedModel.Fit].
+	Formula *Formula
+
+	// Names are the names of the coefficients, starting with
+	// "Intercept" if an intercept is included.
+	Names []string
+
+	// Coeff are the fitted coefficients, on the scale of the
+	// linear predictor, e.g., the log odds for [Binomial].
+	Coeff []float64
+
+	// StdErr are the standard errors of the coefficients.
+	StdErr []float64
+
+	// Z are the Wald statistics of the coefficients, Coeff / StdErr,
+	// which are z statistics, or t statistics for the [Gaussian]
+	// family where the dispersion is estimated.
+	Z []float64
+
+	// P are the two-sided p values of the Z statistics.
+	P []float64
+
+	// CILow and CIHigh are the lower and upper bounds of the Wald
+	// confidence intervals of the coefficients, at the Confidence level.
+	CILow, CIHigh []float64
+
+	// Deviance is the residual deviance of the model, i.e., twice the
+	// difference in log likelihood between a saturated model and this model.
+	Deviance float64
+
+	// NullDeviance is the deviance of the model with only an intercept
+	// (or no coefficients when there is no intercept).
+	NullDeviance float64
+
+	// AIC is the Akaike information criterion, -2 * LogLik + 2 * number
+	// of parameters, where the dispersion is a parameter for [Gaussian].
+	AIC float64
+
+	// LogLik is the log likelihood of the model.
+	LogLik float64
+
+	// Dispersion is the dispersion parameter, which is 1 for [Binomial]
+	// and [Poisson], and the estimated error variance for [Gaussian].
+	Dispersion float64
+
+	// N is the number of observations used in the fit.
+	N int
+
+	// DF is the residual degrees of freedom, N minus the number of coefficients.
+	DF int
+
+	// NullDF is the degrees of freedom of the NullDeviance.
+	NullDF int
+
+	// Iters is the number of IRLS iterations used.
+	Iters int
+
+	// Converged is whether the fit converged within MaxIters iterations.
+	// Fits to data that are perfectly separated by the predictors do not
+	// converge, with coefficients becoming very large.
+	Converged bool
+
+	// Fitted are the fitted mean values for each observation,
+	// e.g., the probability for [Binomial].
+	Fitted *tensor.Float64
+
+	//////// Parameters for the model fitting:
+
+	// Family is the error distribution family and link function.
+	Family Family
+
+	// ZeroOffset restricts the linear predictor to have no intercept.
+	// This is only used by [GeneralizedModel.Fit]: the formula determines
+	// the intercept for [GeneralizedModel.FitTable].
+	ZeroOffset bool
+
+	// Confidence is the confidence level for the CILow and CIHigh intervals.
+	Confidence float64 `default:"0.95"`
+
+	// MaxIters is the maximum number of IRLS iterations.
+	MaxIters int `default:"25"`
+
+	// StopTolerance is the tolerance on the relative change in the deviance
+	// across iterations to stop iterating.
+	StopTolerance float64 `default:"1e-8"`
+
+	// intercept is whether the fit model has an intercept.
+	intercept bool
+}
+
+func NewGeneralizedModel(family Family) *GeneralizedModel {
+	gm := &GeneralizedModel{}
+	gm.Defaults()
+	gm.Family = family
+	return gm
+}
+
+func (gm *GeneralizedModel) Defaults() {
+	gm.Confidence = 0.95
+	gm.MaxIters = 25
+	gm.StopTolerance = 1e-8
+}
+
+// Fit fits the model to predict the dependent variable y, with one value
+// for each row of the independent variables x, which is 1D for a single
+// variable, or has the variables in the inner dimension(s). The optional
+// weights w give the prior weight of each observation, which is the number
+// of trials for [Binomial], and can be nil for weights of 1.
+func (gm *GeneralizedModel) Fit(x, y, w tensor.Tensor) error {
+	gm.intercept = !gm.ZeroOffset
+	dx, names := designTensor(x, gm.intercept)
+	gm.Formula = nil
+	return gm.fit(dx, names, y, w)
+}
+
+// FitTable fits the model given by the formula (see [Formula]) to the
+// columns of given table, e.g., "Correct ~ Epoch + Cond". The optional
+// weights column gives the prior weight of each row, and can be ""
+// for weights of 1.
+func (gm *GeneralizedModel) FitTable(dt *table.Table, formula, weights string) error {
+	f, err := ParseFormula(formula)
+	if err != nil {
+		return err
+	}
+	x, names, err := f.Design(dt)
+	if err != nil {
+		return err
+	}
+	y, err := f.ResponseValues(dt)
+	if err != nil {
+		return err
+	}
+	var w tensor.Tensor
+	if weights != "" {
+		wc, err := dt.ColumnTry(weights)
+		if err != nil {
+			return err
+		}
+		w = wc
+	}
+	gm.intercept = f.Intercept
+	gm.Formula = f
+	return gm.fit(x, names, y, w)
+}
+
+// Predict returns the mean values predicted by the model fit with
+// [GeneralizedModel.Fit] for given independent variables, in the same
+// format as for the fit, e.g., the probability for [Binomial].
+func (gm *GeneralizedModel) Predict(x tensor.Tensor) *tensor.Float64 {
+	dx, _ := designTensor(x, gm.intercept)
+	return gm.linkInv(mulCoeff(dx, gm.Coeff))
+}
+
+// PredictTable returns the mean values predicted by the model fit with
+// [GeneralizedModel.FitTable] for each row of given table, which must have
+// the columns used in the formula (except the response).
+func (gm *GeneralizedModel) PredictTable(dt *table.Table) (*tensor.Float64, error) {
+	if gm.Formula == nil {
+		return nil, errors.New("glm: PredictTable requires a model fit with FitTable")
+	}
+	x, _, err := gm.Formula.Design(dt)
+	if err != nil {
+		return nil, err
+	}
+	return gm.linkInv(mulCoeff(x, gm.Coeff)), nil
+}
+
+// linkInv applies the inverse link function to given linear predictor values.
+func (gm *GeneralizedModel) linkInv(eta *tensor.Float64) *tensor.Float64 {
+	for i, v := range eta.Values {
+		eta.Values[i] = gm.Family.linkInv(v)
+	}
+	return eta
+}
+
+// fit fits the model with given design matrix.
+func (gm *GeneralizedModel) fit(x *tensor.Float64, names []string, y, w tensor.Tensor) error {
+	fam := gm.Family
+	p := x.DimSize(1)
+	xs, ys, pw, rows, err := observations(x, y, w)
+	if err != nil {
+		return err
+	}
+	m := len(rows)
+	for _, v := range ys {
+		if (fam == Binomial && (v < 0 || v > 1)) || (fam == Poisson && v < 0) {
+			return fmt.Errorf("glm: invalid response value %g for the %s family", v, fam)
+		}
+	}
+	mu := make([]float64, m)
+	eta := make([]float64, m)
+	z := make([]float64, m)
+	wz := make([]float64, m)
+	for k, v := range ys {
+		mu[k] = fam.start(v, pw[k])
+		eta[k] = fam.link(mu[k])
+	}
+	deviance := func() float64 {
+		dev := 0.0
+		for k, v := range ys {
+			dev += fam.deviance(v, mu[k], pw[k])
+		}
+		return dev
+	}
+	dev := deviance()
+	gm.Converged = false
+	var beta []float64
+	var xtxi *mat.Dense
+	for gm.Iters = 1; gm.Iters <= gm.MaxIters; gm.Iters++ {
+		for k := range ys {
+			me := fam.muEta(eta[k])
+			z[k] = eta[k] + (ys[k]-mu[k])/me
+			wz[k] = pw[k] * me * me / fam.variance(mu[k])
+		}
+		b, xi, err := leastSquares(xs, z, wz)
+		if err != nil {
+			return err
+		}
+		beta, xtxi = b, xi
+		fit := mulCoeff(xs, beta)
+		for k := range ys {
+			eta[k] = fit.Values[k]
+			mu[k] = fam.linkInv(eta[k])
+		}
+		ndev := deviance()
+		conv := math.Abs(ndev-dev)/(math.Abs(ndev)+0.1) < gm.StopTolerance
+		dev = ndev
+		if conv {
+			gm.Converged = true
+			break
+		}
+	}
+	gm.Iters = min(gm.Iters, gm.MaxIters)
+
+	gm.Names = names
+	gm.Coeff = beta
+	gm.N = m
+	gm.DF = m - p
+	gm.Deviance = dev
+	gm.Fitted = gm.linkInv(mulCoeff(x, beta))
+	valid := make([]bool, x.DimSize(0))
+	for _, i := range rows {
+		valid[i] = true
+	}
+	for i, v := range valid {
+		if !v {
+			gm.Fitted.Values[i] = math.NaN()
+		}
+	}
+
+	nmu := fam.linkInv(0)
+	gm.NullDF = m
+	if gm.intercept {
+		sw, sy := 0.0, 0.0
+		for k, v := range ys {
+			sw += pw[k]
+			sy += pw[k] * v
+		}
+		nmu = sy / sw
+		gm.NullDF = m - 1
+	}
+	gm.NullDeviance = 0
+	for k, v := range ys {
+		gm.NullDeviance += fam.deviance(v, nmu, pw[k])
+	}
+
+	gm.Dispersion = 1
+	npar := p
+	if fam == Gaussian {
+		gm.Dispersion = dev / float64(gm.DF)
+		npar++
+	}
+	disp := dev / float64(m) // maximum likelihood estimate
+	gm.LogLik = 0
+	for k, v := range ys {
+		gm.LogLik += fam.logLik(v, mu[k], pw[k], disp)
+	}
+	gm.AIC = -2*gm.LogLik + 2*float64(npar)
+
+	var sd dist.Dist = dist.Normal{Mu: 0, Sigma: 1}
+	if fam == Gaussian {
+		sd = dist.StudentT{Nu: float64(gm.DF)}
+	}
+	q := sd.Quantile(1 - (1-gm.Confidence)/2)
+	gm.StdErr = make([]float64, p)
+	gm.Z = make([]float64, p)
+	gm.P = make([]float64, p)
+	gm.CILow = make([]float64, p)
+	gm.CIHigh = make([]float64, p)
+	for j := range p {
+		se := math.Sqrt(gm.Dispersion * xtxi.At(j, j))
+		gm.StdErr[j] = se
+		gm.Z[j] = beta[j] / se
+		gm.P[j] = 2 * sd.Survival(math.Abs(gm.Z[j]))
+		gm.CILow[j] = beta[j] - q*se
+		gm.CIHigh[j] = beta[j] + q*se
+	}
+	return nil
+}
+
+// String returns a summary of the model fit, with a row for each coefficient.
+func (gm *GeneralizedModel) String() string {
+	var b strings.Builder
+	if gm.Formula != nil {
+		b.WriteString(gm.Formula.String() + ", ")
+	}
+	b.WriteString(gm.Family.String() + "\n")
+	zn := "z"
+	if gm.Family == Gaussian {
+		zn = "t"
+	}
+	writeCoeffs(&b, gm.Names, gm.Coeff, gm.StdErr, gm.Z, gm.P, gm.CILow, gm.CIHigh, zn, gm.Confidence)
+	fmt.Fprintf(&b, "Deviance = %.4g, DF = %d, Null Deviance = %.4g, Null DF = %d, AIC = %.4g, N = %d\n", gm.Deviance, gm.DF, gm.NullDeviance, gm.NullDF, gm.AIC, gm.N)
+	return b.String()
+}
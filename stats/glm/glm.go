@@ -12,15 +12,16 @@ import (
 	"cogentcore.org/lab/tensor"
 )
 
-// todo: add tests
-
 // GLM contains results and parameters for running a general
 // linear model, which is a general form of multivariate linear
 // regression, supporting multiple independent and dependent
 // variables.  Make a NewGLM and then do Run() on a tensor
 // table.Table with the relevant data in columns of the table.
 // Batch-mode gradient descent is used and the relevant parameters
-// can be altered from defaults before calling Run as needed.
+// can be altered from defaults before calling Run as needed,
+// or RunQR fits the coefficients in closed form using QR decomposition.
+// See [LinearModel] for a single dependent variable with standard errors,
+// robust fitting, and formulas.
 type GLM struct {
 	// Coeff are the coefficients to map from input independent variables
 	// to the dependent variables.  The first, outer dimension is number of
@@ -143,8 +144,14 @@ func (glm *GLM) SetTable(dt *table.Table, indepVars, depVars, predVars, errVars
 	glm.Table = dt
 	glm.IndepVars = iv
 	glm.DepVars = dv
-	glm.PredVars = pv
-	glm.ErrVars = ev
+	// note: nil *tensor.Rows values must not be assigned to the interfaces
+	glm.PredVars, glm.ErrVars = nil, nil
+	if pv != nil {
+		glm.PredVars = pv
+	}
+	if ev != nil {
+		glm.ErrVars = ev
+	}
 	return nil
 }
 
@@ -282,6 +289,79 @@ func (glm *GLM) Run() {
 	}
 }
 
+// RunQR performs the multi-variate linear regression using data SetTable function,
+// like [GLM.Run], but fitting each dependent variable in closed form by
+// ordinary least squares using QR decomposition (see [LinearModel]), which
+// is faster and exact for well-conditioned problems. The iterative
+// parameters and L1Cost and L2Cost are not used.
+func (glm *GLM) RunQR() error {
+	n := glm.Table.NumRows()
+	nIv := glm.NIndepVars
+	nDv := glm.NDepVars
+	x := tensor.NewFloat64(n, nIv)
+	for i := range n {
+		for ii := range nIv {
+			x.Values[i*nIv+ii] = glm.IndepVars.FloatRow(i, ii)
+		}
+	}
+	lm := NewLinearModel()
+	lm.ZeroOffset = glm.ZeroOffset
+	y := tensor.NewFloat64(n)
+	sse := 0.0
+	for di := range nDv {
+		for i := range n {
+			y.Values[i] = glm.DepVars.FloatRow(i, di)
+		}
+		if err := lm.Fit(x, y, nil); err != nil {
+			return err
+		}
+		off := lm.numIntercept()
+		for ii := range nIv {
+			glm.Coeff.Set(lm.Coeff[off+ii], di, ii)
+		}
+		offset := 0.0
+		if off == 1 {
+			offset = lm.Coeff[0]
+		}
+		glm.Coeff.Set(offset, di, nIv)
+		var obs, errs []float64
+		for i := range n {
+			e := lm.Residuals.Values[i]
+			if glm.PredVars != nil {
+				glm.PredVars.SetFloatRow(lm.Fitted.Values[i], i, di)
+			}
+			if glm.ErrVars != nil {
+				glm.ErrVars.SetFloatRow(e, i, di)
+			}
+			if !math.IsNaN(e) {
+				obs = append(obs, y.Values[i])
+				errs = append(errs, e)
+				sse += e * e
+			}
+		}
+		glm.ObsVariance[di] = variance(obs)
+		glm.ErrVariance[di] = variance(errs)
+		glm.R2[di] = 1.0 - (glm.ErrVariance[di] / glm.ObsVariance[di])
+	}
+	glm.MSE = sse / float64(n)
+	return nil
+}
+
+// variance returns the population variance of given values.
+func variance(vals []float64) float64 {
+	mean := 0.0
+	for _, v := range vals {
+		mean += v
+	}
+	mean /= float64(len(vals))
+	vr := 0.0
+	for _, v := range vals {
+		d := v - mean
+		vr += d * d
+	}
+	return vr / float64(len(vals))
+}
+
 // Variance returns a description of the variance accounted for by the regression
 // equation, R^2, for each dependent variable, along with the variances of
 // observed and errors (residuals), which are used to compute it.
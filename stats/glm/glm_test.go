@@ -0,0 +1,441 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// reference values are from R, using the cars and PlantGrowth datasets.
+var (
+	carsSpeed = tensor.NewFloat64FromValues(4, 4, 7, 7, 8, 9, 10, 10, 10, 11, 11, 12, 12, 12, 12, 13, 13, 13, 13, 14, 14, 14, 14, 15, 15, 15, 16, 16, 17, 17, 17, 18, 18, 18, 18, 19, 19, 19, 20, 20, 20, 20, 20, 22, 23, 24, 24, 24, 24, 25)
+	carsDist  = tensor.NewFloat64FromValues(2, 10, 4, 22, 16, 10, 18, 26, 34, 17, 28, 14, 20, 24, 28, 26, 34, 34, 46, 26, 36, 60, 80, 20, 26, 54, 32, 40, 32, 40, 50, 42, 56, 76, 84, 36, 46, 68, 32, 48, 52, 56, 64, 66, 54, 70, 92, 93, 120, 85)
+
+	plantWeight = []float64{4.17, 5.58, 5.18, 6.11, 4.50, 4.61, 5.17, 4.53, 5.33, 5.14,
+		4.81, 4.17, 4.41, 3.59, 5.87, 3.83, 6.03, 4.89, 4.32, 4.69,
+		6.31, 5.12, 5.54, 5.50, 5.37, 5.29, 4.92, 6.15, 5.80, 5.26}
+)
+
+func plantTable() *table.Table {
+	dt := table.New("PlantGrowth")
+	dt.AddFloat64Column("Weight")
+	dt.AddStringColumn("Group")
+	dt.SetNumRows(30)
+	groups := []string{"ctrl", "trt1", "trt2"}
+	for i, w := range plantWeight {
+		dt.Column("Weight").SetFloatRow(w, i, 0)
+		dt.Column("Group").SetStringRow(groups[i/10], i, 0)
+	}
+	return dt
+}
+
+func TestParseFormula(t *testing.T) {
+	f, err := ParseFormula("Err ~ Epoch + Cond")
+	assert.NoError(t, err)
+	assert.Equal(t, "Err", f.Response)
+	assert.Equal(t, [][]string{{"Epoch"}, {"Cond"}}, f.Terms)
+	assert.True(t, f.Intercept)
+
+	f, err = ParseFormula("y ~ a*b - 1")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a"}, {"b"}, {"a", "b"}}, f.Terms)
+	assert.False(t, f.Intercept)
+	assert.Equal(t, "y ~ 0 + a + b + a:b", f.String())
+
+	f, err = ParseFormula("y ~ 0 + a:b + b:a + c - c")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}}, f.Terms)
+	assert.False(t, f.Intercept)
+
+	f, err = ParseFormula("y ~ 1")
+	assert.NoError(t, err)
+	assert.Len(t, f.Terms, 0)
+	assert.Equal(t, "y ~ 1", f.String())
+
+	for _, bad := range []string{"y = a", "~ a", "y ~ a ~ b", "y ~ a + + b", "y ~ a +", "y ~ a b"} {
+		_, err = ParseFormula(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestLinearModel(t *testing.T) {
+	lm := NewLinearModel()
+	assert.NoError(t, lm.Fit(carsSpeed, carsDist, nil))
+	tol := 1.0e-3
+	assert.Equal(t, []string{"Intercept", "X0"}, lm.Names)
+	assert.InDeltaSlice(t, []float64{-17.5791, 3.9324}, lm.Coeff, tol)
+	assert.InDeltaSlice(t, []float64{6.7584, 0.4155}, lm.StdErr, tol)
+	assert.InDeltaSlice(t, []float64{-2.601, 9.464}, lm.T, tol)
+	assert.InDelta(t, 0.01232, lm.P[0], 1.0e-5)
+	assert.InDelta(t, 1.49e-12, lm.P[1], 1.0e-14)
+	assert.InDeltaSlice(t, []float64{-31.167850, 3.096964}, lm.CILow, tol)
+	assert.InDeltaSlice(t, []float64{-3.990340, 4.767853}, lm.CIHigh, tol)
+	assert.InDelta(t, 15.38, lm.Sigma, 0.01)
+	assert.Equal(t, 48, lm.DF)
+	assert.Equal(t, 50, lm.N)
+	assert.InDelta(t, 0.6511, lm.R2, 1.0e-4)
+	assert.InDelta(t, 0.6438, lm.AdjR2, 1.0e-4)
+	assert.InDelta(t, 89.57, lm.F, 0.01)
+	assert.InDelta(t, lm.P[1], lm.FP, 1.0e-14)
+	assert.InDelta(t, carsDist.Values[0]-lm.Fitted.Values[0], lm.Residuals.Values[0], 1.0e-10)
+	assert.InDeltaSlice(t, lm.Fitted.Values, lm.Predict(carsSpeed).Values, 1.0e-10)
+
+	// missing values are excluded
+	y := carsDist.Clone().(*tensor.Float64)
+	y.Values = append(y.Values[:49:49], math.NaN())
+	y.SetShapeSizes(50)
+	assert.NoError(t, lm.Fit(carsSpeed, y, nil))
+	assert.Equal(t, 49, lm.N)
+	assert.True(t, math.IsNaN(lm.Residuals.Values[49]))
+
+	// integer weights give the same coefficients as repeated observations
+	w := tensor.NewFloat64(50)
+	xr := tensor.NewFloat64(0)
+	yr := tensor.NewFloat64(0)
+	for i := range 50 {
+		w.Values[i] = float64(1 + i%3)
+		for range 1 + i%3 {
+			xr.AppendRowFloat(carsSpeed.Values[i])
+			yr.AppendRowFloat(carsDist.Values[i])
+		}
+	}
+	assert.NoError(t, lm.Fit(carsSpeed, carsDist, w))
+	wc := lm.Coeff
+	assert.NoError(t, lm.Fit(xr, yr, nil))
+	assert.InDeltaSlice(t, lm.Coeff, wc, 1.0e-8)
+
+	lm.ZeroOffset = true
+	assert.NoError(t, lm.Fit(carsSpeed, carsDist, nil))
+	assert.InDeltaSlice(t, []float64{2.909}, lm.Coeff, tol)
+	assert.InDelta(t, 0.8963, lm.R2, 1.0e-4)
+
+	lm.ZeroOffset = false
+	x := tensor.NewFloat64(50, 2)
+	for i := range 50 {
+		x.Values[2*i] = carsSpeed.Values[i]
+		x.Values[2*i+1] = 2 * carsSpeed.Values[i]
+	}
+	assert.ErrorIs(t, lm.Fit(x, carsDist, nil), ErrSingular)
+	assert.ErrorIs(t, lm.Fit(tensor.NewFloat64FromValues(1, 2), tensor.NewFloat64FromValues(3, 5), nil), ErrTooFew)
+}
+
+func TestLinearModelTable(t *testing.T) {
+	dt := plantTable()
+	lm := NewLinearModel()
+	assert.NoError(t, lm.FitTable(dt, "Weight ~ Group", ""))
+	tol := 1.0e-3
+	assert.Equal(t, []string{"Intercept", "Grouptrt1", "Grouptrt2"}, lm.Names)
+	assert.InDeltaSlice(t, []float64{5.0320, -0.3710, 0.4940}, lm.Coeff, tol)
+	assert.InDeltaSlice(t, []float64{0.1971, 0.2788, 0.2788}, lm.StdErr, tol)
+	assert.InDelta(t, 0.1944, lm.P[1], 1.0e-4)
+	assert.InDelta(t, 0.0877, lm.P[2], 1.0e-4)
+	assert.InDelta(t, 0.6234, lm.Sigma, 1.0e-4)
+	assert.InDelta(t, 0.2641, lm.R2, 1.0e-4)
+	assert.InDelta(t, 0.2096, lm.AdjR2, 1.0e-4)
+	assert.InDelta(t, 4.846, lm.F, 1.0e-3)
+	assert.InDelta(t, 0.01591, lm.FP, 1.0e-5)
+	assert.Contains(t, lm.String(), "Weight ~ Group\n")
+
+	pt := table.New()
+	pt.AddStringColumn("Group")
+	pt.SetNumRows(2)
+	pt.Column("Group").SetStringRow("trt2", 0, 0)
+	pt.Column("Group").SetStringRow("other", 1, 0)
+	pred, err := lm.PredictTable(pt)
+	assert.NoError(t, err)
+	assert.InDelta(t, 5.526, pred.Values[0], tol)
+	assert.True(t, math.IsNaN(pred.Values[1]))
+
+	// interaction of numeric and categorical
+	dt.AddFloat64Column("X")
+	for i := range 30 {
+		dt.Column("X").SetFloatRow(float64(i%10), i, 0)
+	}
+	assert.NoError(t, lm.FitTable(dt, "Weight ~ X*Group", ""))
+	assert.Equal(t, []string{"Intercept", "X", "Grouptrt1", "Grouptrt2", "X:Grouptrt1", "X:Grouptrt2"}, lm.Names)
+
+	assert.Error(t, lm.FitTable(dt, "Weight ~ Missing", ""))
+	assert.Error(t, lm.FitTable(dt, "Group ~ X", ""))
+}
+
+func TestLinearModelRobust(t *testing.T) {
+	n := 40
+	x := tensor.NewFloat64(n)
+	y := tensor.NewFloat64(n)
+	for i := range n {
+		x.Values[i] = float64(i)
+		y.Values[i] = 1 + 2*float64(i) + 0.5*math.Sin(float64(i))
+	}
+	y.Values[35] += 100
+	y.Values[38] += 150
+
+	lm := NewLinearModel()
+	assert.NoError(t, lm.Fit(x, y, nil))
+	ols := lm.Coeff
+	lm.Robust = true
+	assert.NoError(t, lm.Fit(x, y, nil))
+	assert.Greater(t, lm.Iters, 1)
+	assert.InDeltaSlice(t, []float64{1, 2}, lm.Coeff, 0.2)
+	assert.Greater(t, math.Abs(ols[1]-2), 10*math.Abs(lm.Coeff[1]-2))
+	assert.Less(t, lm.Weights.Values[38], 0.1)
+	assert.Equal(t, 1.0, lm.Weights.Values[10])
+
+	// exact fit: the robust scale is 0, and no observations are downweighted
+	y0 := tensor.NewFloat64(6)
+	assert.NoError(t, lm.Fit(tensor.NewFloat64FromValues(0, 1, 2, 3, 4, 5), y0, nil))
+	assert.Equal(t, []float64{1, 1, 1, 1, 1, 1}, lm.Weights.Values)
+}
+
+func TestRunQR(t *testing.T) {
+	dt := table.New()
+	dt.AddFloat64Column("X", 2)
+	dt.AddFloat64Column("Y", 2)
+	dt.AddFloat64Column("Pred", 2)
+	dt.SetNumRows(20)
+	for i := range 20 {
+		x0, x1 := float64(i), math.Cos(float64(i))
+		dt.Column("X").SetFloatRow(x0, i, 0)
+		dt.Column("X").SetFloatRow(x1, i, 1)
+		dt.Column("Y").SetFloatRow(1+2*x0-3*x1, i, 0)
+		dt.Column("Y").SetFloatRow(-x0+0.5*x1+0.1*math.Sin(float64(i)), i, 1)
+	}
+	glm := NewGLM()
+	assert.NoError(t, glm.SetTable(dt, "X", "Y", "Pred", ""))
+	assert.NoError(t, glm.RunQR())
+	assert.InDeltaSlice(t, []float64{2, -3, 1}, glm.Coeff.Values[:3], 1.0e-10)
+	assert.InDeltaSlice(t, []float64{-1, 0.5, 0}, glm.Coeff.Values[3:], 0.1)
+	assert.InDelta(t, 1, glm.R2[0], 1.0e-10)
+	assert.Greater(t, glm.R2[1], 0.99)
+	assert.InDelta(t, 1+2*5-3*math.Cos(5), dt.Column("Pred").FloatRow(5, 0), 1.0e-10)
+}
+
+// mtcars hp, wt and am (automatic = 0, manual = 1) columns, from R.
+var (
+	mtcarsHp = []float64{110, 110, 93, 110, 175, 105, 245, 62, 95, 123, 123, 180, 180, 180, 205, 215, 230, 66, 52, 65, 97, 150, 150, 245, 175, 66, 91, 113, 264, 175, 335, 109}
+	mtcarsWt = []float64{2.620, 2.875, 2.320, 3.215, 3.440, 3.460, 3.570, 3.190, 3.150, 3.440, 3.440, 4.070, 3.730, 3.780, 5.250, 5.424, 5.345, 2.200, 1.615, 1.835, 2.465, 3.520, 3.435, 3.840, 3.845, 1.935, 2.140, 1.513, 3.170, 2.770, 3.570, 2.780}
+	mtcarsAm = []float64{1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1}
+)
+
+func TestGeneralizedModel(t *testing.T) {
+	x := tensor.NewFloat64(32, 2)
+	for i := range 32 {
+		x.Values[2*i] = mtcarsHp[i]
+		x.Values[2*i+1] = mtcarsWt[i]
+	}
+	y := tensor.NewFloat64FromValues(mtcarsAm...)
+	gm := NewGeneralizedModel(Binomial)
+	assert.NoError(t, gm.Fit(x, y, nil))
+	assert.True(t, gm.Converged)
+	assert.InDeltaSlice(t, []float64{18.86630, 0.03626, -8.08348}, gm.Coeff, 1.0e-4)
+	assert.InDeltaSlice(t, []float64{7.44356, 0.01773, 3.06868}, gm.StdErr, 1.0e-4)
+	assert.InDeltaSlice(t, []float64{2.535, 2.044, -2.634}, gm.Z, 1.0e-3)
+	assert.InDeltaSlice(t, []float64{0.0113, 0.0409, 0.0084}, gm.P, 1.0e-4)
+	assert.InDelta(t, 43.230, gm.NullDeviance, 1.0e-3)
+	assert.InDelta(t, 10.059, gm.Deviance, 1.0e-3)
+	assert.InDelta(t, 16.059, gm.AIC, 1.0e-3)
+	assert.Equal(t, 31, gm.NullDF)
+	assert.Equal(t, 29, gm.DF)
+	assert.InDeltaSlice(t, gm.Fitted.Values, gm.Predict(x).Values, 1.0e-10)
+
+	// Dobson (1990) Poisson example from R glm docs
+	dt := table.New()
+	dt.AddFloat64Column("Counts")
+	dt.AddStringColumn("Outcome")
+	dt.AddStringColumn("Treatment")
+	dt.SetNumRows(9)
+	counts := []float64{18, 17, 15, 20, 10, 20, 25, 13, 12}
+	for i, c := range counts {
+		dt.Column("Counts").SetFloatRow(c, i, 0)
+		dt.Column("Outcome").SetStringRow(string(rune('1'+i%3)), i, 0)
+		dt.Column("Treatment").SetStringRow(string(rune('1'+i/3)), i, 0)
+	}
+	gm = NewGeneralizedModel(Poisson)
+	assert.NoError(t, gm.FitTable(dt, "Counts ~ Outcome + Treatment", ""))
+	assert.Equal(t, []string{"Intercept", "Outcome2", "Outcome3", "Treatment2", "Treatment3"}, gm.Names)
+	assert.InDeltaSlice(t, []float64{3.044522, -0.4542553, -0.2929871, 0, 0}, gm.Coeff, 1.0e-6)
+	assert.InDeltaSlice(t, []float64{0.1708987, 0.2021708, 0.1927423, 0.2, 0.2}, gm.StdErr, 1.0e-6)
+	assert.InDelta(t, 0.0246, gm.P[1], 1.0e-4)
+	assert.InDelta(t, 10.58145, gm.NullDeviance, 1.0e-5)
+	assert.InDelta(t, 5.129141, gm.Deviance, 1.0e-5)
+	assert.InDelta(t, 56.76132, gm.AIC, 1.0e-5)
+	pred, err := gm.PredictTable(dt)
+	assert.NoError(t, err)
+	assert.InDelta(t, 21, pred.Values[0], 1.0e-6)
+	assert.Contains(t, gm.String(), "Counts ~ Outcome + Treatment, Poisson\n")
+
+	// Gaussian is the same as the linear model
+	gm = NewGeneralizedModel(Gaussian)
+	assert.NoError(t, gm.Fit(carsSpeed, carsDist, nil))
+	lm := NewLinearModel()
+	assert.NoError(t, lm.Fit(carsSpeed, carsDist, nil))
+	assert.InDeltaSlice(t, lm.Coeff, gm.Coeff, 1.0e-8)
+	assert.InDeltaSlice(t, lm.StdErr, gm.StdErr, 1.0e-8)
+	assert.InDeltaSlice(t, lm.P, gm.P, 1.0e-8)
+	assert.InDelta(t, 419.1569, gm.AIC, 1.0e-4)
+
+	gm = NewGeneralizedModel(Binomial)
+	assert.Error(t, gm.Fit(carsSpeed, carsDist, nil))
+}
+
+func TestMultinomial(t *testing.T) {
+	// with two classes, the same as logistic regression
+	x := tensor.NewFloat64FromValues(mtcarsWt...)
+	y := tensor.NewFloat64FromValues(mtcarsAm...)
+	gm := NewGeneralizedModel(Binomial)
+	assert.NoError(t, gm.Fit(x, y, nil))
+	mn := NewMultinomial()
+	assert.NoError(t, mn.Fit(x, y, nil))
+	assert.True(t, mn.Converged)
+	assert.Equal(t, []string{"0", "1"}, mn.Classes)
+	assert.InDeltaSlice(t, gm.Coeff, mn.Coeff.Values, 1.0e-6)
+	// glm (and R) uses the IRLS weights from before the last update
+	assert.InDeltaSlice(t, gm.StdErr, mn.StdErr.Values, 1.0e-3)
+	assert.InDelta(t, gm.Deviance, mn.Deviance, 1.0e-6)
+	assert.InDelta(t, gm.AIC, mn.AIC, 1.0e-6)
+	assert.InDelta(t, gm.NullDeviance, mn.NullDeviance, 1.0e-6)
+
+	// with a categorical predictor, fitted probabilities are the proportions
+	dt := table.New()
+	dt.AddStringColumn("Choice")
+	dt.AddStringColumn("Cond")
+	choices := []string{"a", "a", "b", "c", "c", "c", "a", "b", "b", "b", "c", "a", "a", "b", "c", "b", "", "b"}
+	dt.SetNumRows(len(choices))
+	for i, c := range choices {
+		dt.Column("Choice").SetStringRow(c, i, 0)
+		dt.Column("Cond").SetStringRow([]string{"X", "Y"}[i%2], i, 0)
+	}
+	assert.NoError(t, mn.FitTable(dt, "Choice ~ Cond", ""))
+	assert.Equal(t, []string{"a", "b", "c"}, mn.Classes)
+	assert.Equal(t, []string{"Intercept", "CondY"}, mn.Names)
+	assert.Equal(t, []int{2, 2}, mn.Coeff.ShapeSizes())
+	assert.Equal(t, 17, mn.N)
+	// X: a b c a b c a c, with 1 missing
+	assert.InDeltaSlice(t, []float64{0.375, 0.25, 0.375}, mn.Fitted.Values[0:3], 1.0e-6)
+	assert.True(t, math.IsNaN(mn.Fitted.Values[16*3]))
+	pred, err := mn.PredictTable(dt)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, mn.Fitted.Values[3:6], pred.Values[3:6], 1.0e-10)
+	assert.Contains(t, mn.String(), "b:CondY")
+}
+
+func TestParseMixedFormula(t *testing.T) {
+	f, r, g, err := ParseMixedFormula("Err ~ Epoch + Cond + (1 + Epoch | Seed)")
+	assert.NoError(t, err)
+	assert.Equal(t, "Err ~ Epoch + Cond", f.String())
+	assert.Equal(t, "Err ~ Epoch", r.String())
+	assert.Equal(t, "Seed", g)
+
+	f, r, _, err = ParseMixedFormula("Err ~ (0 + Epoch | Seed) + Epoch")
+	assert.NoError(t, err)
+	assert.Equal(t, "Err ~ Epoch", f.String())
+	assert.Equal(t, "Err ~ 0 + Epoch", r.String())
+
+	f, _, _, err = ParseMixedFormula("Err ~ (1 | Seed)")
+	assert.NoError(t, err)
+	assert.Equal(t, "Err ~ 1", f.String())
+
+	for _, bad := range []string{"Err ~ Epoch", "Err ~ Epoch - (1 | Seed)", "Err ~ (1 | Seed) + (1 | Cond)", "Err ~ (1 | )"} {
+		_, _, _, err = ParseMixedFormula(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestMixedModel(t *testing.T) {
+	// lme4 Dyestuff data
+	yield := []float64{1545, 1440, 1440, 1520, 1580, 1540, 1555, 1490, 1560, 1495,
+		1595, 1550, 1605, 1510, 1560, 1445, 1440, 1595, 1465, 1545,
+		1595, 1630, 1515, 1635, 1625, 1520, 1455, 1450, 1480, 1445}
+	dt := table.New()
+	dt.AddFloat64Column("Yield")
+	dt.AddStringColumn("Batch")
+	dt.SetNumRows(30)
+	for i, y := range yield {
+		dt.Column("Yield").SetFloatRow(y, i, 0)
+		dt.Column("Batch").SetStringRow(string(rune('A'+i/5)), i, 0)
+	}
+	mm := NewMixedModel()
+	assert.NoError(t, mm.FitTable(dt, "Yield ~ 1 + (1 | Batch)"))
+	assert.InDelta(t, 1527.5, mm.Coeff[0], 1.0e-3)
+	assert.InDelta(t, 19.3834, mm.StdErr[0], 1.0e-3)
+	assert.InDelta(t, 1764.05, mm.RandomCov.Values[0], 0.1)
+	assert.InDelta(t, 2451.25, mm.ResidualVar, 0.1)
+	assert.InDelta(t, 319.6543, mm.Deviance, 1.0e-3)
+	assert.Equal(t, 24.0, mm.DF[0])
+	assert.Equal(t, []string{"A", "B", "C", "D", "E", "F"}, mm.Groups)
+	// BLUPs sum to 0 for balanced data
+	sum := 0.0
+	for _, v := range mm.RandomEffects.Values {
+		sum += v
+	}
+	assert.InDelta(t, 0, sum, 1.0e-6)
+	assert.InDelta(t, -17.60597, mm.RandomEffects.Values[0], 1.0e-3)
+	assert.Contains(t, mm.String(), "Batch Intercept: Var = 1764")
+
+	mm.ML = true
+	assert.NoError(t, mm.FitTable(dt, "Yield ~ 1 + (1 | Batch)"))
+	assert.InDelta(t, 327.3271, mm.Deviance, 1.0e-3)
+	assert.InDelta(t, 1388.33, mm.RandomCov.Values[0], 0.1)
+	assert.InDelta(t, 17.6944, mm.StdErr[0], 1.0e-3)
+}
+
+func TestMixedModelSlopes(t *testing.T) {
+	// simulated repeated measures, with correlated random intercepts and
+	// slopes, and a between-subjects condition
+	rnd := randx.NewSysRand(1)
+	ns, ne := 40, 10
+	dt := table.New()
+	dt.AddStringColumn("Seed")
+	dt.AddFloat64Column("Epoch")
+	dt.AddStringColumn("Cond")
+	dt.AddFloat64Column("Err")
+	dt.SetNumRows(ns * ne)
+	for s := range ns {
+		b0 := randx.GaussianGen(0, 2, rnd)
+		b1 := 0.5*b0/2 + randx.GaussianGen(0, math.Sqrt(0.75), rnd)
+		cond := []string{"A", "B"}[s%2]
+		for e := range ne {
+			i := s*ne + e
+			err := 10 + b0 + (-0.5+b1)*float64(e) + randx.GaussianGen(0, 1, rnd)
+			if cond == "B" {
+				err += 3
+			}
+			dt.Column("Seed").SetStringRow(fmt.Sprint(s), i, 0)
+			dt.Column("Epoch").SetFloatRow(float64(e), i, 0)
+			dt.Column("Cond").SetStringRow(cond, i, 0)
+			dt.Column("Err").SetFloatRow(err, i, 0)
+		}
+	}
+	mm := NewMixedModel()
+	assert.NoError(t, mm.FitTable(dt, "Err ~ Epoch + Cond + (1 + Epoch | Seed)"))
+	assert.Equal(t, []string{"Intercept", "Epoch", "CondB"}, mm.Names)
+	assert.Equal(t, []string{"Intercept", "Epoch"}, mm.RandomNames)
+	assert.InDeltaSlice(t, []float64{10, -0.5, 3}, mm.Coeff, 1.5)
+	assert.InDelta(t, 4, mm.RandomCov.Values[0], 2)
+	assert.InDelta(t, 1, mm.RandomCov.Values[3], 0.5)
+	assert.InDelta(t, 1, mm.ResidualVar, 0.2)
+	assert.Equal(t, []float64{359, 359, 38}, mm.DF)
+	// the standard error of the slope reflects the variance across seeds,
+	// and is larger than for independent observations
+	lm := NewLinearModel()
+	assert.NoError(t, lm.FitTable(dt, "Err ~ Epoch + Cond", ""))
+	assert.Greater(t, mm.StdErr[1], 1.2*lm.StdErr[1])
+	assert.Contains(t, mm.String(), "Err ~ Epoch + Cond + (1 + Epoch | Seed), REML\n")
+
+	pred, err := mm.PredictTable(dt)
+	assert.NoError(t, err)
+	res := 0.0
+	for i, p := range pred.Values {
+		d := p - dt.Column("Err").FloatRow(i, 0)
+		res += d * d
+	}
+	assert.InDelta(t, 1, res/float64(ns*ne), 0.3)
+}
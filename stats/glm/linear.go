@@ -0,0 +1,441 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrTooFew is returned when there are not more observations than
+	// coefficients to fit.
+	ErrTooFew = errors.New("glm: too few observations")
+
+	// ErrSingular is returned when the design matrix does not have full
+	// column rank, e.g., because of collinear terms.
+	ErrSingular = errors.New("glm: design matrix is singular")
+)
+
+// LinearModel is a linear regression model of a single dependent
+// variable, fit in closed form by ordinary or weighted least squares
+// using the QR decomposition of the design matrix, with an optional
+// robust fit using the Huber loss. Make a NewLinearModel and then use
+// [LinearModel.Fit] on tensors, or [LinearModel.FitTable] with a
+// [Formula] on the columns of a [table.Table]. In addition to the
+// coefficients, it reports their standard errors, t and p values and
+// confidence intervals, along with R^2 and adjusted R^2.
+// Observations with missing (NaN) values are excluded from the fit.
+type LinearModel struct {
+
+	// Formula is the model formula used by [LinearModel.FitTable],
+	// which is nil for models fit with [LinearModel.Fit].
+	Formula *Formula
+
+	// Names are the names of the coefficients, starting with
+	// "Intercept" if an intercept is included.
+	Names []string
+
+	// Coeff are the fitted coefficients.
+	Coeff []float64
+
+	// StdErr are the standard errors of the coefficients.
+	StdErr []float64
+
+	// T are the t statistics of the coefficients, Coeff / StdErr.
+	T []float64
+
+	// P are the two-sided p values of the t statistics.
+	P []float64
+
+	// CILow and CIHigh are the lower and upper bounds of the
+	// confidence intervals of the coefficients, at the Confidence level.
+	CILow, CIHigh []float64
+
+	// R2 is the proportion of variance accounted for by the model,
+	// which is relative to 0 instead of the mean when there is no intercept.
+	R2 float64
+
+	// AdjR2 is R2 adjusted for the number of coefficients.
+	AdjR2 float64
+
+	// Sigma is the residual standard error, i.e., the estimated standard
+	// deviation of the errors.
+	Sigma float64
+
+	// F is the F statistic comparing the model to the intercept-only model,
+	// with the number of coefficients other than the intercept and DF as the
+	// degrees of freedom, and FP is its p value.
+	F, FP float64
+
+	// N is the number of observations used in the fit.
+	N int
+
+	// DF is the residual degrees of freedom, N minus the number of coefficients.
+	DF int
+
+	// Iters is the number of iterations used for a Robust fit.
+	Iters int
+
+	// Fitted are the fitted (predicted) values for each observation.
+	Fitted *tensor.Float64
+
+	// Residuals are the residuals (observed - fitted) for each observation.
+	Residuals *tensor.Float64
+
+	// Weights are the robust weights of each observation for a Robust fit,
+	// which are 1 for observations that are fit normally and less than 1 for
+	// outliers.
+	Weights *tensor.Float64
+
+	//////// Parameters for the model fitting:
+
+	// ZeroOffset restricts the offset of the linear function to 0,
+	// forcing it to pass through the origin. Otherwise, an intercept is
+	// fit. This is only used by [LinearModel.Fit]: the formula determines
+	// the intercept for [LinearModel.FitTable].
+	ZeroOffset bool
+
+	// Confidence is the confidence level for the CILow and CIHigh intervals.
+	Confidence float64 `default:"0.95"`
+
+	// Robust uses the Huber loss instead of the squared error, which
+	// reduces the influence of outliers, fit by iteratively reweighted
+	// least squares. The standard errors are then approximate, from the
+	// final weighted least squares fit.
+	Robust bool
+
+	// HuberK is the threshold of the Huber loss, in units of the robust
+	// scale of the residuals (the median absolute residual / 0.6745),
+	// beyond which errors are weighted linearly instead of squared.
+	// The default of 1.345 gives 95% efficiency for normal errors.
+	HuberK float64 `default:"1.345"`
+
+	// MaxIters is the maximum number of iterations for a Robust fit.
+	MaxIters int `default:"50"`
+
+	// StopTolerance is the tolerance on the relative change in the residuals
+	// across iterations to stop iterating for a Robust fit.
+	StopTolerance float64 `default:"1e-6"`
+
+	// intercept is whether the fit model has an intercept.
+	intercept bool
+}
+
+func NewLinearModel() *LinearModel {
+	lm := &LinearModel{}
+	lm.Defaults()
+	return lm
+}
+
+func (lm *LinearModel) Defaults() {
+	lm.Confidence = 0.95
+	lm.HuberK = 1.345
+	lm.MaxIters = 50
+	lm.StopTolerance = 1e-6
+}
+
+// Fit fits the model to predict the dependent variable y, with one value
+// for each row of the independent variables x, which is 1D for a single
+// variable, or has the variables in the inner dimension(s). The optional
+// weights w give the weight of each observation for weighted least
+// squares, and can be nil for ordinary least squares.
+func (lm *LinearModel) Fit(x, y, w tensor.Tensor) error {
+	lm.intercept = !lm.ZeroOffset
+	dx, names := designTensor(x, lm.intercept)
+	lm.Formula = nil
+	return lm.fit(dx, names, y, w)
+}
+
+// FitTable fits the model given by the formula (see [Formula]) to the
+// columns of given table, e.g., "Err ~ Epoch + Cond". The optional
+// weights column gives the weight of each row for weighted least squares,
+// and can be "" for ordinary least squares.
+func (lm *LinearModel) FitTable(dt *table.Table, formula, weights string) error {
+	f, err := ParseFormula(formula)
+	if err != nil {
+		return err
+	}
+	x, names, err := f.Design(dt)
+	if err != nil {
+		return err
+	}
+	y, err := f.ResponseValues(dt)
+	if err != nil {
+		return err
+	}
+	var w tensor.Tensor
+	if weights != "" {
+		wc, err := dt.ColumnTry(weights)
+		if err != nil {
+			return err
+		}
+		w = wc
+	}
+	lm.intercept = f.Intercept
+	lm.Formula = f
+	return lm.fit(x, names, y, w)
+}
+
+// Predict returns the values predicted by the model fit with
+// [LinearModel.Fit] for given independent variables, in the same
+// format as for the fit.
+func (lm *LinearModel) Predict(x tensor.Tensor) *tensor.Float64 {
+	dx, _ := designTensor(x, lm.intercept)
+	return mulCoeff(dx, lm.Coeff)
+}
+
+// PredictTable returns the values predicted by the model fit with
+// [LinearModel.FitTable] for each row of given table, which must have
+// the columns used in the formula (except the response).
+func (lm *LinearModel) PredictTable(dt *table.Table) (*tensor.Float64, error) {
+	if lm.Formula == nil {
+		return nil, errors.New("glm: PredictTable requires a model fit with FitTable")
+	}
+	x, _, err := lm.Formula.Design(dt)
+	if err != nil {
+		return nil, err
+	}
+	return mulCoeff(x, lm.Coeff), nil
+}
+
+// fit fits the model with given design matrix.
+func (lm *LinearModel) fit(x *tensor.Float64, names []string, y, w tensor.Tensor) error {
+	n, p := x.DimSize(0), x.DimSize(1)
+	xs, ys, pw, rows, err := observations(x, y, w)
+	if err != nil {
+		return err
+	}
+	m := len(rows)
+	wt := slices.Clone(pw)
+	beta, xtxi, err := leastSquares(xs, ys, wt)
+	if err != nil {
+		return err
+	}
+	res := residuals(xs, ys, beta)
+	iters := 0
+	if lm.Robust {
+		rw := make([]float64, m)
+		for k := range rw {
+			rw[k] = 1
+		}
+		ar := make([]float64, m)
+		for iters = 1; iters <= lm.MaxIters; iters++ {
+			for k, r := range res {
+				ar[k] = math.Abs(r * math.Sqrt(pw[k]))
+			}
+			slices.Sort(ar)
+			scale := median(ar) / 0.6745
+			if scale == 0 {
+				break
+			}
+			for k, r := range res {
+				u := math.Abs(r*math.Sqrt(pw[k])) / scale
+				rw[k] = 1
+				if u > lm.HuberK {
+					rw[k] = lm.HuberK / u
+				}
+				wt[k] = pw[k] * rw[k]
+			}
+			beta, xtxi, err = leastSquares(xs, ys, wt)
+			if err != nil {
+				return err
+			}
+			nres := residuals(xs, ys, beta)
+			dss, ss := 0.0, 0.0
+			for k, r := range res {
+				d := nres[k] - r
+				dss += d * d
+				ss += r * r
+			}
+			res = nres
+			if ss == 0 || math.Sqrt(dss/ss) < lm.StopTolerance {
+				break
+			}
+		}
+		lm.Weights = tensor.NewFloat64(n)
+		tensor.SetAllFloat64(lm.Weights, math.NaN())
+		for k, i := range rows {
+			lm.Weights.Values[i] = rw[k]
+		}
+	} else {
+		lm.Weights = nil
+	}
+	lm.Iters = min(iters, lm.MaxIters)
+
+	lm.Names = names
+	lm.Coeff = beta
+	lm.N = m
+	lm.DF = m - p
+	lm.Fitted = mulCoeff(x, beta)
+	lm.Residuals = tensor.NewFloat64(n)
+	tensor.SetAllFloat64(lm.Residuals, math.NaN())
+	for _, i := range rows {
+		lm.Residuals.Values[i] = y.Float1D(i) - lm.Fitted.Values[i]
+	}
+	for i, r := range lm.Residuals.Values {
+		if math.IsNaN(r) {
+			lm.Fitted.Values[i] = math.NaN()
+		}
+	}
+
+	sw, my := 0.0, 0.0
+	for k := range m {
+		sw += wt[k]
+		my += wt[k] * ys[k]
+	}
+	my /= sw
+	if !lm.intercept {
+		my = 0
+	}
+	rss, tss := 0.0, 0.0
+	for k := range m {
+		rss += wt[k] * res[k] * res[k]
+		d := ys[k] - my
+		tss += wt[k] * d * d
+	}
+	ni := lm.numIntercept()
+	df := float64(lm.DF)
+	s2 := rss / df
+	lm.Sigma = math.Sqrt(s2)
+	lm.R2 = 1 - rss/tss
+	lm.AdjR2 = 1 - (1-lm.R2)*float64(m-ni)/df
+	lm.F, lm.FP = math.NaN(), math.NaN()
+	if p > ni {
+		lm.F = ((tss - rss) / float64(p-ni)) / s2
+		lm.FP = dist.F{D1: float64(p - ni), D2: df}.Survival(lm.F)
+	}
+
+	td := dist.StudentT{Nu: df}
+	q := td.Quantile(1 - (1-lm.Confidence)/2)
+	lm.StdErr = make([]float64, p)
+	lm.T = make([]float64, p)
+	lm.P = make([]float64, p)
+	lm.CILow = make([]float64, p)
+	lm.CIHigh = make([]float64, p)
+	for j := range p {
+		se := math.Sqrt(s2 * xtxi.At(j, j))
+		lm.StdErr[j] = se
+		lm.T[j] = beta[j] / se
+		lm.P[j] = 2 * td.Survival(math.Abs(lm.T[j]))
+		lm.CILow[j] = beta[j] - q*se
+		lm.CIHigh[j] = beta[j] + q*se
+	}
+	return nil
+}
+
+// leastSquares returns the weighted least squares coefficients for given
+// design matrix, values and weights, using the QR decomposition,
+// along with the inverse of X'WX, which is used for the standard errors.
+func leastSquares(x *tensor.Float64, y, w []float64) ([]float64, *mat.Dense, error) {
+	m, p := x.DimSize(0), x.DimSize(1)
+	xw := tensor.NewFloat64(m, p)
+	yw := mat.NewVecDense(m, nil)
+	for i := range m {
+		sw := math.Sqrt(w[i])
+		for j := range p {
+			xw.Values[i*p+j] = sw * x.Values[i*p+j]
+		}
+		yw.SetVec(i, sw*y[i])
+	}
+	dx, err := matrix.NewDense(xw)
+	if err != nil {
+		return nil, nil, err
+	}
+	var qr mat.QR
+	qr.Factorize(dx)
+	var r mat.Dense
+	qr.RTo(&r)
+	maxd := 0.0
+	for j := range p {
+		maxd = max(maxd, math.Abs(r.At(j, j)))
+	}
+	rt := mat.NewTriDense(p, mat.Upper, nil)
+	for i := range p {
+		if math.Abs(r.At(i, i)) <= 1e-10*maxd {
+			return nil, nil, ErrSingular
+		}
+		for j := i; j < p; j++ {
+			rt.SetTri(i, j, r.At(i, j))
+		}
+	}
+	var beta mat.VecDense
+	if err := qr.SolveVecTo(&beta, false, yw); err != nil {
+		return nil, nil, err
+	}
+	var ri mat.TriDense
+	if err := ri.InverseTri(rt); err != nil {
+		return nil, nil, ErrSingular
+	}
+	var xtxi mat.Dense
+	xtxi.Mul(&ri, ri.T())
+	coeff := make([]float64, p)
+	for j := range p {
+		coeff[j] = beta.AtVec(j)
+	}
+	return coeff, &xtxi, nil
+}
+
+// residuals returns the residuals of given values relative to the
+// design matrix times the coefficients.
+func residuals(x *tensor.Float64, y, coeff []float64) []float64 {
+	fit := mulCoeff(x, coeff)
+	res := make([]float64, len(y))
+	for i, v := range y {
+		res[i] = v - fit.Values[i]
+	}
+	return res
+}
+
+// median returns the median of the given sorted values.
+func median(vals []float64) float64 {
+	n := len(vals)
+	if n%2 == 1 {
+		return vals[n/2]
+	}
+	return 0.5 * (vals[n/2-1] + vals[n/2])
+}
+
+// String returns a summary of the model fit, with a row for each coefficient.
+func (lm *LinearModel) String() string {
+	var b strings.Builder
+	if lm.Formula != nil {
+		b.WriteString(lm.Formula.String() + "\n")
+	}
+	writeCoeffs(&b, lm.Names, lm.Coeff, lm.StdErr, lm.T, lm.P, lm.CILow, lm.CIHigh, "t", lm.Confidence)
+	fmt.Fprintf(&b, "Sigma = %.4g, DF = %d, R^2 = %.4g, Adj R^2 = %.4g, F(%d, %d) = %.4g, p = %.4g, N = %d\n", lm.Sigma, lm.DF, lm.R2, lm.AdjR2, len(lm.Coeff)-lm.numIntercept(), lm.DF, lm.F, lm.FP, lm.N)
+	return b.String()
+}
+
+// writeCoeffs writes a table of coefficients with given statistics,
+// where statName is the name of the test statistic, e.g., "t".
+func writeCoeffs(b *strings.Builder, names []string, coeff, se, stat, p, lo, hi []float64, statName string, conf float64) {
+	wd := len("Term")
+	for _, nm := range names {
+		wd = max(wd, len(nm))
+	}
+	ci := fmt.Sprintf("%g%% CI", 100*conf)
+	fmt.Fprintf(b, "%-*s  %10s  %10s  %8s  %10s  %21s\n", wd, "Term", "Estimate", "Std Err", statName, "p", ci)
+	for j, nm := range names {
+		fmt.Fprintf(b, "%-*s  %10.4g  %10.4g  %8.4g  %10.4g  [%9.4g, %9.4g]\n", wd, nm, coeff[j], se[j], stat[j], p[j], lo[j], hi[j])
+	}
+}
+
+// numIntercept returns 1 if the model has an intercept, and 0 otherwise.
+func (lm *LinearModel) numIntercept() int {
+	if lm.intercept {
+		return 1
+	}
+	return 0
+}
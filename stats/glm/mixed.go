@@ -0,0 +1,533 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// MixedModel is a linear mixed-effects (hierarchical) model, with fixed
+// effects that are the same for all observations, and random effects that
+// vary across the groups given by a grouping column, e.g., the subjects
+// (random seeds) in repeated measures data, which accounts for the
+// correlation of observations within each group. The random effects
+// are normally distributed with an unstructured covariance, e.g.,
+// a random intercept and slope for each group with their correlation.
+// It is fit by restricted maximum likelihood (REML) by default.
+// Make a NewMixedModel and then use [MixedModel.FitTable] with a formula
+// as in lme4, e.g., "Err ~ Epoch + Cond + (1 + Epoch | Seed)".
+// Observations with missing values are excluded from the fit.
+type MixedModel struct {
+
+	// Formula is the formula for the fixed effects.
+	Formula *Formula
+
+	// RandomFormula is the formula for the random effects, with the
+	// same response as Formula.
+	RandomFormula *Formula
+
+	// Group is the name of the grouping column for the random effects.
+	Group string
+
+	// Names are the names of the fixed effects coefficients, starting with
+	// "Intercept" if an intercept is included.
+	Names []string
+
+	// Coeff are the fitted fixed effects coefficients.
+	Coeff []float64
+
+	// StdErr are the standard errors of the fixed effects.
+	StdErr []float64
+
+	// T are the t statistics of the fixed effects, Coeff / StdErr.
+	T []float64
+
+	// DF are the denominator degrees of freedom of the t statistics,
+	// using the between-within method: effects that are constant within
+	// each group (between-group effects) have the number of groups minus the
+	// number of such effects minus 1, and the others have the number
+	// of observations minus the number of groups minus the number of
+	// within-group effects.
+	DF []float64
+
+	// P are the two-sided p values of the T statistics.
+	P []float64
+
+	// CILow and CIHigh are the lower and upper bounds of the
+	// confidence intervals of the fixed effects, at the Confidence level.
+	CILow, CIHigh []float64
+
+	// RandomNames are the names of the random effects, starting with
+	// "Intercept" if a random intercept is included.
+	RandomNames []string
+
+	// RandomCov is the covariance matrix of the random effects,
+	// with the variance of each random effect on the diagonal.
+	RandomCov tensor.Float64
+
+	// ResidualVar is the variance of the residual errors.
+	ResidualVar float64
+
+	// Groups are the unique values of the grouping column, in order
+	// of first appearance.
+	Groups []string
+
+	// RandomEffects are the predicted random effects (BLUPs) for each
+	// of the Groups (rows) and RandomNames (columns).
+	RandomEffects tensor.Float64
+
+	// Deviance is -2 times the REML log likelihood, i.e., the REML criterion,
+	// or the log likelihood if ML is set.
+	Deviance float64
+
+	// AIC is the Akaike information criterion, Deviance + 2 * number of
+	// parameters, including the variance components. Only models with the
+	// same fixed effects can be compared for REML fits.
+	AIC float64
+
+	// N is the number of observations used in the fit.
+	N int
+
+	// Iters is the number of iterations used to optimize the variance
+	// components.
+	Iters int
+
+	//////// Parameters for the model fitting:
+
+	// ML uses maximum likelihood instead of REML, which is needed to compare
+	// models with different fixed effects using the Deviance or AIC.
+	// REML estimates of the variance components are unbiased.
+	ML bool
+
+	// Confidence is the confidence level for the CILow and CIHigh intervals.
+	Confidence float64 `default:"0.95"`
+
+	// MaxIters is the maximum number of iterations to optimize the variance
+	// components.
+	MaxIters int `default:"1000"`
+
+	// theta are the optimized parameters, which are the lower triangular
+	// Cholesky factor of RandomCov / ResidualVar.
+	theta []float64
+}
+
+func NewMixedModel() *MixedModel {
+	mm := &MixedModel{}
+	mm.Defaults()
+	return mm
+}
+
+func (mm *MixedModel) Defaults() {
+	mm.Confidence = 0.95
+	mm.MaxIters = 1000
+}
+
+// randomTerm matches the random effects term of a mixed model formula.
+var randomTerm = regexp.MustCompile(`\(([^()|]*)\|([^()|]*)\)`)
+
+// ParseMixedFormula parses a mixed model formula, with the random effects
+// given in parentheses as in lme4, e.g., "Err ~ Epoch + (1 + Epoch | Seed)",
+// returning the fixed and random effects formulas and the grouping column.
+// There must be exactly one random effects term, in which the intercept is
+// included unless removed with 0 or -1, as in the [Formula].
+func ParseMixedFormula(formula string) (fixed, random *Formula, group string, err error) {
+	ms := randomTerm.FindAllStringSubmatchIndex(formula, -1)
+	if len(ms) != 1 {
+		return nil, nil, "", fmt.Errorf("glm: mixed model formula %q must have one random effects term, e.g., (1 | Group)", formula)
+	}
+	m := ms[0]
+	group = strings.TrimSpace(formula[m[4]:m[5]])
+	if !validName(group) {
+		return nil, nil, "", fmt.Errorf("glm: invalid group %q in formula %q", group, formula)
+	}
+	const placeholder = "RandomEffectsTerm"
+	fixed, err = ParseFormula(formula[:m[0]] + placeholder + formula[m[1]:])
+	if err != nil {
+		return nil, nil, "", err
+	}
+	ti := slices.IndexFunc(fixed.Terms, func(t []string) bool { return len(t) == 1 && t[0] == placeholder })
+	if ti < 0 {
+		return nil, nil, "", fmt.Errorf("glm: random effects term must be added in formula %q", formula)
+	}
+	fixed.Terms = slices.Delete(fixed.Terms, ti, ti+1)
+	random, err = ParseFormula(fixed.Response + " ~ " + formula[m[2]:m[3]])
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return fixed, random, group, nil
+}
+
+// mixedGroup has the sufficient statistics for one group.
+type mixedGroup struct {
+	ztz, ztx, xtx *mat.Dense
+	zty, xty      *mat.VecDense
+	yty           float64
+	n             int
+}
+
+// FitTable fits the model given by the mixed model formula
+// (see [ParseMixedFormula]) to the columns of given table,
+// e.g., "Err ~ Epoch + Cond + (1 + Epoch | Seed)".
+func (mm *MixedModel) FitTable(dt *table.Table, formula string) error {
+	fixed, random, group, err := ParseMixedFormula(formula)
+	if err != nil {
+		return err
+	}
+	gcol, err := dt.ColumnTry(group)
+	if err != nil {
+		return err
+	}
+	x, names, err := fixed.Design(dt)
+	if err != nil {
+		return err
+	}
+	z, rnames, err := random.Design(dt)
+	if err != nil {
+		return err
+	}
+	y, err := fixed.ResponseValues(dt)
+	if err != nil {
+		return err
+	}
+	if len(rnames) == 0 {
+		return errors.New("glm: mixed model must have at least one random effect")
+	}
+	n, p, q := dt.NumRows(), len(names), len(rnames)
+	// the group index is added as the last column, for observations
+	xz := tensor.NewFloat64(n, p+q+1)
+	var groups []string
+	gidx := map[string]int{}
+	for i := range n {
+		row := xz.Values[i*(p+q+1) : (i+1)*(p+q+1)]
+		copy(row, x.Values[i*p:(i+1)*p])
+		copy(row[p:], z.Values[i*q:(i+1)*q])
+		g := gcol.StringRow(i, 0)
+		gi, ok := gidx[g]
+		if !ok {
+			gi = -1
+			if g != "" {
+				gi = len(groups)
+				gidx[g] = gi
+				groups = append(groups, g)
+			}
+		}
+		row[p+q] = float64(gi)
+		if gi < 0 {
+			row[p+q] = math.NaN()
+		}
+	}
+	xs, ys, _, _, err := observations(xz, y, nil)
+	if err != nil {
+		return err
+	}
+	ng := len(groups)
+	gs := make([]mixedGroup, ng)
+	for i := range gs {
+		gs[i] = mixedGroup{ztz: mat.NewDense(q, q, nil), ztx: mat.NewDense(q, p, nil), xtx: mat.NewDense(p, p, nil), zty: mat.NewVecDense(q, nil), xty: mat.NewVecDense(p, nil)}
+	}
+	m := len(ys)
+	between := make([]bool, p)
+	first := make([][]float64, ng)
+	for j := range between {
+		between[j] = !fixed.Intercept || j > 0
+	}
+	for k, yv := range ys {
+		row := xs.Values[k*(p+q+1) : (k+1)*(p+q+1)]
+		xr, zr := mat.NewVecDense(p, row[:p]), mat.NewVecDense(q, row[p:p+q])
+		g := &gs[int(row[p+q])]
+		g.n++
+		g.ztz.RankOne(g.ztz, 1, zr, zr)
+		g.ztx.RankOne(g.ztx, 1, zr, xr)
+		g.xtx.RankOne(g.xtx, 1, xr, xr)
+		g.zty.AddScaledVec(g.zty, yv, zr)
+		g.xty.AddScaledVec(g.xty, yv, xr)
+		g.yty += yv * yv
+		gi := int(row[p+q])
+		if first[gi] == nil {
+			first[gi] = row[:p]
+		}
+		for j := range p {
+			if row[j] != first[gi][j] {
+				between[j] = false
+			}
+		}
+	}
+	var used []mixedGroup
+	var ugroups []string
+	for i, g := range gs {
+		if g.n > 0 {
+			used = append(used, g)
+			ugroups = append(ugroups, groups[i])
+		}
+	}
+	gs, groups, ng = used, ugroups, len(used)
+	if ng < 2 {
+		return ErrTooFew
+	}
+
+	nt := q * (q + 1) / 2
+	theta := make([]float64, nt)
+	for i := range q {
+		theta[i*(i+1)/2+i] = 1
+	}
+	if len(mm.theta) == nt {
+		copy(theta, mm.theta)
+	}
+	prob := optimize.Problem{Func: func(th []float64) float64 {
+		dev, _ := mm.deviance(th, gs, p, q, m)
+		return dev
+	}}
+	set := &optimize.Settings{MajorIterations: mm.MaxIters, Converger: &optimize.FunctionConverge{Absolute: 1e-10, Relative: 1e-10, Iterations: 100}}
+	res, err := optimize.Minimize(prob, theta, set, &optimize.NelderMead{})
+	if err != nil && res == nil {
+		return err
+	}
+	mm.theta = slices.Clone(res.X)
+	mm.Iters = res.Stats.MajorIterations
+	dev, st := mm.deviance(mm.theta, gs, p, q, m)
+	if st == nil {
+		return ErrSingular
+	}
+
+	mm.Formula = fixed
+	mm.RandomFormula = random
+	mm.Group = group
+	mm.Names = names
+	mm.RandomNames = rnames
+	mm.Groups = groups
+	mm.N = m
+	mm.Deviance = dev
+	mm.AIC = dev + 2*float64(p+nt+1)
+	mm.ResidualVar = st.sigma2
+	mm.Coeff = make([]float64, p)
+	for j := range p {
+		mm.Coeff[j] = st.beta.AtVec(j)
+	}
+	var tt mat.Dense
+	tt.Mul(st.lambda, st.lambda.T())
+	tt.Scale(st.sigma2, &tt)
+	mm.RandomCov.SetShapeSizes(q, q)
+	matrix.CopyFromDense(&mm.RandomCov, &tt)
+
+	// BLUPs: b = T M^-1 T' Z'(y - X beta)
+	mm.RandomEffects.SetShapeSizes(ng, q)
+	for i, g := range gs {
+		var zr, tz, mb, b mat.VecDense
+		zr.MulVec(g.ztx, st.beta)
+		zr.SubVec(g.zty, &zr)
+		tz.MulVec(st.lambda.T(), &zr)
+		mb.SolveVec(st.ms[i], &tz)
+		b.MulVec(st.lambda, &mb)
+		for j := range q {
+			mm.RandomEffects.Values[i*q+j] = b.AtVec(j)
+		}
+	}
+
+	nb := 0
+	for _, b := range between {
+		if b {
+			nb++
+		}
+	}
+	mm.StdErr = make([]float64, p)
+	mm.T = make([]float64, p)
+	mm.DF = make([]float64, p)
+	mm.P = make([]float64, p)
+	mm.CILow = make([]float64, p)
+	mm.CIHigh = make([]float64, p)
+	var ai mat.Dense
+	if err := ai.Inverse(st.a); err != nil {
+		return ErrSingular
+	}
+	for j := range p {
+		se := math.Sqrt(st.sigma2 * ai.At(j, j))
+		df := float64(m - ng - (p - nb))
+		if fixed.Intercept {
+			df++
+		}
+		if between[j] {
+			df = float64(ng - nb - 1)
+		}
+		td := dist.StudentT{Nu: max(df, 1)}
+		tq := td.Quantile(1 - (1-mm.Confidence)/2)
+		mm.StdErr[j] = se
+		mm.DF[j] = df
+		mm.T[j] = mm.Coeff[j] / se
+		mm.P[j] = 2 * td.Survival(math.Abs(mm.T[j]))
+		mm.CILow[j] = mm.Coeff[j] - tq*se
+		mm.CIHigh[j] = mm.Coeff[j] + tq*se
+	}
+	return nil
+}
+
+// mixedState has the results of evaluating the deviance.
+type mixedState struct {
+	lambda *mat.TriDense
+	ms     []*mat.Dense
+	a      *mat.Dense
+	beta   *mat.VecDense
+	sigma2 float64
+}
+
+// deviance returns the profiled deviance (REML criterion or -2 log likelihood)
+// for given parameters, which are the lower triangular Cholesky factor of
+// the random effects covariance relative to the residual variance.
+func (mm *MixedModel) deviance(theta []float64, gs []mixedGroup, p, q, n int) (float64, *mixedState) {
+	st := &mixedState{lambda: mat.NewTriDense(q, mat.Lower, nil), ms: make([]*mat.Dense, len(gs))}
+	for i := range q {
+		for j := 0; j <= i; j++ {
+			st.lambda.SetTri(i, j, theta[i*(i+1)/2+j])
+		}
+	}
+	lt := st.lambda.T()
+	a := mat.NewDense(p, p, nil)
+	b := mat.NewVecDense(p, nil)
+	yy := 0.0
+	logdet := 0.0
+	for i, g := range gs {
+		var tzt, mi mat.Dense
+		tzt.Mul(lt, g.ztz)
+		mi.Mul(&tzt, st.lambda)
+		for j := range q {
+			mi.Set(j, j, mi.At(j, j)+1)
+		}
+		var ch mat.Cholesky
+		if !factorize(&ch, &mi) {
+			return math.Inf(1), nil
+		}
+		st.ms[i] = &mi
+		logdet += ch.LogDet()
+		var tzx, mtzx mat.Dense
+		tzx.Mul(lt, g.ztx)
+		if err := ch.SolveTo(&mtzx, &tzx); err != nil {
+			return math.Inf(1), nil
+		}
+		var tzy, mtzy mat.VecDense
+		tzy.MulVec(lt, g.zty)
+		if err := ch.SolveVecTo(&mtzy, &tzy); err != nil {
+			return math.Inf(1), nil
+		}
+		var xwx mat.Dense
+		xwx.Mul(tzx.T(), &mtzx)
+		xwx.Sub(g.xtx, &xwx)
+		a.Add(a, &xwx)
+		var xwy mat.VecDense
+		xwy.MulVec(tzx.T(), &mtzy)
+		xwy.SubVec(g.xty, &xwy)
+		b.AddVec(b, &xwy)
+		yy += g.yty - mat.Dot(&tzy, &mtzy)
+	}
+	var ach mat.Cholesky
+	if !factorize(&ach, a) {
+		return math.Inf(1), nil
+	}
+	st.a = a
+	st.beta = mat.NewVecDense(p, nil)
+	if err := ach.SolveVecTo(st.beta, b); err != nil {
+		return math.Inf(1), nil
+	}
+	rss := yy - mat.Dot(st.beta, b)
+	if mm.ML {
+		st.sigma2 = rss / float64(n)
+		return logdet + float64(n)*(1+math.Log(2*math.Pi*st.sigma2)), st
+	}
+	df := float64(n - p)
+	st.sigma2 = rss / df
+	return logdet + ach.LogDet() + df*(1+math.Log(2*math.Pi*st.sigma2)), st
+}
+
+// factorize computes the Cholesky factorization of given symmetric matrix.
+func factorize(ch *mat.Cholesky, a *mat.Dense) bool {
+	r, _ := a.Dims()
+	sym := mat.NewSymDense(r, nil)
+	for i := range r {
+		for j := i; j < r; j++ {
+			sym.SetSym(i, j, 0.5*(a.At(i, j)+a.At(j, i)))
+		}
+	}
+	return ch.Factorize(sym)
+}
+
+// PredictTable returns the values predicted by the model for each row of
+// given table, which must have the columns used in the formula (except the
+// response), including the random effects for the groups in the fit, and
+// only the fixed effects for other groups.
+func (mm *MixedModel) PredictTable(dt *table.Table) (*tensor.Float64, error) {
+	if mm.Formula == nil {
+		return nil, errors.New("glm: PredictTable requires a fit model")
+	}
+	x, _, err := mm.Formula.Design(dt)
+	if err != nil {
+		return nil, err
+	}
+	z, _, err := mm.RandomFormula.Design(dt)
+	if err != nil {
+		return nil, err
+	}
+	gcol, err := dt.ColumnTry(mm.Group)
+	if err != nil {
+		return nil, err
+	}
+	out := mulCoeff(x, mm.Coeff)
+	q := len(mm.RandomNames)
+	for i := range out.Values {
+		gi := slices.Index(mm.Groups, gcol.StringRow(i, 0))
+		if gi < 0 {
+			continue
+		}
+		for j := range q {
+			out.Values[i] += z.Values[i*q+j] * mm.RandomEffects.Values[gi*q+j]
+		}
+	}
+	return out, nil
+}
+
+// String returns a summary of the model fit, with the variance components
+// and a row for each fixed effect.
+func (mm *MixedModel) String() string {
+	var b strings.Builder
+	crit := "REML"
+	if mm.ML {
+		crit = "ML"
+	}
+	rf := mm.RandomFormula
+	rand := strings.TrimPrefix(rf.String(), rf.Response+" ~ ")
+	if rf.Intercept && len(rf.Terms) > 0 {
+		rand = "1 + " + rand
+	}
+	fixed := mm.Formula.String()
+	if len(mm.Formula.Terms) > 0 || !mm.Formula.Intercept {
+		fixed += " + "
+	} else {
+		fixed = strings.TrimSuffix(fixed, "1")
+	}
+	fmt.Fprintf(&b, "%s(%s | %s), %s\n", fixed, rand, mm.Group, crit)
+	q := len(mm.RandomNames)
+	for j, nm := range mm.RandomNames {
+		v := mm.RandomCov.Values[j*q+j]
+		fmt.Fprintf(&b, "%s %s: Var = %.4g, SD = %.4g", mm.Group, nm, v, math.Sqrt(v))
+		for k := range j {
+			c := mm.RandomCov.Values[j*q+k] / math.Sqrt(v*mm.RandomCov.Values[k*q+k])
+			fmt.Fprintf(&b, ", Corr %s = %.3g", mm.RandomNames[k], c)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "Residual: Var = %.4g, SD = %.4g\n", mm.ResidualVar, math.Sqrt(mm.ResidualVar))
+	writeCoeffs(&b, mm.Names, mm.Coeff, mm.StdErr, mm.T, mm.P, mm.CILow, mm.CIHigh, "t", mm.Confidence)
+	fmt.Fprintf(&b, "%s Deviance = %.4g, AIC = %.4g, N = %d, Groups = %d\n", crit, mm.Deviance, mm.AIC, mm.N, len(mm.Groups))
+	return b.String()
+}
@@ -0,0 +1,434 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+)
+
+// Multinomial is a multinomial logistic regression model of a categorical
+// dependent variable with two or more classes, e.g., choice data, using
+// the baseline-category logit, where the log odds of each class relative to
+// the first (reference) class is a linear function of the independent
+// variables. It is fit by Newton-Raphson iterations (equivalent to IRLS).
+// Make a NewMultinomial and then use [Multinomial.Fit] on tensors, or
+// [Multinomial.FitTable] with a [Formula] on the columns of a [table.Table].
+// Observations with missing (NaN or "") values are excluded from the fit.
+type Multinomial struct {
+
+	// Formula is the model formula used by [Multinomial.FitTable],
+	// which is nil for models fit with [Multinomial.Fit].
+	Formula *Formula
+
+	// Classes are the classes (levels) of the response variable, in sorted
+	// order, where the first is the reference class.
+	Classes []string
+
+	// Names are the names of the coefficients for each class, starting with
+	// "Intercept" if an intercept is included.
+	Names []string
+
+	// Coeff are the fitted coefficients, with a row for each class other
+	// than the reference class, and a column for each of the Names,
+	// giving the log odds of that class relative to the reference class.
+	Coeff tensor.Float64
+
+	// StdErr are the standard errors of the coefficients.
+	StdErr tensor.Float64
+
+	// Z are the Wald z statistics of the coefficients, Coeff / StdErr.
+	Z tensor.Float64
+
+	// P are the two-sided p values of the Z statistics.
+	P tensor.Float64
+
+	// Deviance is the residual deviance of the model, -2 * LogLik.
+	Deviance float64
+
+	// NullDeviance is the deviance of the model with only an intercept
+	// (or no coefficients when there is no intercept).
+	NullDeviance float64
+
+	// AIC is the Akaike information criterion, -2 * LogLik + 2 * number
+	// of coefficients.
+	AIC float64
+
+	// LogLik is the log likelihood of the model.
+	LogLik float64
+
+	// N is the number of observations used in the fit.
+	N int
+
+	// Iters is the number of iterations used.
+	Iters int
+
+	// Converged is whether the fit converged within MaxIters iterations.
+	Converged bool
+
+	// Fitted are the fitted probabilities of each class for each observation,
+	// with a row for each observation and a column for each of the Classes.
+	Fitted *tensor.Float64
+
+	//////// Parameters for the model fitting:
+
+	// ZeroOffset restricts the linear predictors to have no intercept.
+	// This is only used by [Multinomial.Fit]: the formula determines
+	// the intercept for [Multinomial.FitTable].
+	ZeroOffset bool
+
+	// MaxIters is the maximum number of iterations.
+	MaxIters int `default:"50"`
+
+	// StopTolerance is the tolerance on the relative change in the deviance
+	// across iterations to stop iterating.
+	StopTolerance float64 `default:"1e-8"`
+
+	// intercept is whether the fit model has an intercept.
+	intercept bool
+}
+
+func NewMultinomial() *Multinomial {
+	mn := &Multinomial{}
+	mn.Defaults()
+	return mn
+}
+
+func (mn *Multinomial) Defaults() {
+	mn.MaxIters = 50
+	mn.StopTolerance = 1e-8
+}
+
+// Fit fits the model to predict the class of the dependent variable y,
+// which can be strings or numbers, with one value for each row of the
+// independent variables x, which is 1D for a single variable, or has the
+// variables in the inner dimension(s). The optional weights w give the
+// weight of each observation, and can be nil for weights of 1.
+func (mn *Multinomial) Fit(x, y, w tensor.Tensor) error {
+	mn.intercept = !mn.ZeroOffset
+	dx, names := designTensor(x, mn.intercept)
+	mn.Formula = nil
+	return mn.fit(dx, names, y, w)
+}
+
+// FitTable fits the model given by the formula (see [Formula]) to the
+// columns of given table, e.g., "Choice ~ Value + Cond", where the response
+// column has one string or number per row. The optional weights column gives
+// the weight of each row, and can be "" for weights of 1.
+func (mn *Multinomial) FitTable(dt *table.Table, formula, weights string) error {
+	f, err := ParseFormula(formula)
+	if err != nil {
+		return err
+	}
+	x, names, err := f.Design(dt)
+	if err != nil {
+		return err
+	}
+	y, err := dt.ColumnTry(f.Response)
+	if err != nil {
+		return err
+	}
+	if _, cells := y.Shape().RowCellSize(); cells != 1 {
+		return fmt.Errorf("glm: response %q must have one value per row", f.Response)
+	}
+	var w tensor.Tensor
+	if weights != "" {
+		wc, err := dt.ColumnTry(weights)
+		if err != nil {
+			return err
+		}
+		w = wc
+	}
+	mn.intercept = f.Intercept
+	mn.Formula = f
+	return mn.fit(x, names, y, w)
+}
+
+// Predict returns the probabilities of each class predicted by the model
+// fit with [Multinomial.Fit] for given independent variables, in the same
+// format as for the fit, with a row for each row of x and a column for each
+// of the Classes.
+func (mn *Multinomial) Predict(x tensor.Tensor) *tensor.Float64 {
+	dx, _ := designTensor(x, mn.intercept)
+	return mn.probs(dx)
+}
+
+// PredictTable returns the probabilities of each class predicted by the
+// model fit with [Multinomial.FitTable] for each row of given table, which
+// must have the columns used in the formula (except the response),
+// with a column for each of the Classes.
+func (mn *Multinomial) PredictTable(dt *table.Table) (*tensor.Float64, error) {
+	if mn.Formula == nil {
+		return nil, errors.New("glm: PredictTable requires a model fit with FitTable")
+	}
+	x, _, err := mn.Formula.Design(dt)
+	if err != nil {
+		return nil, err
+	}
+	return mn.probs(x), nil
+}
+
+// probs returns the class probabilities for given design matrix.
+func (mn *Multinomial) probs(x *tensor.Float64) *tensor.Float64 {
+	n, p := x.DimSize(0), x.DimSize(1)
+	nc := len(mn.Classes)
+	out := tensor.NewFloat64(n, nc)
+	for i := range n {
+		softmax(x.Values[i*p:(i+1)*p], mn.Coeff.Values, out.Values[i*nc:(i+1)*nc])
+	}
+	return out
+}
+
+// softmax sets the class probabilities for design matrix row x,
+// given coefficients with a row of p values for each non-reference class.
+func softmax(x, coeff, probs []float64) {
+	p := len(x)
+	probs[0] = 0
+	mx := 0.0
+	for k := 1; k < len(probs); k++ {
+		eta := 0.0
+		for j, v := range x {
+			eta += coeff[(k-1)*p+j] * v
+		}
+		probs[k] = eta
+		mx = max(mx, eta)
+	}
+	sum := 0.0
+	for k, eta := range probs {
+		probs[k] = math.Exp(eta - mx)
+		sum += probs[k]
+	}
+	for k := range probs {
+		probs[k] /= sum
+	}
+}
+
+// classes returns the class index of each value, with NaN for missing
+// values, along with the sorted classes, which are sorted numerically
+// for numbers.
+func classes(y tensor.Tensor) (*tensor.Float64, []string) {
+	n := y.Len()
+	var cls []string
+	if y.IsString() {
+		for i := range n {
+			if s := y.String1D(i); s != "" && !slices.Contains(cls, s) {
+				cls = append(cls, s)
+			}
+		}
+		slices.Sort(cls)
+	} else {
+		var vals []float64
+		for i := range n {
+			if v := y.Float1D(i); !math.IsNaN(v) && !slices.Contains(vals, v) {
+				vals = append(vals, v)
+			}
+		}
+		slices.Sort(vals)
+		for _, v := range vals {
+			cls = append(cls, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	}
+	ci := tensor.NewFloat64(n)
+	for i := range n {
+		ci.Values[i] = math.NaN()
+		s := y.String1D(i)
+		if !y.IsString() {
+			s = strconv.FormatFloat(y.Float1D(i), 'g', -1, 64)
+		}
+		if k := slices.Index(cls, s); k >= 0 {
+			ci.Values[i] = float64(k)
+		}
+	}
+	return ci, cls
+}
+
+// fit fits the model with given design matrix.
+func (mn *Multinomial) fit(x *tensor.Float64, names []string, y, w tensor.Tensor) error {
+	ci, cls := classes(y)
+	nc := len(cls)
+	if nc < 2 {
+		return errors.New("glm: response must have at least 2 classes")
+	}
+	p := x.DimSize(1)
+	xs, ys, pw, rows, err := observations(x, ci, w)
+	if err != nil {
+		return err
+	}
+	m := len(rows)
+	q := (nc - 1) * p
+	coeff := make([]float64, q)
+	prob := make([]float64, m*nc)
+	deviance := func(coeff []float64) float64 {
+		dev := 0.0
+		for k, c := range ys {
+			pr := prob[k*nc : (k+1)*nc]
+			softmax(xs.Values[k*p:(k+1)*p], coeff, pr)
+			dev -= 2 * pw[k] * math.Log(max(pr[int(c)], epsilon))
+		}
+		return dev
+	}
+	grad := make([]float64, q)
+	info := tensor.NewFloat64(q, q)
+	cov := tensor.NewFloat64()
+	// information sets grad and info (as the covariance of the coefficients)
+	// for the current probabilities.
+	information := func() error {
+		clear(grad)
+		clear(info.Values)
+		for k, c := range ys {
+			xr := xs.Values[k*p : (k+1)*p]
+			pr := prob[k*nc : (k+1)*nc]
+			for a := 1; a < nc; a++ {
+				ya := 0.0
+				if int(c) == a {
+					ya = 1
+				}
+				for j, xj := range xr {
+					grad[(a-1)*p+j] += pw[k] * xj * (ya - pr[a])
+				}
+				for b := 1; b < nc; b++ {
+					wab := -pr[a] * pr[b]
+					if a == b {
+						wab += pr[a]
+					}
+					wab *= pw[k]
+					for j, xj := range xr {
+						row := ((a-1)*p + j) * q
+						for h, xh := range xr {
+							info.Values[row+(b-1)*p+h] += wab * xj * xh
+						}
+					}
+				}
+			}
+		}
+		if err := matrix.InverseOut(info, cov); err != nil {
+			return ErrSingular
+		}
+		return nil
+	}
+
+	dev := deviance(coeff)
+	ndev := dev
+	mn.Converged = false
+	next := make([]float64, q)
+	for mn.Iters = 1; mn.Iters <= mn.MaxIters; mn.Iters++ {
+		if err := information(); err != nil {
+			return err
+		}
+		step := 1.0
+		for range 20 {
+			for a := range q {
+				d := 0.0
+				for b := range q {
+					d += cov.Values[a*q+b] * grad[b]
+				}
+				next[a] = coeff[a] + step*d
+			}
+			ndev = deviance(next)
+			if ndev <= dev*(1+1e-10) {
+				break
+			}
+			step *= 0.5
+		}
+		copy(coeff, next)
+		conv := math.Abs(ndev-dev)/(math.Abs(ndev)+0.1) < mn.StopTolerance
+		dev = ndev
+		if conv {
+			mn.Converged = true
+			break
+		}
+	}
+	mn.Iters = min(mn.Iters, mn.MaxIters)
+	deviance(coeff)
+	if err := information(); err != nil {
+		return err
+	}
+
+	mn.Classes = cls
+	mn.Names = names
+	mn.N = m
+	mn.Deviance = dev
+	mn.LogLik = -dev / 2
+	mn.AIC = dev + 2*float64(q)
+	mn.Coeff.SetShapeSizes(nc-1, p)
+	copy(mn.Coeff.Values, coeff)
+	mn.StdErr.SetShapeSizes(nc-1, p)
+	mn.Z.SetShapeSizes(nc-1, p)
+	mn.P.SetShapeSizes(nc-1, p)
+	for a := range q {
+		se := math.Sqrt(cov.Values[a*q+a])
+		mn.StdErr.Values[a] = se
+		mn.Z.Values[a] = coeff[a] / se
+		mn.P.Values[a] = 2 * dist.Normal{Mu: 0, Sigma: 1}.Survival(math.Abs(mn.Z.Values[a]))
+	}
+	mn.Fitted = mn.probs(x)
+	valid := make([]bool, x.DimSize(0))
+	for _, i := range rows {
+		valid[i] = true
+	}
+	for i, v := range valid {
+		if !v {
+			for c := range nc {
+				mn.Fitted.Values[i*nc+c] = math.NaN()
+			}
+		}
+	}
+
+	counts := make([]float64, nc)
+	for k, c := range ys {
+		counts[int(c)] += pw[k]
+	}
+	sw := 0.0
+	for _, c := range counts {
+		sw += c
+	}
+	mn.NullDeviance = 0
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		pr := 1 / float64(nc)
+		if mn.intercept {
+			pr = c / sw
+		}
+		mn.NullDeviance -= 2 * c * math.Log(pr)
+	}
+	return nil
+}
+
+// String returns a summary of the model fit, with a row for each
+// coefficient of each class.
+func (mn *Multinomial) String() string {
+	var b strings.Builder
+	if mn.Formula != nil {
+		b.WriteString(mn.Formula.String() + ", ")
+	}
+	b.WriteString("Multinomial, reference class: " + mn.Classes[0] + "\n")
+	p := len(mn.Names)
+	wd := len("Term")
+	for _, nm := range mn.Names {
+		for _, c := range mn.Classes[1:] {
+			wd = max(wd, len(c)+len(nm)+1)
+		}
+	}
+	fmt.Fprintf(&b, "%-*s  %10s  %10s  %8s  %10s\n", wd, "Term", "Estimate", "Std Err", "z", "p")
+	for a, c := range mn.Classes[1:] {
+		for j, nm := range mn.Names {
+			i := a*p + j
+			fmt.Fprintf(&b, "%-*s  %10.4g  %10.4g  %8.4g  %10.4g\n", wd, c+":"+nm, mn.Coeff.Values[i], mn.StdErr.Values[i], mn.Z.Values[i], mn.P.Values[i])
+		}
+	}
+	fmt.Fprintf(&b, "Deviance = %.4g, Null Deviance = %.4g, AIC = %.4g, N = %d\n", mn.Deviance, mn.NullDeviance, mn.AIC, mn.N)
+	return b.String()
+}
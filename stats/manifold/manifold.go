@@ -0,0 +1,121 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package manifold computes low-dimensional embeddings of items from a
+// distance matrix between them, such as computed by [metric.Matrix], for
+// visualizing the similarity structure of high-dimensional patterns
+// (e.g., in representational similarity analysis). It provides classical
+// and metric multidimensional scaling (MDS), Barnes-Hut t-SNE, and UMAP.
+// Each returns an N x Dims tensor of coordinates, which can be plotted
+// using [Plot].
+package manifold
+
+import (
+	"errors"
+
+	"cogentcore.org/lab/plot"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+)
+
+var (
+	// ErrShape is returned when the distance matrix is not a square 2D matrix.
+	ErrShape = errors.New("manifold: distance matrix must be a square 2D matrix")
+
+	// ErrTooFew is returned when there are too few items to embed.
+	ErrTooFew = errors.New("manifold: at least 3 items are required")
+
+	// ErrDims is returned when the number of embedding dimensions is invalid.
+	ErrDims = errors.New("manifold: invalid number of embedding dimensions")
+)
+
+// distances returns the values of given square distance matrix as
+// a flat n x n slice, symmetrized by averaging with its transpose,
+// along with n.
+func distances(dmat tensor.Tensor) ([]float64, int, error) {
+	if dmat.NumDims() != 2 || dmat.DimSize(0) != dmat.DimSize(1) {
+		return nil, 0, ErrShape
+	}
+	n := dmat.DimSize(0)
+	if n < 3 {
+		return nil, 0, ErrTooFew
+	}
+	d := make([]float64, n*n)
+	for i := range n {
+		for j := range i {
+			v := 0.5 * (dmat.Float(i, j) + dmat.Float(j, i))
+			d[i*n+j] = v
+			d[j*n+i] = v
+		}
+	}
+	return d, n, nil
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []float64) float64 {
+	d := 0.0
+	for k, v := range a {
+		e := v - b[k]
+		d += e * e
+	}
+	return d
+}
+
+// Plot sets the rows of given data table to render a scatter plot of
+// the given embedding coordinates (N x Dims, as returned by the embedding
+// functions), when plotted with a standard plotting package. The first two
+// dimensions are plotted as X and Y. The labels for each item are optional
+// and can be nil, as can the groups, which color the points for each
+// distinct group value differently (e.g., category or cluster labels).
+func Plot(pt *table.Table, coords, labels, groups tensor.Tensor) {
+	pt.DeleteAll()
+	n := coords.DimSize(0)
+	dims := 1
+	if coords.NumDims() > 1 {
+		dims = coords.DimSize(1)
+	}
+	xc := pt.AddFloat64Column("X")
+	yc := pt.AddFloat64Column("Y")
+	gc := pt.AddStringColumn("Group")
+	lc := pt.AddStringColumn("Label")
+	pt.SetNumRows(n)
+	for i := range n {
+		xc.SetFloat1D(coords.Float1D(i*dims), i)
+		if dims > 1 {
+			yc.SetFloat1D(coords.Float1D(i*dims+1), i)
+		}
+		if labels != nil && labels.Len() > i {
+			lc.SetString1D(labels.String1D(i), i)
+		}
+		if groups != nil && groups.Len() > i {
+			gc.SetString1D(groups.String1D(i), i)
+		}
+	}
+
+	plot.SetFirstStyler(xc, func(s *plot.Style) {
+		s.Role = plot.X
+	})
+	plot.SetFirstStyler(yc, func(s *plot.Style) {
+		s.On = true
+		s.Role = plot.Y
+		s.Plot.LinesOn = plot.Off
+		s.Plot.PointsOn = plot.On
+	})
+	if groups != nil {
+		plot.SetFirstStyler(gc, func(s *plot.Style) {
+			s.On = true
+			s.Role = plot.Split
+		})
+	}
+	if labels != nil {
+		plot.SetFirstStyler(lc, func(s *plot.Style) {
+			s.On = true
+			s.Role = plot.Label
+			s.Plotter = "Labels"
+			s.NoLegend = true
+			s.Text.Offset.Y.Dp(8)
+			s.Text.Offset.X.Dp(2)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/core/base/iox/imagex"
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/plot"
+	_ "cogentcore.org/lab/plot/plots"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// blobs returns n points in given number of dimensions around each of
+// nc random centers, with normally distributed noise of given standard
+// deviation, along with the index of the center for each point.
+func blobs(nc, n, dims int, sd float64, seed int64) (*tensor.Float64, []int) {
+	rnd := randx.NewSysRand(seed)
+	data := tensor.NewFloat64(nc*n, dims)
+	groups := make([]int, nc*n)
+	for c := range nc {
+		ctr := make([]float64, dims)
+		for k := range ctr {
+			ctr[k] = 10 * rnd.Float64()
+		}
+		for i := range n {
+			r := c*n + i
+			groups[r] = c
+			for k, v := range ctr {
+				data.Set(v+sd*rnd.NormFloat64(), r, k)
+			}
+		}
+	}
+	return data, groups
+}
+
+// neighborAccuracy returns the proportion of points whose nearest
+// neighbor in the embedding is in the same group.
+func neighborAccuracy(y *tensor.Float64, groups []int) float64 {
+	n, dims := y.DimSize(0), y.DimSize(1)
+	correct := 0
+	for i := range n {
+		best, bd := -1, math.Inf(1)
+		for j := range n {
+			if j == i {
+				continue
+			}
+			if d := sqDist(y.Values[i*dims:(i+1)*dims], y.Values[j*dims:(j+1)*dims]); d < bd {
+				best, bd = j, d
+			}
+		}
+		if groups[best] == groups[i] {
+			correct++
+		}
+	}
+	return float64(correct) / float64(n)
+}
+
+func TestClassicalMDS(t *testing.T) {
+	data := tensor.NewFloat64FromValues(0, 0, 3, 0, 0, 4, 3, 4, 1, 1)
+	data.SetShapeSizes(5, 2)
+	dmat := metric.Matrix(metric.L2Norm, data)
+	y, err := ClassicalMDS(dmat, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 2}, y.ShapeSizes())
+	ed := metric.Matrix(metric.L2Norm, y)
+	for i := range dmat.Len() {
+		assert.InDelta(t, dmat.Float1D(i), ed.Float1D(i), 1e-10)
+	}
+	// first dimension has the most variance
+	v0, v1 := 0.0, 0.0
+	for i := range 5 {
+		v0 += y.Value(i, 0) * y.Value(i, 0)
+		v1 += y.Value(i, 1) * y.Value(i, 1)
+	}
+	assert.Greater(t, v0, v1)
+
+	_, stress, err := MetricMDS(dmat, 2, 0)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, stress, 1e-6)
+
+	_, err = ClassicalMDS(tensor.NewFloat64(3, 4), 2)
+	assert.ErrorIs(t, err, ErrShape)
+	_, err = ClassicalMDS(dmat, 0)
+	assert.ErrorIs(t, err, ErrDims)
+}
+
+func TestMetricMDS(t *testing.T) {
+	// points on a 3D helix do not fit exactly in 2D
+	data := tensor.NewFloat64(30, 3)
+	for i := range 30 {
+		a := float64(i) * 0.4
+		data.Set(math.Cos(a), i, 0)
+		data.Set(math.Sin(a), i, 1)
+		data.Set(0.2*a, i, 2)
+	}
+	dmat := metric.Matrix(metric.L2Norm, data)
+	init, err := ClassicalMDS(dmat, 2)
+	assert.NoError(t, err)
+	_, initStress, _ := MetricMDS(dmat, 2, 1)
+	y, stress, err := MetricMDS(dmat, 2, 0)
+	assert.NoError(t, err)
+	assert.Greater(t, stress, 0.0)
+	assert.Less(t, stress, initStress)
+	assert.NotEqual(t, init.Values, y.Values)
+}
+
+func TestTSNE(t *testing.T) {
+	data, groups := blobs(3, 30, 10, 1, 1)
+	dmat := metric.Matrix(metric.L2Norm, data)
+	opts := NewTSNEOptions()
+	opts.Perplexity = 10
+	opts.Iters = 500
+	opts.Rand = randx.NewSysRand(1)
+	y, err := TSNE(dmat, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{90, 2}, y.ShapeSizes())
+	assert.Equal(t, 1.0, neighborAccuracy(y, groups))
+
+	// reproducible with the same seed
+	opts.Rand = randx.NewSysRand(1)
+	y2, err := TSNE(dmat, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, y.Values, y2.Values)
+
+	// exact and 3D
+	opts.Theta = 0
+	opts.Dims = 3
+	y, err = TSNE(dmat, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{90, 3}, y.ShapeSizes())
+	assert.Equal(t, 1.0, neighborAccuracy(y, groups))
+
+	opts.Dims = 4
+	_, err = TSNE(dmat, opts)
+	assert.ErrorIs(t, err, ErrDims)
+}
+
+func TestUMAP(t *testing.T) {
+	a, b := umapCurve(1, 0.1)
+	assert.InDelta(t, 1.577, a, 0.02)
+	assert.InDelta(t, 0.895, b, 0.01)
+
+	data, groups := blobs(3, 30, 10, 1, 2)
+	dmat := metric.Matrix(metric.L2Norm, data)
+	opts := NewUMAPOptions()
+	opts.Epochs = 200
+	opts.Rand = randx.NewSysRand(2)
+	y, err := UMAP(dmat, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{90, 2}, y.ShapeSizes())
+	assert.Equal(t, 1.0, neighborAccuracy(y, groups))
+
+	opts.Rand = randx.NewSysRand(2)
+	y2, err := UMAP(dmat, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, y.Values, y2.Values)
+}
+
+func TestPlot(t *testing.T) {
+	dt := table.New()
+	err := dt.OpenCSV("../cluster/testdata/faces.dat", tensor.Tab)
+	assert.NoError(t, err)
+	dmat := metric.Matrix(metric.L2Norm, dt.Column("Input"))
+	y, err := ClassicalMDS(dmat, 2)
+	assert.NoError(t, err)
+
+	groups := tensor.NewString(12)
+	for i := range 12 {
+		groups.SetString1D([]string{"happy", "sad"}[i%2], i)
+	}
+	pt := table.New()
+	Plot(pt, y, dt.Column("Name"), groups)
+	assert.Equal(t, 12, pt.NumRows())
+	plt, err := plot.NewTablePlot(pt)
+	assert.NoError(t, err)
+	imagex.Assert(t, plt.RenderImage(), "mds.png")
+}
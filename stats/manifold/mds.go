@@ -0,0 +1,152 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/tensor"
+)
+
+// ClassicalMDS returns the classical (Torgerson) multidimensional scaling
+// embedding of the items in given square distance matrix into the given
+// number of dimensions, as an N x dims tensor. The coordinates are the
+// eigenvectors of the double-centered squared distance matrix with the
+// largest eigenvalues, scaled by the square root of the eigenvalues, so
+// that the first dimension captures the most variance. For Euclidean
+// distances, this is equivalent to PCA of the original data, and the
+// distances are reproduced exactly when dims is large enough.
+// The sign of each dimension is set so that its largest value is positive.
+func ClassicalMDS(dmat tensor.Tensor, dims int) (*tensor.Float64, error) {
+	d, n, err := distances(dmat)
+	if err != nil {
+		return nil, err
+	}
+	if dims < 1 || dims > n {
+		return nil, ErrDims
+	}
+	b := tensor.NewFloat64(n, n)
+	means := make([]float64, n)
+	mean := 0.0
+	for i := range n {
+		for j := range n {
+			sq := d[i*n+j] * d[i*n+j]
+			b.Values[i*n+j] = sq
+			means[i] += sq / float64(n)
+		}
+		mean += means[i] / float64(n)
+	}
+	for i := range n {
+		for j := range n {
+			b.Values[i*n+j] = -0.5 * (b.Values[i*n+j] - means[i] - means[j] + mean)
+		}
+	}
+	vecs, vals := tensor.NewFloat64(), tensor.NewFloat64()
+	if err := matrix.EigSymOut(b, vecs, vals); err != nil {
+		return nil, err
+	}
+	out := tensor.NewFloat64(n, dims)
+	for c := range dims {
+		col := n - 1 - c // highest eigenvalues are last
+		scale := math.Sqrt(max(vals.Values[col], 0))
+		big := 0.0
+		for i := range n {
+			if v := vecs.Value(i, col); math.Abs(v) > math.Abs(big) {
+				big = v
+			}
+		}
+		if big < 0 {
+			scale = -scale
+		}
+		for i := range n {
+			out.Values[i*dims+c] = scale * vecs.Value(i, col)
+		}
+	}
+	return out, nil
+}
+
+// mdsTolerance is the relative decrease in stress below which
+// [MetricMDS] stops.
+const mdsTolerance = 1e-9
+
+// MetricMDS returns the metric multidimensional scaling embedding of the
+// items in given square distance matrix into the given number of dimensions,
+// as an N x dims tensor, which minimizes the stress: the sum of squared
+// differences between the distances in the matrix and the Euclidean
+// distances in the embedding. This does not require the distances to
+// be Euclidean, unlike [ClassicalMDS], which is used as the starting point.
+// It uses the SMACOF majorization algorithm for up to maxIters iterations
+// (300 if maxIters is 0), and also returns the normalized stress of the
+// result (Kruskal's stress-1), which is 0 for a perfect embedding.
+func MetricMDS(dmat tensor.Tensor, dims, maxIters int) (*tensor.Float64, float64, error) {
+	x, err := ClassicalMDS(dmat, dims)
+	if err != nil {
+		return nil, 0, err
+	}
+	d, n, _ := distances(dmat)
+	if maxIters <= 0 {
+		maxIters = 300
+	}
+	norm := 0.0
+	for _, v := range d {
+		norm += v * v
+	}
+	stress := func(xs []float64) float64 {
+		s := 0.0
+		for i := range n {
+			for j := range i {
+				e := d[i*n+j] - math.Sqrt(sqDist(xs[i*dims:(i+1)*dims], xs[j*dims:(j+1)*dims]))
+				s += e * e
+			}
+		}
+		return s
+	}
+	s := stress(x.Values)
+	bx := make([]float64, n*dims)
+	for range maxIters {
+		// Guttman transform: x = B(x) x / n
+		for i := range n {
+			xi := x.Values[i*dims : (i+1)*dims]
+			bi := bx[i*dims : (i+1)*dims]
+			for k := range bi {
+				bi[k] = 0
+			}
+			bii := 0.0
+			for j := range n {
+				if j == i {
+					continue
+				}
+				xj := x.Values[j*dims : (j+1)*dims]
+				dx := math.Sqrt(sqDist(xi, xj))
+				if dx == 0 {
+					continue
+				}
+				bij := -d[i*n+j] / dx
+				bii -= bij
+				for k, v := range xj {
+					bi[k] += bij * v
+				}
+			}
+			for k, v := range xi {
+				bi[k] = (bi[k] + bii*v) / float64(n)
+			}
+		}
+		ns := stress(bx)
+		if ns > s {
+			break
+		}
+		copy(x.Values, bx)
+		done := s-ns <= mdsTolerance*s
+		s = ns
+		if done {
+			break
+		}
+	}
+	if norm == 0 {
+		return x, 0, nil
+	}
+	return x, math.Sqrt(2 * s / norm), nil
+}
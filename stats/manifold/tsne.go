@@ -0,0 +1,404 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+	"slices"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/tensor"
+)
+
+// TSNEOptions are the options for [TSNE].
+type TSNEOptions struct {
+
+	// Dims is the number of embedding dimensions, from 1 to 3.
+	Dims int `default:"2" min:"1" max:"3"`
+
+	// Perplexity is the effective number of neighbors of each item,
+	// which determines the width of the Gaussian similarity kernel for
+	// each item. Typical values are 5 to 50, with larger values emphasizing
+	// more global structure. It is limited to (N-1) / 3.
+	Perplexity float64 `default:"30"`
+
+	// Theta is the Barnes-Hut approximation parameter: groups of points
+	// in the embedding whose extent relative to their distance is less
+	// than Theta are treated as a single point when computing repulsive
+	// forces. Larger values are faster but less accurate, and 0 computes
+	// the forces exactly, which takes O(N²) time per iteration.
+	Theta float64 `default:"0.5"`
+
+	// Iters is the number of gradient descent iterations.
+	Iters int `default:"1000"`
+
+	// LearningRate is the gradient descent learning rate.
+	LearningRate float64 `default:"200"`
+
+	// Exaggeration is the factor by which the input similarities are
+	// multiplied during the initial ExaggerationIters iterations,
+	// which helps to form well separated clusters.
+	Exaggeration float64 `default:"12"`
+
+	// ExaggerationIters is the number of initial iterations with
+	// exaggerated similarities and lower momentum.
+	ExaggerationIters int `default:"250"`
+
+	// Rand is the random number source used for the initial embedding.
+	// Use a source with a given seed for reproducible results,
+	// e.g., [randx.NewSysRand]. If nil, the global source is used.
+	Rand randx.Rand
+}
+
+// NewTSNEOptions returns new TSNEOptions with default values.
+func NewTSNEOptions() *TSNEOptions {
+	o := &TSNEOptions{}
+	o.Defaults()
+	return o
+}
+
+func (o *TSNEOptions) Defaults() {
+	o.Dims = 2
+	o.Perplexity = 30
+	o.Theta = 0.5
+	o.Iters = 1000
+	o.LearningRate = 200
+	o.Exaggeration = 12
+	o.ExaggerationIters = 250
+}
+
+// TSNE returns the t-distributed stochastic neighbor embedding (t-SNE)
+// of the items in given square distance matrix, as an N x Dims tensor,
+// using the Barnes-Hut approximation (van der Maaten, 2014), with given
+// options, which can be nil to use the defaults. The similarity of each
+// item to its 3 * Perplexity nearest neighbors is a Gaussian function of
+// the squared distance, with a width set for each item to achieve the
+// Perplexity, and the embedding minimizes the divergence between these
+// similarities and those of a heavy-tailed Student t distribution in the
+// embedding. t-SNE preserves local neighborhood structure, such as
+// clusters, but the distances between clusters are not meaningful.
+func TSNE(dmat tensor.Tensor, opts *TSNEOptions) (*tensor.Float64, error) {
+	if opts == nil {
+		opts = NewTSNEOptions()
+	}
+	d, n, err := distances(dmat)
+	if err != nil {
+		return nil, err
+	}
+	dims := opts.Dims
+	if dims < 1 || dims > 3 {
+		return nil, ErrDims
+	}
+	rnd := opts.Rand
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	nbrs, ps := tsneSimilarities(d, n, min(opts.Perplexity, float64(n-1)/3))
+
+	y := tensor.NewFloat64(n, dims)
+	for i := range y.Values {
+		y.Values[i] = 1e-4 * rnd.NormFloat64()
+	}
+	attr := make([]float64, n*dims)
+	rep := make([]float64, n*dims)
+	update := make([]float64, n*dims)
+	gains := make([]float64, n*dims)
+	for i := range gains {
+		gains[i] = 1
+	}
+	tr := &spTree{dims: dims}
+	theta2 := opts.Theta * opts.Theta
+	for it := range opts.Iters {
+		exag, momentum := 1.0, 0.8
+		if it < opts.ExaggerationIters {
+			exag, momentum = opts.Exaggeration, 0.5
+		}
+		tr.build(y.Values)
+		for i := range attr {
+			attr[i], rep[i] = 0, 0
+		}
+		z := 0.0
+		for i := range n {
+			yi := y.Values[i*dims : (i+1)*dims]
+			ai := attr[i*dims : (i+1)*dims]
+			for e, j := range nbrs[i] {
+				yj := y.Values[j*dims : (j+1)*dims]
+				f := ps[i][e] / (1 + sqDist(yi, yj))
+				for k, v := range yi {
+					ai[k] += f * (v - yj[k])
+				}
+			}
+			z += tr.repulsion(0, i, yi, theta2, rep[i*dims:(i+1)*dims])
+		}
+		for i, u := range update {
+			g := 4 * (exag*attr[i] - rep[i]/z)
+			if (g > 0) != (u > 0) {
+				gains[i] += 0.2
+			} else {
+				gains[i] = max(gains[i]*0.8, 0.01)
+			}
+			update[i] = momentum*u - opts.LearningRate*gains[i]*g
+			y.Values[i] += update[i]
+		}
+		center(y.Values, n, dims)
+	}
+	return y, nil
+}
+
+// center subtracts the mean of each dimension from the n x dims coordinates.
+func center(y []float64, n, dims int) {
+	for k := range dims {
+		mean := 0.0
+		for i := range n {
+			mean += y[i*dims+k]
+		}
+		mean /= float64(n)
+		for i := range n {
+			y[i*dims+k] -= mean
+		}
+	}
+}
+
+// tsneSimilarities returns the nearest neighbors of each item, and the
+// symmetrized joint similarities p_ij with each of them, which sum to 1.
+func tsneSimilarities(d []float64, n int, perp float64) ([][]int, [][]float64) {
+	k := min(n-1, int(3*perp))
+	p := make([]float64, n*n)
+	target := math.Log(perp)
+	d2 := make([]float64, k)
+	w := make([]float64, k)
+	for i := range n {
+		nb := nearest(d, n, i, k)
+		for e, j := range nb {
+			d2[e] = d[i*n+j] * d[i*n+j]
+		}
+		// binary search on the precision beta for the target entropy,
+		// with the distances shifted by the nearest for numerical stability
+		beta, lo, hi := 1.0, 0.0, math.Inf(1)
+		for range 200 {
+			sum, dsum := 0.0, 0.0
+			for e, v := range d2 {
+				w[e] = math.Exp(-beta * (v - d2[0]))
+				sum += w[e]
+				dsum += w[e] * (v - d2[0])
+			}
+			h := math.Log(sum) + beta*dsum/sum
+			if math.Abs(h-target) < 1e-5 {
+				break
+			}
+			if h > target {
+				lo = beta
+				if math.IsInf(hi, 1) {
+					beta *= 2
+				} else {
+					beta = (beta + hi) / 2
+				}
+			} else {
+				hi = beta
+				beta = (beta + lo) / 2
+			}
+		}
+		sum := 0.0
+		for _, v := range w {
+			sum += v
+		}
+		for e, j := range nb {
+			v := w[e] / (sum * float64(2*n))
+			p[i*n+j] += v
+			p[j*n+i] += v
+		}
+	}
+	nbrs := make([][]int, n)
+	ps := make([][]float64, n)
+	for i := range n {
+		for j, v := range p[i*n : (i+1)*n] {
+			if v > 0 {
+				nbrs[i] = append(nbrs[i], j)
+				ps[i] = append(ps[i], v)
+			}
+		}
+	}
+	return nbrs, ps
+}
+
+// nearest returns the indexes of the k nearest neighbors of item i
+// according to the n x n distances, in order of increasing distance.
+func nearest(d []float64, n, i, k int) []int {
+	idx := make([]int, 0, n-1)
+	for j := range n {
+		if j != i {
+			idx = append(idx, j)
+		}
+	}
+	row := d[i*n : (i+1)*n]
+	slices.SortStableFunc(idx, func(a, b int) int {
+		switch {
+		case row[a] < row[b]:
+			return -1
+		case row[a] > row[b]:
+			return 1
+		}
+		return 0
+	})
+	return idx[:k]
+}
+
+// spTree is a space-partitioning tree (a quadtree for 2 dimensions and an
+// octree for 3) over the embedding points, used for the Barnes-Hut
+// approximation of the repulsive forces in [TSNE].
+type spTree struct {
+	dims  int
+	y     []float64
+	nodes []spNode
+}
+
+// spNode is a cell in a [spTree], stored by index in the tree nodes.
+type spNode struct {
+
+	// center and half width of the cell, for each dimension
+	center, half [3]float64
+
+	// center of mass of the points in the cell
+	com [3]float64
+
+	// number of points in the cell
+	count int
+
+	// index of the first child cell, or 0 if a leaf
+	kids int
+
+	// points in a leaf cell, which has more than one point only
+	// at the maximum depth (i.e., for duplicate points)
+	points []int
+}
+
+// spMaxDepth is the maximum depth of a [spTree].
+const spMaxDepth = 40
+
+// build rebuilds the tree for given points.
+func (tr *spTree) build(y []float64) {
+	tr.y = y
+	tr.nodes = tr.nodes[:0]
+	n := len(y) / tr.dims
+	var root spNode
+	for k := range tr.dims {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for i := range n {
+			lo = min(lo, y[i*tr.dims+k])
+			hi = max(hi, y[i*tr.dims+k])
+		}
+		root.center[k] = (lo + hi) / 2
+		root.half[k] = max((hi-lo)/2*(1+1e-5), 1e-5)
+	}
+	tr.nodes = append(tr.nodes, root)
+	for i := range n {
+		tr.insert(0, i, 0)
+	}
+}
+
+// point returns the coordinates of point i.
+func (tr *spTree) point(i int) []float64 {
+	return tr.y[i*tr.dims : (i+1)*tr.dims]
+}
+
+// insert inserts point i into the given node at given depth.
+func (tr *spTree) insert(ni, i, depth int) {
+	yi := tr.point(i)
+	nd := &tr.nodes[ni]
+	nd.count++
+	for k, v := range yi {
+		nd.com[k] += (v - nd.com[k]) / float64(nd.count)
+	}
+	if nd.kids == 0 {
+		if len(nd.points) == 0 || depth >= spMaxDepth {
+			nd.points = append(nd.points, i)
+			return
+		}
+		tr.split(ni)
+		nd = &tr.nodes[ni]
+		for _, j := range nd.points {
+			tr.insert(nd.kids+tr.child(ni, tr.point(j)), j, depth+1)
+			nd = &tr.nodes[ni]
+		}
+		nd.points = nil
+	}
+	tr.insert(nd.kids+tr.child(ni, yi), i, depth+1)
+}
+
+// split adds the child cells of given node.
+func (tr *spTree) split(ni int) {
+	nk := 1 << tr.dims
+	first := len(tr.nodes)
+	for c := range nk {
+		var kid spNode
+		for k := range tr.dims {
+			kid.half[k] = tr.nodes[ni].half[k] / 2
+			if c&(1<<k) != 0 {
+				kid.center[k] = tr.nodes[ni].center[k] + kid.half[k]
+			} else {
+				kid.center[k] = tr.nodes[ni].center[k] - kid.half[k]
+			}
+		}
+		tr.nodes = append(tr.nodes, kid)
+	}
+	tr.nodes[ni].kids = first
+}
+
+// child returns the index of the child of given node containing point y.
+func (tr *spTree) child(ni int, y []float64) int {
+	c := 0
+	for k, v := range y {
+		if v > tr.nodes[ni].center[k] {
+			c |= 1 << k
+		}
+	}
+	return c
+}
+
+// repulsion adds the unnormalized repulsive force on point i with
+// coordinates yi from the points in given node to force, and returns the
+// sum of the Student t similarities to them (the normalization term),
+// treating cells as single points when their squared width relative to
+// their squared distance is less than theta2.
+func (tr *spTree) repulsion(ni, i int, yi []float64, theta2 float64, force []float64) float64 {
+	nd := &tr.nodes[ni]
+	if nd.count == 0 {
+		return 0
+	}
+	if nd.kids == 0 {
+		z := 0.0
+		for _, j := range nd.points {
+			if j == i {
+				continue
+			}
+			yj := tr.point(j)
+			q := 1 / (1 + sqDist(yi, yj))
+			z += q
+			for k, v := range yi {
+				force[k] += q * q * (v - yj[k])
+			}
+		}
+		return z
+	}
+	com := nd.com[:tr.dims]
+	d2 := sqDist(yi, com)
+	width := 0.0
+	for k := range tr.dims {
+		width = max(width, 2*nd.half[k])
+	}
+	if width*width < theta2*d2 {
+		q := 1 / (1 + d2)
+		cq := float64(nd.count) * q
+		for k, v := range yi {
+			force[k] += cq * q * (v - com[k])
+		}
+		return cq
+	}
+	z := 0.0
+	for c := range 1 << tr.dims {
+		z += tr.repulsion(nd.kids+c, i, yi, theta2, force)
+	}
+	return z
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/matrix"
+	"cogentcore.org/lab/stats/fit"
+	"cogentcore.org/lab/tensor"
+)
+
+// UMAPOptions are the options for [UMAP].
+type UMAPOptions struct {
+
+	// Dims is the number of embedding dimensions.
+	Dims int `default:"2" min:"1"`
+
+	// Neighbors is the number of nearest neighbors of each item in the
+	// neighbor graph, which determines the scale of the structure that
+	// is preserved: smaller values emphasize local structure, and larger
+	// values more global structure. It is limited to N-1.
+	Neighbors int `default:"15"`
+
+	// MinDist is the minimum distance between points in the embedding,
+	// relative to Spread: smaller values produce more tightly packed
+	// clusters.
+	MinDist float64 `default:"0.1"`
+
+	// Spread is the scale of the embedded points, which together with
+	// MinDist determines how clumped they are.
+	Spread float64 `default:"1"`
+
+	// Epochs is the number of optimization epochs.
+	Epochs int `default:"500"`
+
+	// LearningRate is the initial learning rate, which decreases
+	// linearly to 0 over the epochs.
+	LearningRate float64 `default:"1"`
+
+	// NegativeSamples is the number of random items that each item
+	// is pushed away from for each neighbor it is pulled toward.
+	NegativeSamples int `default:"5"`
+
+	// Rand is the random number source used for the negative sampling.
+	// Use a source with a given seed for reproducible results,
+	// e.g., [randx.NewSysRand]. If nil, the global source is used.
+	Rand randx.Rand
+}
+
+// NewUMAPOptions returns new UMAPOptions with default values.
+func NewUMAPOptions() *UMAPOptions {
+	o := &UMAPOptions{}
+	o.Defaults()
+	return o
+}
+
+func (o *UMAPOptions) Defaults() {
+	o.Dims = 2
+	o.Neighbors = 15
+	o.MinDist = 0.1
+	o.Spread = 1
+	o.Epochs = 500
+	o.LearningRate = 1
+	o.NegativeSamples = 5
+}
+
+// UMAP returns a uniform manifold approximation and projection (UMAP)
+// embedding (McInnes et al., 2018) of the items in given square distance
+// matrix, as an N x Dims tensor, with given options, which can be nil to
+// use the defaults. It constructs a weighted graph connecting each item to
+// its nearest neighbors, with weights that decrease with the distance
+// beyond the nearest neighbor, scaled for each item so that every item has
+// the same total weight. The embedding starts from the spectral embedding
+// of this graph, and is optimized by stochastic gradient descent to pull
+// neighbors together and push random other items apart. Compared to
+// [TSNE], UMAP tends to better preserve the global structure of the data.
+func UMAP(dmat tensor.Tensor, opts *UMAPOptions) (*tensor.Float64, error) {
+	if opts == nil {
+		opts = NewUMAPOptions()
+	}
+	d, n, err := distances(dmat)
+	if err != nil {
+		return nil, err
+	}
+	dims := opts.Dims
+	if dims < 1 || dims >= n {
+		return nil, ErrDims
+	}
+	rnd := opts.Rand
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	w := umapGraph(d, n, min(opts.Neighbors, n-1))
+	y := spectralInit(w, n, dims)
+	a, b := umapCurve(opts.Spread, opts.MinDist)
+
+	// edges in both directions, sampled in proportion to their weight
+	var heads, tails []int
+	var epochsPer []float64
+	maxw := 0.0
+	for _, v := range w {
+		maxw = max(maxw, v)
+	}
+	for i := range n {
+		for j := range n {
+			if v := w[i*n+j]; v > 0 && v >= maxw/float64(opts.Epochs) {
+				heads = append(heads, i)
+				tails = append(tails, j)
+				epochsPer = append(epochsPer, maxw/v)
+			}
+		}
+	}
+	nextSample := make([]float64, len(heads))
+	nextNeg := make([]float64, len(heads))
+	negPer := make([]float64, len(heads))
+	for e, ep := range epochsPer {
+		nextSample[e] = ep
+		negPer[e] = ep / float64(max(opts.NegativeSamples, 1))
+		nextNeg[e] = negPer[e]
+	}
+	clip := func(v float64) float64 {
+		return max(-4, min(4, v))
+	}
+	for epoch := range opts.Epochs {
+		ep := float64(epoch)
+		alpha := opts.LearningRate * (1 - ep/float64(opts.Epochs))
+		for e, i := range heads {
+			if nextSample[e] > ep {
+				continue
+			}
+			yi := y.Values[i*dims : (i+1)*dims]
+			yj := y.Values[tails[e]*dims : (tails[e]+1)*dims]
+			d2 := sqDist(yi, yj)
+			gc := 0.0
+			if d2 > 0 {
+				gc = -2 * a * b * math.Pow(d2, b-1) / (a*math.Pow(d2, b) + 1)
+			}
+			for k := range yi {
+				g := clip(gc*(yi[k]-yj[k])) * alpha
+				yi[k] += g
+				yj[k] -= g
+			}
+			nextSample[e] += epochsPer[e]
+			if opts.NegativeSamples <= 0 {
+				continue
+			}
+			nneg := int((ep - nextNeg[e]) / negPer[e])
+			for range nneg {
+				j := rnd.Intn(n)
+				if j == i {
+					continue
+				}
+				yj := y.Values[j*dims : (j+1)*dims]
+				d2 := sqDist(yi, yj)
+				gc := 0.0
+				if d2 > 0 {
+					gc = 2 * b / ((0.001 + d2) * (a*math.Pow(d2, b) + 1))
+				}
+				for k := range yi {
+					g := 4.0
+					if gc > 0 {
+						g = clip(gc * (yi[k] - yj[k]))
+					}
+					yi[k] += g * alpha
+				}
+			}
+			nextNeg[e] += float64(nneg) * negPer[e]
+		}
+	}
+	return y, nil
+}
+
+// umapGraph returns the n x n symmetric weights of the fuzzy nearest
+// neighbor graph with k neighbors for each item.
+func umapGraph(d []float64, n, k int) []float64 {
+	w := make([]float64, n*n)
+	mean := 0.0
+	for _, v := range d {
+		mean += v / float64(n*n)
+	}
+	target := math.Log2(float64(k))
+	for i := range n {
+		nb := nearest(d, n, i, k)
+		rho := 0.0
+		for _, j := range nb {
+			if d[i*n+j] > 0 {
+				rho = d[i*n+j]
+				break
+			}
+		}
+		// binary search on sigma such that the weights sum to log2(k)
+		sigma, lo, hi := 1.0, 0.0, math.Inf(1)
+		for range 64 {
+			sum := 0.0
+			for _, j := range nb {
+				sum += math.Exp(-max(d[i*n+j]-rho, 0) / sigma)
+			}
+			if math.Abs(sum-target) < 1e-5 {
+				break
+			}
+			if sum > target {
+				hi = sigma
+				sigma = (lo + hi) / 2
+			} else {
+				lo = sigma
+				if math.IsInf(hi, 1) {
+					sigma *= 2
+				} else {
+					sigma = (lo + hi) / 2
+				}
+			}
+		}
+		sigma = max(sigma, 1e-3*mean)
+		for _, j := range nb {
+			w[i*n+j] = math.Exp(-max(d[i*n+j]-rho, 0) / sigma)
+		}
+	}
+	// fuzzy union: a + b - a * b
+	for i := range n {
+		for j := range i {
+			a, b := w[i*n+j], w[j*n+i]
+			v := a + b - a*b
+			w[i*n+j] = v
+			w[j*n+i] = v
+		}
+	}
+	return w
+}
+
+// spectralInit returns the initial embedding from the eigenvectors of the
+// normalized Laplacian of given graph weights with the lowest nonzero
+// eigenvalues, scaled to the range 0 to 10 in each dimension.
+func spectralInit(w []float64, n, dims int) *tensor.Float64 {
+	deg := make([]float64, n)
+	for i := range n {
+		for _, v := range w[i*n : (i+1)*n] {
+			deg[i] += v
+		}
+	}
+	lap := tensor.NewFloat64(n, n)
+	for i := range n {
+		for j := range n {
+			v := 0.0
+			if deg[i] > 0 && deg[j] > 0 {
+				v = -w[i*n+j] / math.Sqrt(deg[i]*deg[j])
+			}
+			if i == j {
+				v += 1
+			}
+			lap.Values[i*n+j] = v
+		}
+	}
+	vecs, vals := tensor.NewFloat64(), tensor.NewFloat64()
+	y := tensor.NewFloat64(n, dims)
+	if matrix.EigSymOut(lap, vecs, vals) != nil {
+		return y
+	}
+	for c := range dims {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for i := range n {
+			v := vecs.Value(i, c+1) // skip the trivial first eigenvector
+			lo, hi = min(lo, v), max(hi, v)
+		}
+		for i := range n {
+			if hi > lo {
+				y.Values[i*dims+c] = 10 * (vecs.Value(i, c+1) - lo) / (hi - lo)
+			}
+		}
+	}
+	return y
+}
+
+// umapCurve returns the parameters a and b of the curve 1 / (1 + a d^(2b))
+// that best fits the target similarity as a function of the embedding
+// distance d, which is 1 up to minDist and decays exponentially with
+// given spread beyond that.
+func umapCurve(spread, minDist float64) (a, b float64) {
+	const n = 300
+	x := tensor.NewFloat64(n)
+	y := tensor.NewFloat64(n)
+	for i := range n {
+		v := 3 * spread * float64(i) / (n - 1)
+		x.Values[i] = v
+		y.Values[i] = 1
+		if v >= minDist {
+			y.Values[i] = math.Exp(-(v - minDist) / spread)
+		}
+	}
+	res, err := fit.Curve(func(x float64, p []float64) float64 {
+		return 1 / (1 + p[0]*math.Pow(x, 2*p[1]))
+	}, x, y, []float64{1, 1})
+	if err != nil || res.Params[0] <= 0 || res.Params[1] <= 0 {
+		return 1.577, 0.895 // values for the default parameters
+	}
+	return res.Params[0], res.Params[1]
+}
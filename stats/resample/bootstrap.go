@@ -0,0 +1,221 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/stats/stats"
+	"cogentcore.org/lab/tensor"
+)
+
+// BootstrapResult has the results of a bootstrap, from which confidence
+// intervals are computed by the [BootstrapResult.Percentile] and
+// [BootstrapResult.BCa] methods. All of the tensors have the shape of
+// the statistic as the cells, e.g., a single value for a 1D input.
+type BootstrapResult struct {
+
+	// Estimate is the statistic computed on the original data.
+	Estimate *tensor.Float64
+
+	// Samples has the statistic computed on each bootstrap sample,
+	// as the outer row dimension.
+	Samples *tensor.Float64
+
+	// Jackknife has the statistic computed with each row of the
+	// original data left out, as the outer row dimension, which is used
+	// to compute the acceleration of the BCa interval.
+	Jackknife *tensor.Float64
+}
+
+// Bootstrap computes the given statistic on n bootstrap samples of the
+// rows of the given data, each of which has the same number of rows drawn
+// at random with replacement, using the given random number source
+// (the global source if nil). For example, to compute a 95% confidence
+// interval for the median:
+//
+//	br, err := resample.Bootstrap(stats.Median, data, 10000, rnd)
+//	low, high := br.BCa(0.95)
+func Bootstrap(stat stats.StatsFunc, data tensor.Tensor, n int, rnd randx.Rand) (*BootstrapResult, error) {
+	vals := data.AsValues()
+	nr := vals.DimSize(0)
+	if nr < 2 || n < 2 {
+		return nil, ErrTooFew
+	}
+	flops := vals.Len()
+	br := &BootstrapResult{Estimate: tensor.AsFloat64(stat(vals))}
+	br.Samples = replicates(n, flops, rnd, func(idx int, rnd randx.Rand) tensor.Values {
+		return stat(tensor.NewRows(vals, sampleRows(nr, rnd)...))
+	})
+	br.Jackknife = parallel(nr, flops, func(idx int) tensor.Values {
+		return stat(tensor.NewRows(vals, leaveOut(nr, idx)...))
+	})
+	return br, nil
+}
+
+// BootstrapMetric computes the given metric between the rows of a and b
+// on n bootstrap samples of the rows, which are drawn at random with
+// replacement using the given random number source (the global source
+// if nil). The same rows are used for a and b, which must have the same
+// number of rows, so that their pairing is preserved, e.g., for a
+// confidence interval for the [metric.Correlation] of a and b.
+func BootstrapMetric(fun metric.MetricFunc, a, b tensor.Tensor, n int, rnd randx.Rand) (*BootstrapResult, error) {
+	av, bv := a.AsValues(), b.AsValues()
+	nr := av.DimSize(0)
+	if bv.DimSize(0) != nr {
+		return nil, fmt.Errorf("resample.BootstrapMetric: a and b must have the same number of rows: %d != %d", nr, bv.DimSize(0))
+	}
+	if nr < 2 || n < 2 {
+		return nil, ErrTooFew
+	}
+	flops := 2 * av.Len()
+	br := &BootstrapResult{Estimate: tensor.AsFloat64(fun(av, bv))}
+	br.Samples = replicates(n, flops, rnd, func(idx int, rnd randx.Rand) tensor.Values {
+		rows := sampleRows(nr, rnd)
+		return fun(tensor.NewRows(av, rows...), tensor.NewRows(bv, rows...))
+	})
+	br.Jackknife = parallel(nr, flops, func(idx int) tensor.Values {
+		rows := leaveOut(nr, idx)
+		return fun(tensor.NewRows(av, rows...), tensor.NewRows(bv, rows...))
+	})
+	return br, nil
+}
+
+// sampleRows returns n row indexes drawn at random with replacement.
+func sampleRows(n int, rnd randx.Rand) []int {
+	rows := make([]int, n)
+	for i := range rows {
+		rows[i] = rnd.Intn(n)
+	}
+	return rows
+}
+
+// leaveOut returns the n row indexes except the given one.
+func leaveOut(n, out int) []int {
+	rows := make([]int, 0, n-1)
+	for i := range n {
+		if i != out {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+// StdErr returns the bootstrap standard error of the statistic,
+// which is the standard deviation of the samples.
+func (br *BootstrapResult) StdErr() *tensor.Float64 {
+	return tensor.AsFloat64(stats.Std(br.Samples))
+}
+
+// Bias returns the bootstrap estimate of the bias of the statistic,
+// which is the mean of the samples minus the estimate.
+func (br *BootstrapResult) Bias() *tensor.Float64 {
+	out := tensor.AsFloat64(stats.Mean(br.Samples))
+	for i := range out.Values {
+		out.Values[i] -= br.Estimate.Values[i]
+	}
+	return out
+}
+
+// Percentile returns the lower and upper bounds of the percentile
+// confidence interval with given confidence level (e.g., 0.95),
+// which are the (1-conf)/2 and (1+conf)/2 quantiles of the samples.
+func (br *BootstrapResult) Percentile(conf float64) (low, high *tensor.Float64) {
+	alpha := (1 - conf) / 2
+	return br.interval(func(cell int) (float64, float64) { return alpha, 1 - alpha })
+}
+
+// BCa returns the lower and upper bounds of the bias-corrected and
+// accelerated (BCa) confidence interval with given confidence level
+// (e.g., 0.95), which adjusts the quantiles of the percentile interval
+// for the bias and skewness of the sample distribution, as estimated
+// from the samples and the jackknife values, and is more accurate than
+// the percentile interval for statistics with skewed sample distributions.
+func (br *BootstrapResult) BCa(conf float64) (low, high *tensor.Float64) {
+	norm := dist.Normal{Mu: 0, Sigma: 1}
+	za := norm.Quantile((1 - conf) / 2)
+	return br.interval(func(cell int) (float64, float64) {
+		est := br.Estimate.Values[cell]
+		ns, less, eq := 0, 0, 0
+		for _, v := range column(br.Samples, cell) {
+			ns++
+			if v < est {
+				less++
+			} else if v == est {
+				eq++
+			}
+		}
+		z0 := norm.Quantile((float64(less) + 0.5*float64(eq)) / float64(ns))
+		jk := column(br.Jackknife, cell)
+		jm := 0.0
+		for _, v := range jk {
+			jm += v
+		}
+		jm /= float64(len(jk))
+		num, den := 0.0, 0.0
+		for _, v := range jk {
+			d := jm - v
+			num += d * d * d
+			den += d * d
+		}
+		a := 0.0
+		if den > 0 {
+			a = num / (6 * math.Pow(den, 1.5))
+		}
+		adj := func(z float64) float64 {
+			return norm.CDF(z0 + (z0+z)/(1-a*(z0+z)))
+		}
+		return adj(za), adj(-za)
+	})
+}
+
+// interval returns the quantiles of the samples for each cell
+// at the probabilities returned by given function.
+func (br *BootstrapResult) interval(probs func(cell int) (float64, float64)) (low, high *tensor.Float64) {
+	low = tensor.NewFloat64(br.Estimate.ShapeSizes()...)
+	high = tensor.NewFloat64(br.Estimate.ShapeSizes()...)
+	for c := range br.Estimate.Len() {
+		lp, hp := probs(c)
+		vals := column(br.Samples, c)
+		slices.Sort(vals)
+		low.Values[c] = quantile(vals, lp)
+		high.Values[c] = quantile(vals, hp)
+	}
+	return
+}
+
+// column returns the non-NaN values of the given cell across rows.
+func column(tsr *tensor.Float64, cell int) []float64 {
+	nr := tsr.DimSize(0)
+	cells := tsr.Len() / nr
+	vals := make([]float64, 0, nr)
+	for r := range nr {
+		if v := tsr.Values[r*cells+cell]; !math.IsNaN(v) {
+			vals = append(vals, v)
+		}
+	}
+	return vals
+}
+
+// quantile returns the given quantile of the given sorted values,
+// using linear interpolation between values.
+func quantile(vals []float64, p float64) float64 {
+	n := len(vals)
+	if n == 0 || math.IsNaN(p) {
+		return math.NaN()
+	}
+	pos := min(max(p, 0), 1) * float64(n-1)
+	i := int(pos)
+	if i >= n-1 {
+		return vals[n-1]
+	}
+	f := pos - float64(i)
+	return vals[i] + f*(vals[i+1]-vals[i])
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/stats/stats"
+	"cogentcore.org/lab/tensor"
+)
+
+// PermutationResult has the results of a permutation test. All of the
+// tensors have the shape of the statistic as the cells, e.g., a single
+// value for 1D inputs.
+type PermutationResult struct {
+
+	// Observed is the test statistic computed on the original data.
+	Observed *tensor.Float64
+
+	// Samples has the test statistic computed on each permutation,
+	// as the outer row dimension, which is its distribution under the
+	// null hypothesis.
+	Samples *tensor.Float64
+
+	// P is the two-sided p-value, which is the proportion of permutations
+	// with a test statistic at least as far from the mean of the samples
+	// as the observed statistic, counting the observed data as one of
+	// the permutations, so that it is never 0.
+	P *tensor.Float64
+}
+
+// PermutationTest tests whether the given statistic differs between
+// the rows of groups a and b, using the difference stat(a) - stat(b) as
+// the test statistic, and computing it on n random permutations of the
+// assignment of the rows to the two groups, which keep the group sizes,
+// using the given random number source (the global source if nil).
+// The groups must have the same cell shape. For example, to test
+// whether the medians differ:
+//
+//	pr, err := resample.PermutationTest(stats.Median, a, b, 10000, rnd)
+func PermutationTest(stat stats.StatsFunc, a, b tensor.Tensor, n int, rnd randx.Rand) (*PermutationResult, error) {
+	av, bv := a.AsValues(), b.AsValues()
+	na, nb := av.DimSize(0), bv.DimSize(0)
+	if na < 1 || nb < 1 || n < 1 {
+		return nil, ErrTooFew
+	}
+	cells := av.Len() / na
+	if !slices.Equal(av.ShapeSizes()[1:], bv.ShapeSizes()[1:]) {
+		return nil, fmt.Errorf("resample.PermutationTest: a and b must have the same cell shape: %v != %v", av.ShapeSizes()[1:], bv.ShapeSizes()[1:])
+	}
+	pooled := tensor.NewFloat64(append([]int{na + nb}, av.ShapeSizes()[1:]...)...)
+	for i := range av.Len() {
+		pooled.Values[i] = av.Float1D(i)
+	}
+	for i := range bv.Len() {
+		pooled.Values[na*cells+i] = bv.Float1D(i)
+	}
+	diff := func(a, b tensor.Tensor) tensor.Values {
+		sa, sb := tensor.AsFloat64(stat(a)), stat(b)
+		for i := range sa.Values {
+			sa.Values[i] -= sb.Float1D(i)
+		}
+		return sa
+	}
+	pr := &PermutationResult{Observed: tensor.AsFloat64(diff(av, bv))}
+	pr.Samples = replicates(n, pooled.Len(), rnd, func(idx int, rnd randx.Rand) tensor.Values {
+		perm := rnd.Perm(na + nb)
+		return diff(tensor.NewRows(pooled, perm[:na]...), tensor.NewRows(pooled, perm[na:]...))
+	})
+	pr.pValues()
+	return pr, nil
+}
+
+// PermutationTestMetric tests whether there is an association between
+// the corresponding rows of a and b, as measured by the given metric, e.g.,
+// [metric.Correlation], by computing the metric on n random permutations
+// of the rows of b relative to those of a, which must have the same number
+// of rows, using the given random number source (the global source if nil).
+func PermutationTestMetric(fun metric.MetricFunc, a, b tensor.Tensor, n int, rnd randx.Rand) (*PermutationResult, error) {
+	av, bv := a.AsValues(), b.AsValues()
+	nr := av.DimSize(0)
+	if bv.DimSize(0) != nr {
+		return nil, fmt.Errorf("resample.PermutationTestMetric: a and b must have the same number of rows: %d != %d", nr, bv.DimSize(0))
+	}
+	if nr < 2 || n < 1 {
+		return nil, ErrTooFew
+	}
+	pr := &PermutationResult{Observed: tensor.AsFloat64(fun(av, bv))}
+	pr.Samples = replicates(n, 2*av.Len(), rnd, func(idx int, rnd randx.Rand) tensor.Values {
+		return fun(av, tensor.NewRows(bv, rnd.Perm(nr)...))
+	})
+	pr.pValues()
+	return pr, nil
+}
+
+// pValues computes the P values from the Observed and Samples values.
+func (pr *PermutationResult) pValues() {
+	pr.P = tensor.NewFloat64(pr.Observed.ShapeSizes()...)
+	for c := range pr.Observed.Len() {
+		vals := column(pr.Samples, c)
+		mean := 0.0
+		for _, v := range vals {
+			mean += v
+		}
+		mean /= float64(len(vals))
+		// tolerance for rounding errors in values equal to the observed one
+		obs := math.Abs(pr.Observed.Values[c]-mean) * (1 - 1e-12)
+		count := 0
+		for _, v := range vals {
+			if math.Abs(v-mean) >= obs {
+				count++
+			}
+		}
+		pr.P.Values[c] = float64(count+1) / float64(len(vals)+1)
+	}
+}
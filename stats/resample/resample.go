@@ -0,0 +1,58 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package resample provides bootstrap confidence intervals and permutation
+// tests for arbitrary statistics, computed by any [stats.StatsFunc] or
+// [metric.MetricFunc], by resampling the rows of [tensor.Tensor] data.
+// The resamples are computed in parallel using [tensor.VectorizeThreaded],
+// each using its own random number source with a seed drawn in order from the
+// given source, so that the results are reproducible when the given source
+// is seeded, e.g., using [randx.Seeds].
+package resample
+
+import (
+	"errors"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/tensor"
+)
+
+// ErrTooFew is returned when there are too few rows or resamples.
+var ErrTooFew = errors.New("resample: too few rows or resamples")
+
+// replicates returns the results of calling the given function n times in
+// parallel, each with a new random number source seeded from the given source
+// (the global source if nil), as the rows of a Float64 tensor with the
+// shape of the results as the cells. The flops estimate is for each call.
+func replicates(n, flops int, rnd randx.Rand, fun func(idx int, rnd randx.Rand) tensor.Values) *tensor.Float64 {
+	if rnd == nil {
+		rnd = randx.NewGlobalRand()
+	}
+	seeds := make(randx.Seeds, n)
+	for i := range seeds {
+		seeds[i] = rnd.Int63()
+	}
+	return parallel(n, flops, func(idx int) tensor.Values {
+		return fun(idx, randx.NewSysRand(seeds[idx]))
+	})
+}
+
+// parallel returns the results of calling the given function n times in
+// parallel, as the rows of a Float64 tensor with the shape of the results
+// as the cells. The flops estimate is for each call.
+func parallel(n, flops int, fun func(idx int) tensor.Values) *tensor.Float64 {
+	res := make([]tensor.Values, n)
+	tensor.VectorizeThreaded(flops, func(tsr ...tensor.Tensor) int { return n },
+		func(idx int, tsr ...tensor.Tensor) {
+			res[idx] = fun(idx)
+		})
+	out := tensor.NewFloat64(append([]int{n}, res[0].ShapeSizes()...)...)
+	cells := res[0].Len()
+	for i, r := range res {
+		for j := range min(cells, r.Len()) {
+			out.Values[i*cells+j] = r.Float1D(j)
+		}
+	}
+	return out
+}
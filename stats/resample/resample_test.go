@@ -0,0 +1,141 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/metric"
+	"cogentcore.org/lab/stats/stats"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func normalData(n int, mean, sd float64, seed int64) *tensor.Float64 {
+	rnd := randx.NewSysRand(seed)
+	x := tensor.NewFloat64(n)
+	for i := range x.Values {
+		x.Values[i] = randx.GaussianGen(mean, sd, rnd)
+	}
+	return x
+}
+
+func TestBootstrap(t *testing.T) {
+	x := normalData(200, 5, 2, 1)
+	br, err := Bootstrap(stats.Mean, x, 2000, randx.NewSysRand(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2000, 1}, br.Samples.ShapeSizes())
+	assert.Equal(t, []int{200, 1}, br.Jackknife.ShapeSizes())
+
+	mean := stats.Mean(x).Float1D(0)
+	sem := stats.Sem(x).Float1D(0)
+	assert.Equal(t, mean, br.Estimate.Values[0])
+	assert.InDelta(t, sem, br.StdErr().Values[0], 0.1*sem)
+	assert.InDelta(t, 0, br.Bias().Values[0], 0.2*sem)
+
+	// for the mean, the intervals are close to the normal interval
+	lo, hi := br.Percentile(0.95)
+	assert.InDelta(t, mean-1.96*sem, lo.Values[0], 0.15*sem)
+	assert.InDelta(t, mean+1.96*sem, hi.Values[0], 0.15*sem)
+	blo, bhi := br.BCa(0.95)
+	assert.InDelta(t, lo.Values[0], blo.Values[0], 0.15*sem)
+	assert.InDelta(t, hi.Values[0], bhi.Values[0], 0.15*sem)
+
+	// reproducible with the same seed
+	br2, err := Bootstrap(stats.Mean, x, 2000, randx.NewSysRand(2))
+	assert.NoError(t, err)
+	assert.Equal(t, br.Samples.Values, br2.Samples.Values)
+
+	// per-cell statistics
+	x2 := tensor.NewFloat64(100, 2)
+	for i := range 100 {
+		x2.Set(float64(i), i, 0)
+		x2.Set(float64(2*i), i, 1)
+	}
+	br, err = Bootstrap(stats.Mean, x2, 500, randx.NewSysRand(3))
+	assert.NoError(t, err)
+	lo, hi = br.BCa(0.9)
+	assert.Equal(t, []int{2}, lo.ShapeSizes())
+	for c := range 2 {
+		assert.Less(t, lo.Values[c], br.Estimate.Values[c])
+		assert.Greater(t, hi.Values[c], br.Estimate.Values[c])
+	}
+	assert.InDelta(t, 2*(hi.Values[0]-lo.Values[0]), hi.Values[1]-lo.Values[1], 1e-9)
+
+	// the median has a discrete sample distribution
+	x1 := tensor.NewFloat64(100)
+	for i := range 100 {
+		x1.Values[i] = float64(i)
+	}
+	br, err = Bootstrap(stats.Median, x1, 500, randx.NewSysRand(3))
+	assert.NoError(t, err)
+	lo, hi = br.BCa(0.95)
+	assert.Less(t, lo.Values[0], 49.5)
+	assert.Greater(t, hi.Values[0], 49.5)
+
+	_, err = Bootstrap(stats.Mean, tensor.NewFloat64(1), 100, nil)
+	assert.ErrorIs(t, err, ErrTooFew)
+}
+
+func TestBootstrapMetric(t *testing.T) {
+	a := normalData(100, 0, 1, 1)
+	noise := normalData(100, 0, 1, 2)
+	b := tensor.NewFloat64(100)
+	for i := range b.Values {
+		b.Values[i] = a.Values[i] + noise.Values[i]
+	}
+	br, err := BootstrapMetric(metric.Correlation, a, b, 1000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	lo, hi := br.BCa(0.95)
+	r := br.Estimate.Values[0]
+	assert.InDelta(t, 1/math.Sqrt2, r, 0.1)
+	assert.Less(t, lo.Values[0], r)
+	assert.Greater(t, hi.Values[0], r)
+	assert.Less(t, hi.Values[0], 1.0)
+	assert.Greater(t, lo.Values[0], 0.4)
+
+	_, err = BootstrapMetric(metric.Correlation, a, tensor.NewFloat64(10), 100, nil)
+	assert.Error(t, err)
+}
+
+func TestPermutationTest(t *testing.T) {
+	a := normalData(30, 0, 1, 1)
+	b := normalData(30, 1, 1, 2)
+	pr, err := PermutationTest(stats.Mean, a, b, 2000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assert.InDelta(t, stats.Mean(a).Float1D(0)-stats.Mean(b).Float1D(0), pr.Observed.Values[0], 1e-12)
+	assert.Less(t, pr.P.Values[0], 0.01)
+	assert.Greater(t, pr.P.Values[0], 0.0)
+
+	c := normalData(40, 0, 1, 3)
+	pr, err = PermutationTest(stats.Median, a, c, 2000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assert.Greater(t, pr.P.Values[0], 0.05)
+
+	pr2, err := PermutationTest(stats.Median, a, c, 2000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assert.Equal(t, pr.P.Values, pr2.P.Values)
+
+	_, err = PermutationTest(stats.Mean, a, tensor.NewFloat64(3, 2), 100, nil)
+	assert.Error(t, err)
+}
+
+func TestPermutationTestMetric(t *testing.T) {
+	a := normalData(50, 0, 1, 1)
+	noise := normalData(50, 0, 1, 2)
+	b := tensor.NewFloat64(50)
+	for i := range b.Values {
+		b.Values[i] = a.Values[i] + noise.Values[i]
+	}
+	pr, err := PermutationTestMetric(metric.Correlation, a, b, 1000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0/1001, pr.P.Values[0], 1e-12)
+
+	pr, err = PermutationTestMetric(metric.Correlation, a, normalData(50, 0, 1, 7), 1000, randx.NewSysRand(1))
+	assert.NoError(t, err)
+	assert.Greater(t, pr.P.Values[0], 0.05)
+}
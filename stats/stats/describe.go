@@ -22,7 +22,10 @@ var DescriptiveStats = []Stats{StatCount, StatMean, StatStd, StatSem, StatMin, S
 // and result tensor stats for each result.
 // This is an easy way to provide a comprehensive description of data.
 // The [DescriptiveStats] list is: [Count], [Mean], [Std], [Sem],
-// [Min], [Q1], [Median], [Q3], [Max]
+// [Min], [Q1], [Median], [Q3], [Max]. In addition, a Missing result
+// is added after Count, with the number of missing (NaN) values.
+// Each result is a scalar, so for higher-dimensional data only the
+// first cell is described, including for Missing.
 func Describe(dir *tensorfs.Node, tsrs ...tensor.Tensor) {
 	dd := dir.Dir("Describe")
 	for i, tsr := range tsrs {
@@ -40,6 +43,10 @@ func Describe(dir *tensorfs.Node, tsrs ...tensor.Tensor) {
 			sv := tensorfs.Scalar[float64](td, stnm)
 			stout := st.Call(tsr)
 			sv.CopyFrom(stout)
+			if st == StatCount { // first cell only, as for the other stats
+				mv := tensorfs.Scalar[float64](td, "Missing")
+				mv.SetFloat1D(float64(nr)-stout.Float1D(0), 0)
+			}
 		}
 	}
 }
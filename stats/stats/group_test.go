@@ -43,6 +43,25 @@ func TestGroup(t *testing.T) {
 	assert.Equal(t, "B", gdt.Column("Name").String1D(1))
 }
 
+func TestGroupsSingleLast(t *testing.T) {
+	// the last group has a single row, for both string and numeric values
+	names := tensor.NewStringFromValues("B", "A", "A", "C")
+	vals := tensor.NewFloat64FromValues(2, 1, 1, 3)
+	dir, _ := tensorfs.NewDir("Groups")
+	err := Groups(dir, names, vals)
+	assert.NoError(t, err)
+
+	ixs := dir.Dir("Groups/0").ValuesFunc(nil)
+	assert.Equal(t, 3, len(ixs))
+	assert.Equal(t, []int{1, 2}, tensor.AsInt(ixs[0]).Values)
+	assert.Equal(t, []int{0}, tensor.AsInt(ixs[1]).Values)
+	assert.Equal(t, []int{3}, tensor.AsInt(ixs[2]).Values)
+
+	ixs = dir.Dir("Groups/1").ValuesFunc(nil)
+	assert.Equal(t, 3, len(ixs))
+	assert.Equal(t, []int{3}, tensor.AsInt(ixs[2]).Values)
+}
+
 /*
 func TestAggEmpty(t *testing.T) {
 	dt := table.New().SetNumRows(4)
@@ -38,7 +38,7 @@ func QuantilesOut(in, qs tensor.Tensor, out tensor.Values) error {
 	if qs.NumDims() != 1 {
 		return errors.Log(errors.New("stats.QuantilesFunc: only 1D quantile tensors allowed"))
 	}
-	tensor.SetShapeFrom(out, in)
+	tensor.SetShapeFrom(out, qs)
 	sin := tensor.AsRows(in.AsValues())
 	sin.ExcludeMissing()
 	sin.Sort(tensor.Ascending)
@@ -187,6 +187,22 @@ func TestFuncsCell(t *testing.T) {
 	}
 }
 
+func TestQuantiles(t *testing.T) {
+	vals := []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1}
+	ix := tensor.NewNumberFromValues(vals...)
+	qs := tensor.NewFloat64FromValues(0, 0.25, 0.5, 1)
+
+	// output has the shape of the quantiles, not the input
+	out := tensor.NewFloat64()
+	assert.NoError(t, QuantilesOut(ix, qs, out))
+	assert.Equal(t, []int{4}, out.ShapeSizes())
+	assert.InDeltaSlice(t, []float64{0, 0.25, 0.5, 1}, out.Values, 1.0e-8)
+
+	q := Quantiles(ix, tensor.NewFloat64Scalar(0.5))
+	assert.Equal(t, 1, q.Len())
+	assert.InDelta(t, 0.5, q.Float1D(0), 1.0e-8)
+}
+
 func TestNorm(t *testing.T) {
 	vals := []float64{-1.507556722888818, -1.2060453783110545, -0.9045340337332908, -0.6030226891555273, -0.3015113445777635, 0.1, 0.3015113445777635, 0.603022689155527, 0.904534033733291, 1.2060453783110545, 1.507556722888818, .3}
 
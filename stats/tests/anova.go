@@ -0,0 +1,94 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"cogentcore.org/lab/tensor"
+)
+
+// ANOVA performs a one-way analysis of variance of whether the means of
+// the given groups of values differ, skipping NaN values, e.g., using the
+// groups returned by [TableGroups]. The effect size is eta squared:
+// the between-groups sum of squares divided by the total sum of squares.
+func ANOVA(groups ...tensor.Tensor) (*Result, error) {
+	if len(groups) < 2 {
+		return nil, ErrTooFew
+	}
+	gs := make([][]float64, len(groups))
+	n, sum := 0, 0.0
+	for i, g := range groups {
+		gs[i] = values(g)
+		if len(gs[i]) == 0 {
+			return nil, ErrTooFew
+		}
+		n += len(gs[i])
+		for _, v := range gs[i] {
+			sum += v
+		}
+	}
+	k := len(gs)
+	if n <= k {
+		return nil, ErrTooFew
+	}
+	gm := sum / float64(n)
+	ssb, ssw := 0.0, 0.0
+	for _, x := range gs {
+		m, vr := meanVar(x)
+		ssb += float64(len(x)) * (m - gm) * (m - gm)
+		ssw += vr * float64(len(x)-1)
+	}
+	df1, df2 := float64(k-1), float64(n-k)
+	f := (ssb / df1) / (ssw / df2)
+	return &Result{Test: "F", Stat: f, DF: df1, DF2: df2, P: pF(f, df1, df2), Effect: ssb / (ssb + ssw), EffectName: "eta2", N: n}, nil
+}
+
+// ANOVARM performs a one-way repeated-measures analysis of variance of
+// whether the means of the given conditions differ, where each condition
+// has one value per subject, in the same order of subjects for each
+// condition. Subjects with a NaN value in any condition are skipped.
+// No correction for violations of sphericity is applied.
+// The effect size is partial eta squared: the conditions sum of squares
+// divided by the sum of it and the error sum of squares, after removing
+// the variance between subjects.
+func ANOVARM(conditions ...tensor.Tensor) (*Result, error) {
+	if len(conditions) < 2 {
+		return nil, ErrTooFew
+	}
+	rs, err := rows(conditions...)
+	if err != nil {
+		return nil, err
+	}
+	n, k := len(rs), len(conditions)
+	if n < 2 {
+		return nil, ErrTooFew
+	}
+	gm := 0.0
+	cm := make([]float64, k)
+	for _, r := range rs {
+		for j, v := range r {
+			cm[j] += v
+			gm += v
+		}
+	}
+	gm /= float64(n * k)
+	sst, sss, ssc := 0.0, 0.0, 0.0
+	for _, r := range rs {
+		sm := 0.0
+		for _, v := range r {
+			sm += v
+			sst += (v - gm) * (v - gm)
+		}
+		sm /= float64(k)
+		sss += float64(k) * (sm - gm) * (sm - gm)
+	}
+	for j := range cm {
+		cm[j] /= float64(n)
+		ssc += float64(n) * (cm[j] - gm) * (cm[j] - gm)
+	}
+	sse := sst - ssc - sss
+	df1, df2 := float64(k-1), float64((k-1)*(n-1))
+	f := (ssc / df1) / (sse / df2)
+	return &Result{Test: "RM F", Stat: f, DF: df1, DF2: df2, P: pF(f, df1, df2), Effect: ssc / (ssc + sse), EffectName: "partial eta2", N: n}, nil
+}
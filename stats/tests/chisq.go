@@ -0,0 +1,81 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"fmt"
+	"math"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// ChiSquare performs Pearson's chi-square test of independence on the
+// given 2D contingency table of observed counts, with rows and columns
+// as the categories of the two variables. No continuity correction is
+// applied. The effect size is Cramér's V.
+func ChiSquare(observed tensor.Tensor) (*Result, error) {
+	if observed.NumDims() != 2 {
+		return nil, fmt.Errorf("tests.ChiSquare: observed counts must be 2D, not %dD", observed.NumDims())
+	}
+	nr, nc := observed.DimSize(0), observed.DimSize(1)
+	if nr < 2 || nc < 2 {
+		return nil, ErrTooFew
+	}
+	rt := make([]float64, nr)
+	ct := make([]float64, nc)
+	n := 0.0
+	for i := range nr {
+		for j := range nc {
+			v := observed.Float(i, j)
+			rt[i] += v
+			ct[j] += v
+			n += v
+		}
+	}
+	x := 0.0
+	for i := range nr {
+		for j := range nc {
+			e := rt[i] * ct[j] / n
+			d := observed.Float(i, j) - e
+			x += d * d / e
+		}
+	}
+	df := float64((nr - 1) * (nc - 1))
+	v := math.Sqrt(x / (n * float64(min(nr, nc)-1)))
+	return &Result{Test: "Chi-square", Stat: x, DF: df, P: pChiSq(x, df), Effect: v, EffectName: "V", N: int(n)}, nil
+}
+
+// ChiSquareGOF performs Pearson's chi-square goodness of fit test of
+// whether the given observed counts in each category match the given
+// expected counts or proportions, which are scaled to the same total as
+// the observed counts. If expected is nil, the counts are expected to be
+// equal across categories. The effect size is Cohen's w.
+func ChiSquareGOF(observed, expected tensor.Tensor) (*Result, error) {
+	k := observed.Len()
+	if k < 2 {
+		return nil, ErrTooFew
+	}
+	if expected != nil && expected.Len() != k {
+		return nil, ErrMismatch
+	}
+	n, et := 0.0, 0.0
+	for i := range k {
+		n += observed.Float1D(i)
+		if expected != nil {
+			et += expected.Float1D(i)
+		}
+	}
+	x := 0.0
+	for i := range k {
+		e := n / float64(k)
+		if expected != nil {
+			e = n * expected.Float1D(i) / et
+		}
+		d := observed.Float1D(i) - e
+		x += d * d / e
+	}
+	df := float64(k - 1)
+	return &Result{Test: "Chi-square", Stat: x, DF: df, P: pChiSq(x, df), Effect: math.Sqrt(x / n), EffectName: "w", N: int(n)}, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"fmt"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/stats/stats"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+)
+
+// Groups returns the values of the given tensor for each of the groups
+// made by [stats.Groups] for the given grouping name in given directory,
+// as [tensor.Rows] views using the group indexes, which can be passed to
+// the tests, along with the group names (the unique values of the
+// grouping tensor) in sorted order. Within each group, the values are
+// in the original order of the rows, so that they are aligned across
+// groups for paired and repeated measures tests if the data has the
+// same order of subjects for each group.
+func Groups(dir *tensorfs.Node, group string, tsr tensor.Tensor) ([]string, []tensor.Tensor, error) {
+	gd := dir.Node("Groups")
+	if gd != nil {
+		gd = gd.Node(group)
+	}
+	if gd == nil {
+		return nil, nil, fmt.Errorf("tests.Groups: group %q not found", group)
+	}
+	vals := gd.ValuesFunc(nil)
+	names := make([]string, len(vals))
+	groups := make([]tensor.Tensor, len(vals))
+	for i, v := range vals {
+		names[i] = metadata.Name(v)
+		groups[i] = tensor.NewRows(tsr.AsValues(), tensor.AsIntSlice(v)...)
+	}
+	return names, groups, nil
+}
+
+// TableGroups returns the values of the given value column of the given
+// [table.Table] for each unique value of the given group column, using
+// [stats.TableGroups] and [Groups], along with the group names. For example,
+// to test for differences in the Err column across values of the Cond column:
+//
+//	_, groups, err := tests.TableGroups(dt, "Cond", "Err")
+//	res, err := tests.ANOVA(groups...)
+func TableGroups(dt *table.Table, group, value string) ([]string, []tensor.Tensor, error) {
+	vc := dt.Columns.At(value)
+	if vc == nil {
+		return nil, nil, fmt.Errorf("tests.TableGroups: column %q not found", value)
+	}
+	if dt.Columns.At(group) == nil {
+		return nil, nil, fmt.Errorf("tests.TableGroups: column %q not found", group)
+	}
+	dir, _ := tensorfs.NewDir("Groups")
+	if err := stats.TableGroups(dir, dt, group); err != nil {
+		return nil, nil, err
+	}
+	return Groups(dir, group, vc)
+}
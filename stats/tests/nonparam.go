@@ -0,0 +1,116 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// MannWhitney performs the Mann-Whitney U test (Wilcoxon rank-sum test)
+// of whether the values in tensor a tend to be larger or smaller than
+// those in tensor b, skipping NaN values. The statistic is U for a,
+// and the p-value uses the normal approximation with corrections for
+// ties and continuity. The effect size is the rank-biserial correlation,
+// which is positive when the values of a tend to be larger.
+func MannWhitney(a, b tensor.Tensor) (*Result, error) {
+	x, y := values(a), values(b)
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 < 1 || n2 < 1 {
+		return nil, ErrTooFew
+	}
+	r, ties := ranks(append(x, y...))
+	r1 := 0.0
+	for _, v := range r[:len(x)] {
+		r1 += v
+	}
+	n := n1 + n2
+	u := r1 - n1*(n1+1)/2
+	mu := n1 * n2 / 2
+	sigma := math.Sqrt(n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1))))
+	z := (math.Abs(u-mu) - 0.5) / sigma
+	return &Result{Test: "Mann-Whitney U", Stat: u, P: pZ(max(z, 0)), Effect: 2*u/(n1*n2) - 1, EffectName: "r", N: len(x) + len(y)}, nil
+}
+
+// Wilcoxon performs the Wilcoxon signed-rank test of whether the
+// differences between corresponding values of the given tensors, a - b,
+// are symmetric around zero. Pairs with a NaN value and zero differences
+// are skipped. The statistic is W, the sum of the ranks of the positive
+// differences, and the p-value uses the normal approximation with
+// corrections for ties and continuity. The effect size is the matched-pairs
+// rank-biserial correlation, which is positive when a tends to be larger.
+func Wilcoxon(a, b tensor.Tensor) (*Result, error) {
+	rs, err := rows(a, b)
+	if err != nil {
+		return nil, err
+	}
+	var d []float64
+	for _, r := range rs {
+		if df := r[0] - r[1]; df != 0 {
+			d = append(d, df)
+		}
+	}
+	if len(d) < 1 {
+		return nil, ErrTooFew
+	}
+	ad := make([]float64, len(d))
+	for i, v := range d {
+		ad[i] = math.Abs(v)
+	}
+	r, ties := ranks(ad)
+	w := 0.0
+	for i, v := range d {
+		if v > 0 {
+			w += r[i]
+		}
+	}
+	n := float64(len(d))
+	tot := n * (n + 1) / 2
+	mu := tot / 2
+	sigma := math.Sqrt(n*(n+1)*(2*n+1)/24 - ties/48)
+	z := (math.Abs(w-mu) - 0.5) / sigma
+	return &Result{Test: "Wilcoxon W", Stat: w, P: pZ(max(z, 0)), Effect: (2*w - tot) / tot, EffectName: "r", N: len(d)}, nil
+}
+
+// KruskalWallis performs the Kruskal-Wallis H test of whether the given
+// groups of values come from the same distribution, which is the
+// nonparametric equivalent of a one-way [ANOVA], skipping NaN values.
+// The H statistic is corrected for ties, and the p-value uses the
+// chi-square approximation. The effect size is eta squared based on H:
+// (H - k + 1) / (n - k) for k groups and n values.
+func KruskalWallis(groups ...tensor.Tensor) (*Result, error) {
+	if len(groups) < 2 {
+		return nil, ErrTooFew
+	}
+	var all []float64
+	ns := make([]int, len(groups))
+	for i, g := range groups {
+		x := values(g)
+		if len(x) == 0 {
+			return nil, ErrTooFew
+		}
+		ns[i] = len(x)
+		all = append(all, x...)
+	}
+	r, ties := ranks(all)
+	n, k := float64(len(all)), float64(len(groups))
+	if n <= k {
+		return nil, ErrTooFew
+	}
+	h, st := 0.0, 0
+	for _, gn := range ns {
+		rs := 0.0
+		for _, v := range r[st : st+gn] {
+			rs += v
+		}
+		h += rs * rs / float64(gn)
+		st += gn
+	}
+	h = 12/(n*(n+1))*h - 3*(n+1)
+	h /= 1 - ties/(n*n*n-n)
+	df := k - 1
+	return &Result{Test: "Kruskal-Wallis H", Stat: h, DF: df, P: pChiSq(h, df), Effect: (h - k + 1) / (n - k), EffectName: "eta2", N: len(all)}, nil
+}
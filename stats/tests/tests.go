@@ -0,0 +1,182 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tests provides statistical hypothesis tests, including t-tests,
+// ANOVA, chi-square and nonparametric rank tests, operating on
+// [tensor.Tensor] data, such as the columns of a [table.Table] grouped
+// with [stats.Groups].
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"cogentcore.org/lab/stats/dist"
+	"cogentcore.org/lab/tensor"
+)
+
+// Result is the result of a statistical hypothesis test.
+type Result struct {
+	// Test is the name of the test.
+	Test string
+
+	// Stat is the value of the test statistic, e.g., t, F, chi-square,
+	// U, W or H depending on the test.
+	Stat float64
+
+	// DF is the degrees of freedom of the test statistic, or the numerator
+	// degrees of freedom for an F statistic. It is 0 for tests that use
+	// a normal approximation.
+	DF float64
+
+	// DF2 is the denominator degrees of freedom for an F statistic.
+	DF2 float64
+
+	// P is the p-value, which is two-sided for the t-tests and the
+	// Mann-Whitney and Wilcoxon tests, and the upper tail probability
+	// of the F, chi-square and H statistics.
+	P float64
+
+	// Effect is the effect size, as given by EffectName.
+	Effect float64
+
+	// EffectName is the name of the effect size measure, e.g., "d" for
+	// Cohen's d, "eta2" for eta squared, or "r" for the rank-biserial correlation.
+	EffectName string
+
+	// N is the number of values used in the test, excluding missing (NaN)
+	// values, which for paired and repeated measures tests is the number of
+	// pairs or subjects.
+	N int
+}
+
+// String returns the result in a standard reporting format,
+// e.g., "Welch t(17.78) = -1.861, p = 0.07939, d = -0.8322, N = 20".
+func (r *Result) String() string {
+	df := ""
+	switch {
+	case r.DF2 != 0:
+		df = fmt.Sprintf("(%.4g, %.4g)", r.DF, r.DF2)
+	case r.DF != 0:
+		df = fmt.Sprintf("(%.4g)", r.DF)
+	}
+	return fmt.Sprintf("%s%s = %.4g, p = %.4g, %s = %.4g, N = %d", r.Test, df, r.Stat, r.P, r.EffectName, r.Effect, r.N)
+}
+
+var (
+	// ErrTooFew is returned when there are too few values for a test.
+	ErrTooFew = errors.New("tests: too few values")
+
+	// ErrMismatch is returned when paired values or repeated measures
+	// have different numbers of values.
+	ErrMismatch = errors.New("tests: mismatched number of values")
+)
+
+// values returns the non-NaN values of given tensor as a flat list.
+func values(tsr tensor.Tensor) []float64 {
+	n := tsr.Len()
+	x := make([]float64, 0, n)
+	for i := range n {
+		v := tsr.Float1D(i)
+		if !math.IsNaN(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// rows returns the values of the given tensors as rows of values
+// for each of the corresponding elements, skipping those where any
+// of the values are NaN. The tensors must have the same length.
+func rows(tsrs ...tensor.Tensor) ([][]float64, error) {
+	n := tsrs[0].Len()
+	for _, tsr := range tsrs[1:] {
+		if tsr.Len() != n {
+			return nil, ErrMismatch
+		}
+	}
+	var rs [][]float64
+	for i := range n {
+		r := make([]float64, len(tsrs))
+		ok := true
+		for j, tsr := range tsrs {
+			r[j] = tsr.Float1D(i)
+			if math.IsNaN(r[j]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			rs = append(rs, r)
+		}
+	}
+	return rs, nil
+}
+
+// meanVar returns the mean and the sample variance of given values.
+func meanVar(x []float64) (mean, vr float64) {
+	for _, v := range x {
+		mean += v
+	}
+	mean /= float64(len(x))
+	for _, v := range x {
+		d := v - mean
+		vr += d * d
+	}
+	if len(x) > 1 {
+		vr /= float64(len(x) - 1)
+	}
+	return
+}
+
+// ranks returns the 1-based ranks of given values, with tied values
+// assigned their average rank, along with the sum of t^3 - t over the
+// groups of t tied values, which is used to correct for ties.
+func ranks(x []float64) ([]float64, float64) {
+	n := len(x)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+	r := make([]float64, n)
+	ties := 0.0
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && x[idx[j+1]] == x[idx[i]] {
+			j++
+		}
+		avg := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			r[idx[k]] = avg
+		}
+		t := float64(j - i + 1)
+		ties += t*t*t - t
+		i = j + 1
+	}
+	return r, ties
+}
+
+// pT returns the two-sided p-value for given t statistic and degrees of freedom.
+func pT(t, df float64) float64 {
+	return 2 * dist.StudentT{Nu: df}.Survival(math.Abs(t))
+}
+
+// pF returns the upper tail p-value for given F statistic and degrees of freedom.
+func pF(f, df1, df2 float64) float64 {
+	return dist.F{D1: df1, D2: df2}.Survival(f)
+}
+
+// pChiSq returns the upper tail p-value for given chi-square statistic
+// and degrees of freedom.
+func pChiSq(x, df float64) float64 {
+	return dist.ChiSquared{K: df}.Survival(x)
+}
+
+// pZ returns the two-sided p-value for given standard normal z statistic.
+func pZ(z float64) float64 {
+	return min(1, 2*dist.Normal{Mu: 0, Sigma: 1}.Survival(math.Abs(z)))
+}
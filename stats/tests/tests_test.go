@@ -0,0 +1,178 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+// reference values are from R, using the sleep, PlantGrowth
+// and other standard example data sets.
+
+var (
+	sleep1 = tensor.NewFloat64FromValues(0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0)
+	sleep2 = tensor.NewFloat64FromValues(1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4)
+
+	plantCtrl = tensor.NewFloat64FromValues(4.17, 5.58, 5.18, 6.11, 4.50, 4.61, 5.17, 4.53, 5.33, 5.14)
+	plantTrt1 = tensor.NewFloat64FromValues(4.81, 4.17, 4.41, 3.59, 5.87, 3.83, 6.03, 4.89, 4.32, 4.69)
+	plantTrt2 = tensor.NewFloat64FromValues(6.31, 5.12, 5.54, 5.50, 5.37, 5.29, 4.92, 6.15, 5.80, 5.26)
+)
+
+func TestTTest(t *testing.T) {
+	r, err := TTest2(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.InDelta(t, -1.8608, r.Stat, 1e-4)
+	assert.Equal(t, 18.0, r.DF)
+	assert.InDelta(t, 0.07919, r.P, 1e-5)
+	assert.InDelta(t, -0.8321, r.Effect, 1e-4)
+	assert.Equal(t, 20, r.N)
+
+	r, err = Welch(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.InDelta(t, -1.8608, r.Stat, 1e-4)
+	assert.InDelta(t, 17.776, r.DF, 1e-3)
+	assert.InDelta(t, 0.07939, r.P, 1e-5)
+	assert.Equal(t, "Welch t(17.78) = -1.861, p = 0.07939, d = -0.8322, N = 20", r.String())
+
+	r, err = TTestPaired(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.InDelta(t, -4.0621, r.Stat, 1e-4)
+	assert.Equal(t, 9.0, r.DF)
+	assert.InDelta(t, 0.002833, r.P, 1e-6)
+	assert.InDelta(t, -1.2845, r.Effect, 1e-4)
+
+	// the paired test is a one-sample test on the differences
+	d := tensor.NewFloat64(10)
+	for i := range 10 {
+		d.SetFloat1D(sleep1.Float1D(i)-sleep2.Float1D(i), i)
+	}
+	r1, err := TTest(d, 0)
+	assert.NoError(t, err)
+	assert.InDelta(t, r.Stat, r1.Stat, 1e-12)
+
+	// NaN values are skipped
+	na := tensor.NewFloat64FromValues(append(sleep1.Values, math.NaN())...)
+	r, err = Welch(na, sleep2)
+	assert.NoError(t, err)
+	assert.InDelta(t, -1.8608, r.Stat, 1e-4)
+
+	_, err = TTest(tensor.NewFloat64FromValues(1), 0)
+	assert.ErrorIs(t, err, ErrTooFew)
+	_, err = TTestPaired(sleep1, tensor.NewFloat64(5))
+	assert.ErrorIs(t, err, ErrMismatch)
+}
+
+func TestANOVA(t *testing.T) {
+	r, err := ANOVA(plantCtrl, plantTrt1, plantTrt2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 4.846, r.Stat, 1e-3)
+	assert.Equal(t, 2.0, r.DF)
+	assert.Equal(t, 27.0, r.DF2)
+	assert.InDelta(t, 0.01591, r.P, 1e-5)
+	assert.InDelta(t, 0.2641, r.Effect, 1e-4)
+
+	// with two groups, F is the square of Student's t
+	r, err = ANOVA(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.8608*1.8608, r.Stat, 1e-3)
+	assert.InDelta(t, 0.07919, r.P, 1e-5)
+
+	c1 := tensor.NewFloat64FromValues(1, 2, 3, 2, 4)
+	c2 := tensor.NewFloat64FromValues(2, 4, 3, 3, 5)
+	c3 := tensor.NewFloat64FromValues(3, 5, 6, 6, 7)
+	r, err = ANOVARM(c1, c2, c3)
+	assert.NoError(t, err)
+	assert.InDelta(t, 35, r.Stat, 1e-9)
+	assert.Equal(t, 2.0, r.DF)
+	assert.Equal(t, 8.0, r.DF2)
+	assert.InDelta(t, 0.00011066, r.P, 1e-8)
+	assert.InDelta(t, 0.8974, r.Effect, 1e-4)
+	assert.Equal(t, 5, r.N)
+}
+
+func TestChiSquare(t *testing.T) {
+	obs := tensor.NewFloat64FromValues(762, 327, 468, 484, 239, 477)
+	obs.SetShapeSizes(2, 3)
+	r, err := ChiSquare(obs)
+	assert.NoError(t, err)
+	assert.InDelta(t, 30.07, r.Stat, 1e-2)
+	assert.Equal(t, 2.0, r.DF)
+	assert.InDelta(t, 2.954e-07, r.P, 1e-10)
+
+	_, err = ChiSquare(tensor.NewFloat64FromValues(1, 2, 3))
+	assert.Error(t, err)
+
+	r, err = ChiSquareGOF(tensor.NewFloat64FromValues(89, 37, 30, 28, 2), tensor.NewFloat64FromValues(40, 20, 20, 19, 1))
+	assert.NoError(t, err)
+	assert.InDelta(t, 5.7947, r.Stat, 1e-4)
+	assert.Equal(t, 4.0, r.DF)
+	assert.InDelta(t, 0.215, r.P, 1e-3)
+
+	r, err = ChiSquareGOF(tensor.NewFloat64FromValues(10, 10, 10), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, r.Stat)
+	assert.Equal(t, 1.0, r.P)
+}
+
+func TestNonparametric(t *testing.T) {
+	r, err := MannWhitney(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.Equal(t, 25.5, r.Stat)
+	assert.InDelta(t, 0.06933, r.P, 1e-5)
+	assert.InDelta(t, -0.49, r.Effect, 1e-9)
+
+	r, err = Wilcoxon(sleep1, sleep2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, r.Stat)
+	assert.Equal(t, 9, r.N)
+	assert.InDelta(t, 0.009091, r.P, 1e-6)
+	assert.Equal(t, -1.0, r.Effect)
+
+	x := tensor.NewFloat64FromValues(2.9, 3.0, 2.5, 2.6, 3.2)
+	y := tensor.NewFloat64FromValues(3.8, 2.7, 4.0, 2.4)
+	z := tensor.NewFloat64FromValues(2.8, 3.4, 3.7, 2.2, 2.0)
+	r, err = KruskalWallis(x, y, z)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.77143, r.Stat, 1e-5)
+	assert.Equal(t, 2.0, r.DF)
+	assert.InDelta(t, 0.68, r.P, 1e-2)
+}
+
+func TestTableGroups(t *testing.T) {
+	dt := table.New()
+	dt.AddStringColumn("Cond")
+	dt.AddFloat64Column("Weight")
+	dt.SetNumRows(30)
+	for i, g := range []*tensor.Float64{plantTrt2, plantCtrl, plantTrt1} {
+		for j, v := range g.Values {
+			row := j*3 + i // interleave groups
+			dt.Column("Cond").SetStringRow([]string{"trt2", "ctrl", "trt1"}[i], row, 0)
+			dt.Column("Weight").SetFloatRow(v, row, 0)
+		}
+	}
+	names, groups, err := TableGroups(dt, "Cond", "Weight")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ctrl", "trt1", "trt2"}, names)
+	assert.Equal(t, plantCtrl.Values, tensor.AsFloat64(groups[0]).Values)
+	r, err := ANOVA(groups...)
+	assert.NoError(t, err)
+	assert.InDelta(t, 4.846, r.Stat, 1e-3)
+
+	// a single row in the last group
+	dt.SetNumRows(31)
+	dt.Column("Cond").SetStringRow("trt3", 30, 0)
+	names, groups, err = TableGroups(dt, "Cond", "Weight")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ctrl", "trt1", "trt2", "trt3"}, names)
+	assert.Equal(t, 1, groups[3].Len())
+
+	_, _, err = TableGroups(dt, "Cond", "Height")
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// TTest performs a one-sample t-test of whether the mean of the values
+// in given tensor differs from the given mean, skipping NaN values.
+// The effect size is Cohen's d: the difference in means divided by
+// the standard deviation.
+func TTest(a tensor.Tensor, mean float64) (*Result, error) {
+	return tTest1("One-sample t", values(a), mean)
+}
+
+// TTestPaired performs a paired t-test of whether the mean difference
+// between corresponding values of the given tensors is zero, which is a
+// one-sample [TTest] on the differences a - b. Pairs with a NaN value
+// are skipped. The effect size is Cohen's d_z: the mean difference
+// divided by the standard deviation of the differences.
+func TTestPaired(a, b tensor.Tensor) (*Result, error) {
+	rs, err := rows(a, b)
+	if err != nil {
+		return nil, err
+	}
+	d := make([]float64, len(rs))
+	for i, r := range rs {
+		d[i] = r[0] - r[1]
+	}
+	return tTest1("Paired t", d, 0)
+}
+
+func tTest1(name string, x []float64, mean float64) (*Result, error) {
+	n := len(x)
+	if n < 2 {
+		return nil, ErrTooFew
+	}
+	m, vr := meanVar(x)
+	sd := math.Sqrt(vr)
+	t := (m - mean) / (sd / math.Sqrt(float64(n)))
+	df := float64(n - 1)
+	return &Result{Test: name, Stat: t, DF: df, P: pT(t, df), Effect: (m - mean) / sd, EffectName: "d", N: n}, nil
+}
+
+// TTest2 performs Student's two-sample t-test of whether the means of
+// the values in the given tensors differ, assuming equal variances,
+// skipping NaN values. Use [Welch] when the variances may differ.
+// The effect size is Cohen's d: the difference in means divided by
+// the pooled standard deviation.
+func TTest2(a, b tensor.Tensor) (*Result, error) {
+	x, y := values(a), values(b)
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 < 2 || n2 < 2 {
+		return nil, ErrTooFew
+	}
+	m1, v1 := meanVar(x)
+	m2, v2 := meanVar(y)
+	df := n1 + n2 - 2
+	sp := math.Sqrt(((n1-1)*v1 + (n2-1)*v2) / df)
+	t := (m1 - m2) / (sp * math.Sqrt(1/n1+1/n2))
+	return &Result{Test: "t", Stat: t, DF: df, P: pT(t, df), Effect: (m1 - m2) / sp, EffectName: "d", N: len(x) + len(y)}, nil
+}
+
+// Welch performs Welch's two-sample t-test of whether the means of
+// the values in the given tensors differ, without assuming equal
+// variances, skipping NaN values. The degrees of freedom are given by the
+// Welch-Satterthwaite equation. The effect size is Cohen's d, using the
+// square root of the average of the two variances.
+func Welch(a, b tensor.Tensor) (*Result, error) {
+	x, y := values(a), values(b)
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 < 2 || n2 < 2 {
+		return nil, ErrTooFew
+	}
+	m1, v1 := meanVar(x)
+	m2, v2 := meanVar(y)
+	s1, s2 := v1/n1, v2/n2
+	t := (m1 - m2) / math.Sqrt(s1+s2)
+	df := (s1 + s2) * (s1 + s2) / (s1*s1/(n1-1) + s2*s2/(n2-1))
+	return &Result{Test: "Welch t", Stat: t, DF: df, P: pT(t, df), Effect: (m1 - m2) / math.Sqrt((v1+v2)/2), EffectName: "d", N: len(x) + len(y)}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// DiffKinds are the kinds of differences between two tables,
+// as reported in the Kind column of the [Diff] result table.
+type DiffKinds int32 //enums:enum -trim-prefix Diff
+
+const (
+	// DiffAdded is a row that is present in table b but not in table a.
+	DiffAdded DiffKinds = iota
+
+	// DiffRemoved is a row that is present in table a but not in table b.
+	DiffRemoved
+
+	// DiffChanged is a cell value that differs between the tables,
+	// beyond the tolerance for numeric values.
+	DiffChanged
+
+	// DiffSchema is a column that is only present in one of the tables,
+	// or has a different data type or cell shape.
+	DiffSchema
+)
+
+// Diff compares table a to table b, returning a new table with one row for
+// each difference found, in the form of a report with the following columns:
+//   - Kind: the [DiffKinds] kind of difference.
+//   - Key: the key values of the row (or the row number if no keyColumns).
+//   - Column: the column name for changed cells and schema differences.
+//   - Cell: the cell index within a higher dimensional column.
+//   - RowA, RowB: the rows in the indexed views of tables a and b, or -1 if not present.
+//   - A, B: the string representations of the values, or the column type
+//     and cell shape for schema differences.
+//   - Delta: the numeric difference b - a, for changed numeric values.
+//
+// Rows are matched according to the values of the given key columns (which
+// must be present in both tables), or by row number if none are given.
+// Numeric cell values are considered to be different if they are not equal
+// and their absolute difference is greater than the given tolerance, so
+// infinite values of the same sign are equal, and missing (NaN) values are
+// equal to each other. Columns that differ in type are compared if both are
+// numeric, but columns that differ in cell shape are not compared.
+// An empty result means that the tables are equivalent.
+func Diff(a, b *Table, keyColumns []string, tolerance float64) (*Table, error) {
+	dt := New("Diff")
+	kind := dt.AddStringColumn("Kind")
+	key := dt.AddStringColumn("Key")
+	column := dt.AddStringColumn("Column")
+	cell := dt.AddIntColumn("Cell")
+	rowA := dt.AddIntColumn("RowA")
+	rowB := dt.AddIntColumn("RowB")
+	valA := dt.AddStringColumn("A")
+	valB := dt.AddStringColumn("B")
+	delta := dt.AddFloat64Column("Delta")
+	add := func(k DiffKinds, ky, col string, ci, ra, rb int, va, vb string, dv float64) {
+		row := dt.NumRows()
+		dt.AddRows(1)
+		kind.SetStringRow(k.String(), row, 0)
+		key.SetStringRow(ky, row, 0)
+		column.SetStringRow(col, row, 0)
+		cell.SetIntRow(ci, row, 0)
+		rowA.SetIntRow(ra, row, 0)
+		rowB.SetIntRow(rb, row, 0)
+		valA.SetStringRow(va, row, 0)
+		valB.SetStringRow(vb, row, 0)
+		delta.SetFloatRow(dv, row, 0)
+	}
+	nan := math.NaN()
+
+	for _, kc := range keyColumns {
+		if a.Column(kc) == nil || b.Column(kc) == nil {
+			return nil, fmt.Errorf("table.Diff: key column %q not found in both tables", kc)
+		}
+	}
+
+	// schema
+	var cols []string
+	for i, nm := range a.Columns.Keys {
+		ca := a.Columns.Values[i]
+		cb := b.Columns.At(nm)
+		if cb == nil {
+			add(DiffSchema, "", nm, 0, -1, -1, columnTypeString(ca), "", nan)
+			continue
+		}
+		sa, sb := columnTypeString(ca), columnTypeString(cb)
+		if sa != sb {
+			add(DiffSchema, "", nm, 0, -1, -1, sa, sb, nan)
+			if !slices.Equal(ca.ShapeSizes()[1:], cb.ShapeSizes()[1:]) || ca.IsString() != cb.IsString() {
+				continue
+			}
+		}
+		if !slices.Contains(keyColumns, nm) {
+			cols = append(cols, nm)
+		}
+	}
+	for i, nm := range b.Columns.Keys {
+		if a.Columns.At(nm) == nil {
+			add(DiffSchema, "", nm, 0, -1, -1, "", columnTypeString(b.Columns.Values[i]), nan)
+		}
+	}
+
+	// rows
+	rowKey := func(dt *Table, row int) string {
+		if len(keyColumns) == 0 {
+			return strconv.Itoa(row)
+		}
+		ks := make([]string, len(keyColumns))
+		for i, kc := range keyColumns {
+			ks[i] = dt.Column(kc).StringRow(row, 0)
+		}
+		return strings.Join(ks, ", ")
+	}
+	bRows := map[string][]int{}
+	for r := range b.NumRows() {
+		ky := rowKey(b, r)
+		bRows[ky] = append(bRows[ky], r)
+	}
+	matched := make([]bool, b.NumRows())
+	for ra := range a.NumRows() {
+		ky := rowKey(a, ra)
+		rbs := bRows[ky]
+		if len(rbs) == 0 {
+			add(DiffRemoved, ky, "", 0, ra, -1, "", "", nan)
+			continue
+		}
+		rb := rbs[0]
+		bRows[ky] = rbs[1:]
+		matched[rb] = true
+		for _, nm := range cols {
+			ca, cb := a.Column(nm), b.Column(nm)
+			_, cells := ca.RowCellSize()
+			for c := range cells {
+				if ca.IsString() {
+					sa, sb := ca.StringRow(ra, c), cb.StringRow(rb, c)
+					if sa != sb {
+						add(DiffChanged, ky, nm, c, ra, rb, sa, sb, nan)
+					}
+					continue
+				}
+				fa, fb := ca.FloatRow(ra, c), cb.FloatRow(rb, c)
+				nana, nanb := math.IsNaN(fa), math.IsNaN(fb)
+				if fa == fb || (nana && nanb) || (!nana && !nanb && math.Abs(fb-fa) <= tolerance) {
+					continue
+				}
+				add(DiffChanged, ky, nm, c, ra, rb, ca.StringRow(ra, c), cb.StringRow(rb, c), fb-fa)
+			}
+		}
+	}
+	for rb := range b.NumRows() {
+		if !matched[rb] {
+			add(DiffAdded, rowKey(b, rb), "", 0, -1, rb, "", "", nan)
+		}
+	}
+	return dt, nil
+}
+
+// columnTypeString returns a string with the data type and cell shape
+// of the given column, for reporting schema differences.
+func columnTypeString(cl tensor.Values) string {
+	str := cl.DataType().String()
+	if cl.NumDims() > 1 {
+		str += fmt.Sprint(cl.ShapeSizes()[1:])
+	}
+	return str
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDiffTable(names []string, vals []float64) *Table {
+	dt := New()
+	dt.AddStringColumn("Name")
+	dt.AddFloat64Column("Value")
+	dt.AddFloat32Column("Act", 2)
+	dt.SetNumRows(len(names))
+	for i, nm := range names {
+		dt.Column("Name").SetStringRow(nm, i, 0)
+		dt.Column("Value").SetFloatRow(vals[i], i, 0)
+		dt.Column("Act").SetFloatRow(float64(i), i, 1)
+	}
+	return dt
+}
+
+func TestDiff(t *testing.T) {
+	a := newDiffTable([]string{"a", "b", "c"}, []float64{1, 2, math.NaN()})
+	b := newDiffTable([]string{"b", "c", "d"}, []float64{2.0001, math.NaN(), 4})
+
+	df, err := Diff(a, a.Clone(), nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, df.NumRows())
+
+	inf := newDiffTable([]string{"a", "b"}, []float64{math.Inf(1), math.Inf(-1)})
+	df, err = Diff(inf, inf.Clone(), nil, 0.001)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, df.NumRows())
+
+	df, err = Diff(a, b, []string{"Name"}, 0.001)
+	assert.NoError(t, err)
+	// b, c rows have Act cell 1 changed from 1 -> 0 and 2 -> 1
+	assert.Equal(t, 4, df.NumRows())
+	kinds := df.Column("Kind")
+	assert.Equal(t, "Removed", kinds.StringRow(0, 0))
+	assert.Equal(t, "a", df.Column("Key").StringRow(0, 0))
+	assert.Equal(t, 0, df.Column("RowA").IntRow(0, 0))
+	assert.Equal(t, -1, df.Column("RowB").IntRow(0, 0))
+
+	assert.Equal(t, "Changed", kinds.StringRow(1, 0))
+	assert.Equal(t, "b", df.Column("Key").StringRow(1, 0))
+	assert.Equal(t, "Act", df.Column("Column").StringRow(1, 0))
+	assert.Equal(t, 1, df.Column("Cell").IntRow(1, 0))
+	assert.Equal(t, "1", df.Column("A").StringRow(1, 0))
+	assert.Equal(t, "0", df.Column("B").StringRow(1, 0))
+	assert.Equal(t, -1.0, df.Column("Delta").FloatRow(1, 0))
+
+	assert.Equal(t, "Added", kinds.StringRow(3, 0))
+	assert.Equal(t, "d", df.Column("Key").StringRow(3, 0))
+	assert.Equal(t, 2, df.Column("RowB").IntRow(3, 0))
+
+	df, err = Diff(a, b, []string{"Name"}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, df.NumRows())
+	assert.Equal(t, "Value", df.Column("Column").StringRow(1, 0))
+
+	// by row number
+	df, err = Diff(a, b, nil, 0.001)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, df.NumRows()) // 3 Name + 3 Value
+
+	// schema
+	b.DeleteColumnName("Act")
+	b.AddIntColumn("Act")
+	b.AddStringColumn("Extra")
+	df, err = Diff(a, b, []string{"Name"}, 0.001)
+	assert.NoError(t, err)
+	assert.Equal(t, "Schema", df.Column("Kind").StringRow(0, 0))
+	assert.Equal(t, "Act", df.Column("Column").StringRow(0, 0))
+	assert.Equal(t, "float32[2]", df.Column("A").StringRow(0, 0))
+	assert.Equal(t, "int", df.Column("B").StringRow(0, 0))
+	assert.Equal(t, "Extra", df.Column("Column").StringRow(1, 0))
+	assert.Equal(t, "", df.Column("A").StringRow(1, 0))
+	assert.Equal(t, 4, df.NumRows())
+
+	_, err = Diff(a, b, []string{"Nope"}, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Code generated by "core generate"; DO NOT EDIT.
+
+package table
+
+import (
+	"cogentcore.org/core/enums"
+)
+
+var _DiffKindsValues = []DiffKinds{0, 1, 2, 3}
+
+// DiffKindsN is the highest valid value for type DiffKinds, plus one.
+const DiffKindsN DiffKinds = 4
+
+var _DiffKindsValueMap = map[string]DiffKinds{`Added`: 0, `Removed`: 1, `Changed`: 2, `Schema`: 3}
+
+var _DiffKindsDescMap = map[DiffKinds]string{0: `DiffAdded is a row that is present in table b but not in table a.`, 1: `DiffRemoved is a row that is present in table a but not in table b.`, 2: `DiffChanged is a cell value that differs between the tables, beyond the tolerance for numeric values.`, 3: `DiffSchema is a column that is only present in one of the tables, or has a different data type or cell shape.`}
+
+var _DiffKindsMap = map[DiffKinds]string{0: `Added`, 1: `Removed`, 2: `Changed`, 3: `Schema`}
+
+// String returns the string representation of this DiffKinds value.
+func (i DiffKinds) String() string { return enums.String(i, _DiffKindsMap) }
+
+// SetString sets the DiffKinds value from its string representation,
+// and returns an error if the string is invalid.
+func (i *DiffKinds) SetString(s string) error {
+	return enums.SetString(i, s, _DiffKindsValueMap, "DiffKinds")
+}
+
+// Int64 returns the DiffKinds value as an int64.
+func (i DiffKinds) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the DiffKinds value from an int64.
+func (i *DiffKinds) SetInt64(in int64) { *i = DiffKinds(in) }
+
+// Desc returns the description of the DiffKinds value.
+func (i DiffKinds) Desc() string { return enums.Desc(i, _DiffKindsDescMap) }
+
+// DiffKindsValues returns all possible values for the type DiffKinds.
+func DiffKindsValues() []DiffKinds { return _DiffKindsValues }
+
+// Values returns all possible values for the type DiffKinds.
+func (i DiffKinds) Values() []enums.Enum { return enums.Values(_DiffKindsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i DiffKinds) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *DiffKinds) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "DiffKinds")
+}
+
+var _FillMethodsValues = []FillMethods{0, 1, 2, 3}
+
+// FillMethodsN is the highest valid value for type FillMethods, plus one.
+const FillMethodsN FillMethods = 4
+
+var _FillMethodsValueMap = map[string]FillMethods{`Value`: 0, `Forward`: 1, `Backward`: 2, `Mean`: 3}
+
+var _FillMethodsDescMap = map[FillMethods]string{0: `FillValue fills missing values with a given constant value.`, 1: `FillForward fills missing values with the last non-missing value in a prior row, in the current indexed order of rows. Leading missing values are left as is.`, 2: `FillBackward fills missing values with the next non-missing value in a subsequent row, in the current indexed order of rows. Trailing missing values are left as is.`, 3: `FillMean fills missing values with the mean of the non-missing values in the current indexed view of the column, computed separately for each cell in higher dimensional data.`}
+
+var _FillMethodsMap = map[FillMethods]string{0: `Value`, 1: `Forward`, 2: `Backward`, 3: `Mean`}
+
+// String returns the string representation of this FillMethods value.
+func (i FillMethods) String() string { return enums.String(i, _FillMethodsMap) }
+
+// SetString sets the FillMethods value from its string representation,
+// and returns an error if the string is invalid.
+func (i *FillMethods) SetString(s string) error {
+	return enums.SetString(i, s, _FillMethodsValueMap, "FillMethods")
+}
+
+// Int64 returns the FillMethods value as an int64.
+func (i FillMethods) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the FillMethods value from an int64.
+func (i *FillMethods) SetInt64(in int64) { *i = FillMethods(in) }
+
+// Desc returns the description of the FillMethods value.
+func (i FillMethods) Desc() string { return enums.Desc(i, _FillMethodsDescMap) }
+
+// FillMethodsValues returns all possible values for the type FillMethods.
+func FillMethodsValues() []FillMethods { return _FillMethodsValues }
+
+// Values returns all possible values for the type FillMethods.
+func (i FillMethods) Values() []enums.Enum { return enums.Values(_FillMethodsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i FillMethods) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *FillMethods) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "FillMethods")
+}
+
+var _InterpolateMethodsValues = []InterpolateMethods{0, 1}
+
+// InterpolateMethodsN is the highest valid value for type InterpolateMethods, plus one.
+const InterpolateMethodsN InterpolateMethods = 2
+
+var _InterpolateMethodsValueMap = map[string]InterpolateMethods{`Linear`: 0, `Nearest`: 1}
+
+var _InterpolateMethodsDescMap = map[InterpolateMethods]string{0: `InterpolateLinear interpolates linearly between the closest non-missing values on either side, as a function of row distance. Leading and trailing missing values are left as is.`, 1: `InterpolateNearest uses the closest non-missing value in terms of row distance, using the prior row in case of a tie. Leading and trailing missing values are filled with the first and last non-missing values, respectively.`}
+
+var _InterpolateMethodsMap = map[InterpolateMethods]string{0: `Linear`, 1: `Nearest`}
+
+// String returns the string representation of this InterpolateMethods value.
+func (i InterpolateMethods) String() string { return enums.String(i, _InterpolateMethodsMap) }
+
+// SetString sets the InterpolateMethods value from its string representation,
+// and returns an error if the string is invalid.
+func (i *InterpolateMethods) SetString(s string) error {
+	return enums.SetString(i, s, _InterpolateMethodsValueMap, "InterpolateMethods")
+}
+
+// Int64 returns the InterpolateMethods value as an int64.
+func (i InterpolateMethods) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the InterpolateMethods value from an int64.
+func (i *InterpolateMethods) SetInt64(in int64) { *i = InterpolateMethods(in) }
+
+// Desc returns the description of the InterpolateMethods value.
+func (i InterpolateMethods) Desc() string { return enums.Desc(i, _InterpolateMethodsDescMap) }
+
+// InterpolateMethodsValues returns all possible values for the type InterpolateMethods.
+func InterpolateMethodsValues() []InterpolateMethods { return _InterpolateMethodsValues }
+
+// Values returns all possible values for the type InterpolateMethods.
+func (i InterpolateMethods) Values() []enums.Enum { return enums.Values(_InterpolateMethodsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i InterpolateMethods) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *InterpolateMethods) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "InterpolateMethods")
+}
+
+var _SchemaModesValues = []SchemaModes{0, 1}
+
+// SchemaModesN is the highest valid value for type SchemaModes, plus one.
+const SchemaModesN SchemaModes = 2
+
+var _SchemaModesValueMap = map[string]SchemaModes{`Validate`: 0, `Coerce`: 1}
+
+var _SchemaModesDescMap = map[SchemaModes]string{0: `SchemaValidate reports a [SchemaError] for each column and value that does not match the schema. Values that cannot be parsed are set to missing (NaN) for float columns and 0 for other numeric columns, and values out of range are left as is.`, 1: `SchemaCoerce silently converts the values to fit the schema: values that cannot be parsed are set to missing (NaN) for float columns and 0 for other numeric columns, and values out of range are clamped to the range. Only columns that are missing in the data are reported as errors.`}
+
+var _SchemaModesMap = map[SchemaModes]string{0: `Validate`, 1: `Coerce`}
+
+// String returns the string representation of this SchemaModes value.
+func (i SchemaModes) String() string { return enums.String(i, _SchemaModesMap) }
+
+// SetString sets the SchemaModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *SchemaModes) SetString(s string) error {
+	return enums.SetString(i, s, _SchemaModesValueMap, "SchemaModes")
+}
+
+// Int64 returns the SchemaModes value as an int64.
+func (i SchemaModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the SchemaModes value from an int64.
+func (i *SchemaModes) SetInt64(in int64) { *i = SchemaModes(in) }
+
+// Desc returns the description of the SchemaModes value.
+func (i SchemaModes) Desc() string { return enums.Desc(i, _SchemaModesDescMap) }
+
+// SchemaModesValues returns all possible values for the type SchemaModes.
+func SchemaModesValues() []SchemaModes { return _SchemaModesValues }
+
+// Values returns all possible values for the type SchemaModes.
+func (i SchemaModes) Values() []enums.Enum { return enums.Values(_SchemaModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i SchemaModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *SchemaModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "SchemaModes")
+}
@@ -68,12 +68,22 @@ func (dt *Table) String() string {
 // information for tensor type and dimensionality.
 // If the table DOES have existing columns, then those are used robustly
 // for whatever information fits from each row of the file.
+// If a sidecar [Schema] file with the [SchemaExt] extension added to
+// the filename exists, then it is used to read the file via
+// [Table.ReadCSVSchema] in [SchemaValidate] mode.
 func (dt *Table) OpenCSV(filename fsx.Filename, delim tensor.Delims) error { //types:add
 	fp, err := os.Open(string(filename))
 	if err != nil {
 		return errors.Log(err)
 	}
 	defer fp.Close()
+	if sfn := string(filename) + SchemaExt; errors.Ignore1(fsx.FileExists(sfn)) {
+		sc, err := OpenSchema(sfn)
+		if err != nil {
+			return errors.Log(err)
+		}
+		return dt.ReadCSVSchema(bufio.NewReader(fp), delim, sc, SchemaValidate)
+	}
 	return dt.ReadCSV(bufio.NewReader(fp), delim)
 }
 
@@ -84,6 +94,13 @@ func (dt *Table) OpenFS(fsys fs.FS, filename string, delim tensor.Delims) error
 		return errors.Log(err)
 	}
 	defer fp.Close()
+	if sfn := filename + SchemaExt; errors.Ignore1(fsx.FileExistsFS(fsys, sfn)) {
+		sc, err := OpenSchemaFS(fsys, sfn)
+		if err != nil {
+			return errors.Log(err)
+		}
+		return dt.ReadCSVSchema(bufio.NewReader(fp), delim, sc, SchemaValidate)
+	}
 	return dt.ReadCSV(bufio.NewReader(fp), delim)
 }
 
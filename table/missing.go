@@ -0,0 +1,264 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"errors"
+	"math"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// FillMethods are the different ways of filling in missing values,
+// as indicated by NaN, used in [Table.FillMissing].
+type FillMethods int32 //enums:enum -trim-prefix Fill
+
+const (
+	// FillValue fills missing values with a given constant value.
+	FillValue FillMethods = iota
+
+	// FillForward fills missing values with the last non-missing value
+	// in a prior row, in the current indexed order of rows.
+	// Leading missing values are left as is.
+	FillForward
+
+	// FillBackward fills missing values with the next non-missing value
+	// in a subsequent row, in the current indexed order of rows.
+	// Trailing missing values are left as is.
+	FillBackward
+
+	// FillMean fills missing values with the mean of the non-missing
+	// values in the current indexed view of the column, computed
+	// separately for each cell in higher dimensional data.
+	FillMean
+)
+
+// InterpolateMethods are the different ways of interpolating missing values,
+// as indicated by NaN, used in [Table.Interpolate].
+type InterpolateMethods int32 //enums:enum -trim-prefix Interpolate
+
+const (
+	// InterpolateLinear interpolates linearly between the closest
+	// non-missing values on either side, as a function of row distance.
+	// Leading and trailing missing values are left as is.
+	InterpolateLinear InterpolateMethods = iota
+
+	// InterpolateNearest uses the closest non-missing value in terms of
+	// row distance, using the prior row in case of a tie.
+	// Leading and trailing missing values are filled with the
+	// first and last non-missing values, respectively.
+	InterpolateNearest
+)
+
+// missingColumns returns the column list for the missing value functions:
+// the named columns if specified (with an error for any not found),
+// otherwise all of the non-string columns. If floatOnly is set,
+// only float32 and float64 columns are included.
+func (dt *Table) missingColumns(floatOnly bool, columns ...string) ([]*tensor.Rows, error) {
+	var cols []*tensor.Rows
+	var errs []error
+	if len(columns) == 0 {
+		for i, cl := range dt.Columns.Values {
+			if cl.IsString() || (floatOnly && !isFloatColumn(cl)) {
+				continue
+			}
+			cols = append(cols, dt.ColumnByIndex(i))
+		}
+		return cols, nil
+	}
+	for _, nm := range columns {
+		cl, err := dt.ColumnTry(nm)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if cl.IsString() || (floatOnly && !isFloatColumn(cl.Tensor)) {
+			continue
+		}
+		cols = append(cols, cl)
+	}
+	return cols, errors.Join(errs...)
+}
+
+// isFloatColumn returns true if the given tensor has a floating point
+// data type, which is the only type that can represent missing values.
+func isFloatColumn(tsr tensor.Tensor) bool {
+	_, isf32 := tsr.(*tensor.Float32)
+	_, isf64 := tsr.(*tensor.Float64)
+	return isf32 || isf64
+}
+
+// isMissingRow returns true if any cell in the given row of any of the
+// given columns is missing, as indicated by NaN. The row is the actual
+// row number into the underlying column data (i.e., already projected
+// through the Indexes), as in the [Table.Filter] function.
+func isMissingRow(cols []*tensor.Rows, row int) bool {
+	for _, cl := range cols {
+		_, cells := cl.Tensor.Shape().RowCellSize()
+		for c := range cells {
+			if math.IsNaN(cl.Tensor.FloatRow(row, c)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DropMissing filters the indexes to exclude any rows that have missing values,
+// as indicated by NaN, in any cell of the given columns, or all non-string
+// columns if none are specified. As with [Table.Filter], this only affects the
+// Indexes, so [Table.Sequential] restores the full set of rows.
+// Use [NewView] prior to calling to preserve the existing view.
+// Returns an error for any column names not found.
+func (dt *Table) DropMissing(columns ...string) error { //types:add
+	cols, err := dt.missingColumns(false, columns...)
+	if len(cols) == 0 {
+		return err
+	}
+	dt.Filter(func(dt *Table, row int) bool {
+		return !isMissingRow(cols, row)
+	})
+	return err
+}
+
+// FillMissing fills in missing values, as indicated by NaN, using the given
+// [FillMethods] method, for the given columns, or all float columns if none
+// are specified. The value is only used for the [FillValue] method.
+// This operates in place on the underlying column data, for the rows in
+// the current indexed view, and in the order of that view.
+// Returns an error for any column names not found.
+func (dt *Table) FillMissing(method FillMethods, value float64, columns ...string) error { //types:add
+	cols, err := dt.missingColumns(true, columns...)
+	for _, cl := range cols {
+		fillMissingColumn(cl, method, value)
+	}
+	return err
+}
+
+// fillMissingColumn implements [Table.FillMissing] for one column.
+func fillMissingColumn(cl *tensor.Rows, method FillMethods, value float64) {
+	rows, cells := cl.RowCellSize()
+	for c := range cells {
+		switch method {
+		case FillValue:
+			for r := range rows {
+				if math.IsNaN(cl.FloatRow(r, c)) {
+					cl.SetFloatRow(value, r, c)
+				}
+			}
+		case FillForward:
+			last := math.NaN()
+			for r := range rows {
+				v := cl.FloatRow(r, c)
+				switch {
+				case !math.IsNaN(v):
+					last = v
+				case !math.IsNaN(last):
+					cl.SetFloatRow(last, r, c)
+				}
+			}
+		case FillBackward:
+			next := math.NaN()
+			for r := rows - 1; r >= 0; r-- {
+				v := cl.FloatRow(r, c)
+				switch {
+				case !math.IsNaN(v):
+					next = v
+				case !math.IsNaN(next):
+					cl.SetFloatRow(next, r, c)
+				}
+			}
+		case FillMean:
+			sum, n := 0.0, 0
+			for r := range rows {
+				v := cl.FloatRow(r, c)
+				if !math.IsNaN(v) {
+					sum += v
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+			mean := sum / float64(n)
+			for r := range rows {
+				if math.IsNaN(cl.FloatRow(r, c)) {
+					cl.SetFloatRow(mean, r, c)
+				}
+			}
+		}
+	}
+}
+
+// Interpolate fills in missing values, as indicated by NaN, by interpolating
+// along the rows from the nearest non-missing values, using the given
+// [InterpolateMethods] method, for the given float columns, or all float columns
+// if none are specified. Row distance is in terms of the current indexed view.
+// This operates in place on the underlying column data, for the rows in
+// the current indexed view. Returns an error for any column names not found.
+func (dt *Table) Interpolate(method InterpolateMethods, columns ...string) error { //types:add
+	cols, err := dt.missingColumns(true, columns...)
+	for _, cl := range cols {
+		interpolateColumn(cl, method)
+	}
+	return err
+}
+
+// interpolateColumn implements [Table.Interpolate] for one column.
+func interpolateColumn(cl *tensor.Rows, method InterpolateMethods) {
+	rows, cells := cl.RowCellSize()
+	for c := range cells {
+		prev := -1 // row of previous non-missing value
+		for r := range rows {
+			if math.IsNaN(cl.FloatRow(r, c)) {
+				continue
+			}
+			if r-prev > 1 {
+				interpolateGap(cl, method, c, prev, r, rows)
+			}
+			prev = r
+		}
+		if prev >= 0 && prev < rows-1 {
+			interpolateGap(cl, method, c, prev, rows, rows)
+		}
+	}
+}
+
+// interpolateGap fills the missing rows between non-missing rows
+// st and ed (exclusive), where st = -1 and ed = rows indicate
+// a gap that extends to the start or end of the rows, respectively.
+func interpolateGap(cl *tensor.Rows, method InterpolateMethods, c, st, ed, rows int) {
+	if st < 0 && ed >= rows {
+		return
+	}
+	var sv, ev float64
+	if st >= 0 {
+		sv = cl.FloatRow(st, c)
+	}
+	if ed < rows {
+		ev = cl.FloatRow(ed, c)
+	}
+	for r := st + 1; r < ed; r++ {
+		switch {
+		case st < 0:
+			if method == InterpolateNearest {
+				cl.SetFloatRow(ev, r, c)
+			}
+		case ed >= rows:
+			if method == InterpolateNearest {
+				cl.SetFloatRow(sv, r, c)
+			}
+		case method == InterpolateNearest:
+			if r-st <= ed-r {
+				cl.SetFloatRow(sv, r, c)
+			} else {
+				cl.SetFloatRow(ev, r, c)
+			}
+		default:
+			t := float64(r-st) / float64(ed-st)
+			cl.SetFloatRow(sv+t*(ev-sv), r, c)
+		}
+	}
+}
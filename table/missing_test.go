@@ -0,0 +1,110 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMissingTable() *Table {
+	nan := math.NaN()
+	dt := New()
+	dt.AddStringColumn("Name")
+	dt.AddFloat64Column("A")
+	dt.AddFloat32Column("B", 2)
+	dt.SetNumRows(6)
+	a := []float64{nan, 1, nan, nan, 4, nan}
+	b := []float64{0, 1, 2, nan, 4, 5, 6, nan, 8, 9, nan, 11}
+	for i := range 6 {
+		dt.Column("Name").SetStringRow("n", i, 0)
+		dt.Column("A").SetFloatRow(a[i], i, 0)
+		dt.Column("B").SetFloatRow(b[2*i], i, 0)
+		dt.Column("B").SetFloatRow(b[2*i+1], i, 1)
+	}
+	return dt
+}
+
+func columnFloats(dt *Table, name string, cell int) []float64 {
+	cl := dt.Column(name)
+	vals := make([]float64, cl.NumRows())
+	for i := range vals {
+		vals[i] = cl.FloatRow(i, cell)
+	}
+	return vals
+}
+
+func assertFloats(t *testing.T, exp, vals []float64) {
+	t.Helper()
+	assert.Equal(t, len(exp), len(vals))
+	for i, v := range exp {
+		if math.IsNaN(v) {
+			assert.True(t, math.IsNaN(vals[i]), "index %d: %g", i, vals[i])
+		} else {
+			assert.InDelta(t, v, vals[i], 1.0e-6, "index %d", i)
+		}
+	}
+}
+
+func TestDropMissing(t *testing.T) {
+	dt := newMissingTable()
+	assert.NoError(t, dt.DropMissing("A"))
+	assert.Equal(t, []int{1, 4}, dt.Indexes)
+
+	dt.Sequential()
+	assert.NoError(t, dt.DropMissing())
+	assert.Equal(t, []int{4}, dt.Indexes)
+
+	dt.Sequential()
+	assert.NoError(t, dt.DropMissing("B"))
+	assert.Equal(t, []int{0, 2, 4}, dt.Indexes)
+
+	assert.Error(t, dt.DropMissing("Nope"))
+}
+
+func TestFillMissing(t *testing.T) {
+	nan := math.NaN()
+	dt := newMissingTable()
+	assert.NoError(t, dt.FillMissing(FillValue, -1, "A"))
+	assertFloats(t, []float64{-1, 1, -1, -1, 4, -1}, columnFloats(dt, "A", 0))
+
+	dt = newMissingTable()
+	assert.NoError(t, dt.FillMissing(FillForward, 0, "A"))
+	assertFloats(t, []float64{nan, 1, 1, 1, 4, 4}, columnFloats(dt, "A", 0))
+
+	dt = newMissingTable()
+	assert.NoError(t, dt.FillMissing(FillBackward, 0, "A"))
+	assertFloats(t, []float64{1, 1, 4, 4, 4, nan}, columnFloats(dt, "A", 0))
+
+	dt = newMissingTable()
+	assert.NoError(t, dt.FillMissing(FillMean, 0))
+	assertFloats(t, []float64{2.5, 1, 2.5, 2.5, 4, 2.5}, columnFloats(dt, "A", 0))
+	assertFloats(t, []float64{1, 6.5, 5, 6.5, 9, 11}, columnFloats(dt, "B", 1))
+
+	// only the rows in the view are affected, in view order.
+	dt = newMissingTable()
+	dt.Indexes = []int{4, 3, 2, 1, 0}
+	assert.NoError(t, dt.FillMissing(FillForward, 0, "A"))
+	dt.Sequential()
+	assertFloats(t, []float64{1, 1, 4, 4, 4, nan}, columnFloats(dt, "A", 0))
+}
+
+func TestInterpolate(t *testing.T) {
+	nan := math.NaN()
+	dt := newMissingTable()
+	assert.NoError(t, dt.Interpolate(InterpolateLinear, "A"))
+	assertFloats(t, []float64{nan, 1, 2, 3, 4, nan}, columnFloats(dt, "A", 0))
+
+	dt = newMissingTable()
+	assert.NoError(t, dt.Interpolate(InterpolateNearest, "A"))
+	assertFloats(t, []float64{1, 1, 1, 4, 4, 4}, columnFloats(dt, "A", 0))
+
+	dt = newMissingTable()
+	assert.NoError(t, dt.Interpolate(InterpolateLinear))
+	assertFloats(t, []float64{0, 2, 4, 6, 8, nan}, columnFloats(dt, "B", 0))
+	assertFloats(t, []float64{1, 3, 5, 7, 9, 11}, columnFloats(dt, "B", 1))
+}
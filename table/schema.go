@@ -0,0 +1,471 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cogentcore.org/core/base/iox/jsonx"
+	"cogentcore.org/core/math32/minmax"
+	"cogentcore.org/lab/tensor"
+)
+
+// SchemaExt is the extension added to the name of a CSV / TSV data file
+// to obtain the name of its sidecar [Schema] file, which is automatically
+// used by [Table.OpenCSV] and [Table.OpenFS] if present.
+const SchemaExt = ".schema.json"
+
+// Schema describes the columns of a [Table], including the data type
+// and cell shape of each column, with optional units and allowed range
+// of values. It is used to validate or coerce data read from CSV / TSV
+// files in [Table.ReadCSVSchema], and to validate an existing table
+// with [Schema.Validate]. Use [Table.Schema] to get the schema for an
+// existing table. It is saved and loaded as a JSON file.
+type Schema struct {
+	// Columns has the specification for each column, in order.
+	Columns []ColumnSchema
+}
+
+// ColumnSchema is the [Schema] specification for one column.
+type ColumnSchema struct {
+	// Name is the column name, which must be unique.
+	Name string
+
+	// Type is the data type of the column, as the lowercase Go type name:
+	// string, bool, float32, float64, int, int32, or byte.
+	// See [KindFromString].
+	Type string
+
+	// CellShape is the shape of the cells for higher dimensional data,
+	// and is empty for scalar columns.
+	CellShape []int `json:",omitempty"`
+
+	// Units are the units of measurement for the values (e.g., "ms"),
+	// which are set as the Units metadata on the column (see [tensor.SetUnits]).
+	Units string `json:",omitempty"`
+
+	// Range is the allowed range of numeric values, where the FixMin and
+	// FixMax flags indicate if the Min and Max values are enforced.
+	Range minmax.Range64
+}
+
+// SchemaModes are the different ways that a [Schema] can be applied
+// when reading data in [Table.ReadCSVSchema].
+type SchemaModes int32 //enums:enum -trim-prefix Schema
+
+const (
+	// SchemaValidate reports a [SchemaError] for each column and value that
+	// does not match the schema. Values that cannot be parsed are set to
+	// missing (NaN) for float columns and 0 for other numeric columns, and
+	// values out of range are left as is.
+	SchemaValidate SchemaModes = iota
+
+	// SchemaCoerce silently converts the values to fit the schema:
+	// values that cannot be parsed are set to missing (NaN) for float
+	// columns and 0 for other numeric columns, and values out of range are
+	// clamped to the range. Only columns that are missing in the data
+	// are reported as errors.
+	SchemaCoerce
+)
+
+// SchemaError is an error for a specific row and column of data,
+// relative to a [Schema]. Row is the row in the table (not including
+// headers), or -1 for errors that apply to the column as a whole.
+type SchemaError struct {
+	// Row is the table row, or -1 for the entire column.
+	Row int
+
+	// Column is the column name.
+	Column string
+
+	// Err is the error.
+	Err error
+}
+
+func (se *SchemaError) Error() string {
+	if se.Row < 0 {
+		return fmt.Sprintf("column %q: %v", se.Column, se.Err)
+	}
+	return fmt.Sprintf("row %d, column %q: %v", se.Row, se.Column, se.Err)
+}
+
+func (se *SchemaError) Unwrap() error { return se.Err }
+
+// Kind returns the [reflect.Kind] for the column Type.
+func (cs *ColumnSchema) Kind() (reflect.Kind, error) {
+	return KindFromString(cs.Type)
+}
+
+// IsNumeric returns true if the column is a numeric type that
+// is subject to the Range limits.
+func (cs *ColumnSchema) IsNumeric() bool {
+	kind, err := cs.Kind()
+	return err == nil && kind != reflect.String && kind != reflect.Bool
+}
+
+// NumCells returns the number of cells per row.
+func (cs *ColumnSchema) NumCells() int {
+	n := 1
+	for _, d := range cs.CellShape {
+		n *= d
+	}
+	return n
+}
+
+// checkRange returns an error if the value is outside of the Range,
+// which is ignored for missing values.
+func (cs *ColumnSchema) checkRange(val float64) error {
+	if math.IsNaN(val) {
+		return nil
+	}
+	if cs.Range.FixMin && val < cs.Range.Min {
+		return fmt.Errorf("value %g is below the minimum of %g", val, cs.Range.Min)
+	}
+	if cs.Range.FixMax && val > cs.Range.Max {
+		return fmt.Errorf("value %g is above the maximum of %g", val, cs.Range.Max)
+	}
+	return nil
+}
+
+// clamp returns the value clamped to the Range.
+func (cs *ColumnSchema) clamp(val float64) float64 {
+	if cs.Range.FixMin && val < cs.Range.Min {
+		return cs.Range.Min
+	}
+	if cs.Range.FixMax && val > cs.Range.Max {
+		return cs.Range.Max
+	}
+	return val
+}
+
+// Column returns the column specification with the given name,
+// or nil if not found.
+func (sc *Schema) Column(name string) *ColumnSchema {
+	for i := range sc.Columns {
+		if sc.Columns[i].Name == name {
+			return &sc.Columns[i]
+		}
+	}
+	return nil
+}
+
+// Schema returns the [Schema] for this table, with the column names,
+// types and cell shapes, and Units from the column metadata.
+// The Range is not set, as it cannot be determined from the data.
+func (dt *Table) Schema() *Schema {
+	sc := &Schema{}
+	for i, cl := range dt.Columns.Values {
+		cs := ColumnSchema{Name: dt.ColumnName(i), Type: cl.DataType().String(), Units: tensor.Units(cl)}
+		if cl.NumDims() > 1 {
+			cs.CellShape = slices.Clone(cl.ShapeSizes()[1:])
+		}
+		sc.Columns = append(sc.Columns, cs)
+	}
+	return sc
+}
+
+// ConfigFromSchema configures the columns of this table according to the
+// given [Schema], deleting any existing columns.
+func (dt *Table) ConfigFromSchema(sc *Schema) error {
+	dt.DeleteAll()
+	var errs []error
+	for i := range sc.Columns {
+		cs := &sc.Columns[i]
+		kind, err := cs.Kind()
+		if err != nil {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: err})
+		}
+		cl := dt.AddColumnOfType(cs.Name, kind, cs.CellShape...)
+		if cs.Units != "" {
+			tensor.SetUnits(cl, cs.Units)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OpenSchema opens a [Schema] from the given JSON file.
+func OpenSchema(filename string) (*Schema, error) {
+	sc := &Schema{}
+	err := jsonx.Open(sc, filename)
+	return sc, err
+}
+
+// OpenSchemaFS opens a [Schema] from the given JSON file in given filesystem.
+func OpenSchemaFS(fsys fs.FS, filename string) (*Schema, error) {
+	sc := &Schema{}
+	err := jsonx.OpenFS(sc, fsys, filename)
+	return sc, err
+}
+
+// Save saves the schema to the given JSON file. Use [SchemaExt] to
+// create a sidecar file that is automatically used in [Table.OpenCSV].
+func (sc *Schema) Save(filename string) error {
+	return jsonx.SaveIndent(sc, filename)
+}
+
+// Validate checks that the given table matches this schema, returning a
+// [SchemaError] for each column with a different type or cell shape,
+// and for each value outside of the allowed range, for the rows in the
+// current indexed view. Extra columns in the table that are not in the
+// schema are also reported.
+func (sc *Schema) Validate(dt *Table) error {
+	var errs []error
+	for i := range sc.Columns {
+		cs := &sc.Columns[i]
+		cl := dt.Column(cs.Name)
+		if cl == nil {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: errors.New("column not found")})
+			continue
+		}
+		if kind, err := cs.Kind(); err == nil && kind != cl.DataType() {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: fmt.Errorf("type %s does not match schema type %s", cl.DataType(), cs.Type)})
+			continue
+		}
+		var csh []int
+		if cl.NumDims() > 1 {
+			csh = cl.ShapeSizes()[1:]
+		}
+		if !slices.Equal(csh, cs.CellShape) {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: fmt.Errorf("cell shape %v does not match schema cell shape %v", csh, cs.CellShape)})
+			continue
+		}
+		if !cs.IsNumeric() {
+			continue
+		}
+		rows, cells := cl.RowCellSize()
+		for r := range rows {
+			for c := range cells {
+				if err := cs.checkRange(cl.FloatRow(r, c)); err != nil {
+					errs = append(errs, &SchemaError{Row: r, Column: cs.Name, Err: err})
+				}
+			}
+		}
+	}
+	for _, nm := range dt.Columns.Keys {
+		if sc.Column(nm) == nil {
+			errs = append(errs, &SchemaError{Row: -1, Column: nm, Err: errors.New("column is not in the schema")})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// headerColumn records the location of a column in a CSV record,
+// as parsed from the headers.
+type headerColumn struct {
+	// name of column
+	name string
+
+	// kind is the data type, if the headers have type information.
+	kind reflect.Kind
+
+	// typed is true if the headers have type information.
+	typed bool
+
+	// cell shape for higher dimensional data, from typed headers.
+	cellShape []int
+
+	// starting index in the record
+	start int
+}
+
+// parseHeaderColumns returns the columns defined by given headers,
+// which can be either special table headers or plain column names.
+func parseHeaderColumns(hdrs []string) []headerColumn {
+	typed := DetectTableHeaders(hdrs)
+	var hcs []headerColumn
+	for i, hd := range hdrs {
+		hd = strings.TrimSpace(hd)
+		if hd == "" || hd == "_H:" {
+			continue
+		}
+		if !typed {
+			hcs = append(hcs, headerColumn{name: hd, start: i})
+			continue
+		}
+		kind, hd := TableColumnType(hd)
+		hc := headerColumn{name: hd, kind: kind, typed: true, start: i}
+		if dimst := strings.Index(hd, "]<"); dimst > 0 {
+			hc.cellShape = ShapeFromString(hd[dimst+2 : len(hd)-1])
+			hc.name = hd[:strings.Index(hd, "[")]
+		} else if strings.Index(hd, "[") > 0 {
+			continue // subsequent cell of a tensor column
+		}
+		hcs = append(hcs, hc)
+	}
+	return hcs
+}
+
+// ReadCSVSchema reads a table from a comma-separated-values (CSV) file
+// (where comma = any delimiter, specified in the delim arg), using the
+// given [Schema] to configure the table columns, and the given [SchemaModes]
+// mode to either validate or coerce the data to fit the schema.
+// Columns in the file are matched to the schema by name using the header
+// row, so they can be in a different order, and extra columns are ignored
+// (and reported as errors in [SchemaValidate] mode). If none of the
+// headers match, the file is assumed to have no headers and the columns
+// are read in the order of the schema. Returns a joined list of
+// [SchemaError] errors for specific rows and columns.
+func (dt *Table) ReadCSVSchema(r io.Reader, delim tensor.Delims, sc *Schema, mode SchemaModes) error {
+	if err := dt.ConfigFromSchema(sc); err != nil {
+		return err
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = delim.Rune()
+	cr.FieldsPerRecord = -1
+	rec, err := cr.ReadAll()
+	if err != nil || len(rec) == 0 {
+		return err
+	}
+	var errs []error
+	validate := mode == SchemaValidate
+	hcs := parseHeaderColumns(rec[0])
+	starts := make([]int, len(sc.Columns))
+	nfound := 0
+	for i := range sc.Columns {
+		cs := &sc.Columns[i]
+		starts[i] = -1
+		hi := slices.IndexFunc(hcs, func(hc headerColumn) bool { return hc.name == cs.Name })
+		if hi < 0 {
+			continue
+		}
+		nfound++
+		hc := &hcs[hi]
+		starts[i] = hc.start
+		if !validate || !hc.typed {
+			continue
+		}
+		if kind, _ := cs.Kind(); TableHeaderChar(kind) != TableHeaderChar(hc.kind) {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: fmt.Errorf("header type %s does not match schema type %s", hc.kind, cs.Type)})
+		}
+		if !slices.Equal(hc.cellShape, cs.CellShape) {
+			errs = append(errs, &SchemaError{Row: -1, Column: cs.Name, Err: fmt.Errorf("header cell shape %v does not match schema cell shape %v", hc.cellShape, cs.CellShape)})
+		}
+	}
+	strow := 1
+	if nfound == 0 { // no headers: use schema order
+		strow = 0
+		ci := 0
+		if len(rec[0]) > 0 && rec[0][0] == "_D:" {
+			ci++
+		}
+		for i := range sc.Columns {
+			starts[i] = ci
+			ci += sc.Columns[i].NumCells()
+		}
+	} else {
+		for i, st := range starts {
+			if st < 0 {
+				errs = append(errs, &SchemaError{Row: -1, Column: sc.Columns[i].Name, Err: errors.New("column not found in data")})
+			}
+		}
+		if validate {
+			for _, hc := range hcs {
+				if sc.Column(hc.name) == nil {
+					errs = append(errs, &SchemaError{Row: -1, Column: hc.name, Err: errors.New("column is not in the schema")})
+				}
+			}
+		}
+	}
+	rows := len(rec) - strow
+	dt.SetNumRows(rows)
+	nan := math.NaN()
+	for i := range sc.Columns {
+		cs := &sc.Columns[i]
+		st := starts[i]
+		if st < 0 {
+			continue
+		}
+		tsr := dt.Columns.Values[i]
+		kind := tsr.DataType()
+		isString := tsr.IsString()
+		isFloat := kind == reflect.Float32 || kind == reflect.Float64
+		numeric := cs.IsNumeric()
+		cells := cs.NumCells()
+		for r := range rows {
+			rc := rec[r+strow]
+			for c := range cells {
+				ri := st + c
+				if ri >= len(rc) {
+					if validate {
+						errs = append(errs, &SchemaError{Row: r, Column: cs.Name, Err: errors.New("missing value")})
+					}
+					break
+				}
+				str := strings.TrimSpace(rc[ri])
+				if isString {
+					tsr.SetStringRow(str, r, c)
+					continue
+				}
+				if str == "" || str == "NaN" || str == "-NaN" {
+					if isFloat {
+						tsr.SetFloatRow(nan, r, c)
+					} else {
+						tsr.SetFloatRow(0, r, c)
+					}
+					continue
+				}
+				val, err := parseSchemaValue(str, kind)
+				if err != nil {
+					if validate {
+						errs = append(errs, &SchemaError{Row: r, Column: cs.Name, Err: err})
+					}
+					if isFloat {
+						val = nan
+					} else {
+						val = 0
+					}
+				}
+				if numeric {
+					if validate {
+						if err := cs.checkRange(val); err != nil {
+							errs = append(errs, &SchemaError{Row: r, Column: cs.Name, Err: err})
+						}
+					} else {
+						val = cs.clamp(val)
+					}
+				}
+				tsr.SetFloatRow(val, r, c)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// parseSchemaValue parses the given string as a value of the given kind,
+// returning it as a float64.
+func parseSchemaValue(str string, kind reflect.Kind) (float64, error) {
+	switch kind {
+	case reflect.Bool:
+		bv, err := strconv.ParseBool(str)
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as bool", str)
+		}
+		return tensor.BoolToFloat64(bv), nil
+	case reflect.Float32, reflect.Float64:
+		fv, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as %s", str, kind)
+		}
+		return fv, nil
+	}
+	iv, err := strconv.ParseInt(str, 10, 64)
+	if err == nil {
+		return float64(iv), nil
+	}
+	fv, ferr := strconv.ParseFloat(str, 64)
+	if ferr == nil && fv == math.Trunc(fv) {
+		return fv, nil
+	}
+	return 0, fmt.Errorf("cannot parse %q as %s", str, kind)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cogentcore.org/core/base/fsx"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSchemaTable() *Table {
+	dt := New()
+	dt.AddStringColumn("Name")
+	dt.AddIntColumn("Epoch")
+	dt.AddFloat64Column("Err")
+	dt.AddFloat32Column("Act", 2)
+	tensor.SetUnits(dt.Columns.At("Err"), "%")
+	dt.SetNumRows(2)
+	for i := range 2 {
+		dt.Column("Name").SetStringRow("a", i, 0)
+		dt.Column("Epoch").SetIntRow(i, i, 0)
+		dt.Column("Err").SetFloatRow(0.5, i, 0)
+		dt.Column("Act").SetFloatRow(1, i, 1)
+	}
+	return dt
+}
+
+func TestSchemaRoundTrip(t *testing.T) {
+	dt := newSchemaTable()
+	sc := dt.Schema()
+	assert.Equal(t, 4, len(sc.Columns))
+	assert.Equal(t, "int", sc.Column("Epoch").Type)
+	assert.Equal(t, "%", sc.Column("Err").Units)
+	assert.Equal(t, []int{2}, sc.Column("Act").CellShape)
+	assert.NoError(t, sc.Validate(dt))
+
+	fn := filepath.Join(t.TempDir(), "schema.json")
+	assert.NoError(t, sc.Save(fn))
+	sc2, err := OpenSchema(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, sc, sc2)
+
+	nt := New()
+	assert.NoError(t, nt.ConfigFromSchema(sc2))
+	assert.Equal(t, sc, nt.Schema())
+
+	sc.Column("Err").Type = "float32"
+	sc.Column("Epoch").Range.SetMax(0)
+	err = sc.Validate(dt)
+	var se *SchemaError
+	assert.True(t, errors.As(err, &se))
+	assert.Contains(t, err.Error(), `column "Err": type float64 does not match schema type float32`)
+	assert.Contains(t, err.Error(), `row 1, column "Epoch": value 1 is above the maximum of 0`)
+}
+
+func TestSchemaKinds(t *testing.T) {
+	kinds := []reflect.Kind{reflect.String, reflect.Bool, reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Uint8}
+	dt := New()
+	for _, k := range kinds {
+		dt.AddColumnOfType(k.String(), k)
+	}
+	nt := New()
+	assert.NoError(t, nt.ConfigFromSchema(dt.Schema()))
+	assert.Equal(t, dt.Schema(), nt.Schema())
+
+	// byte values are written to CSV as characters, so are not included
+	dt.DeleteColumnName("uint8")
+	kinds = kinds[:len(kinds)-1]
+	dt.SetNumRows(2)
+	for i, k := range kinds {
+		if k == reflect.String {
+			dt.Columns.Values[i].SetStringRow("a", 1, 0)
+		} else {
+			dt.Columns.Values[i].SetFloatRow(1, 1, 0)
+		}
+	}
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "kinds.tsv")
+	assert.NoError(t, dt.SaveCSV(fsx.Filename(fn), tensor.Tab, Headers))
+	assert.NoError(t, dt.Schema().Save(fn+SchemaExt))
+
+	sc, err := OpenSchema(fn + SchemaExt)
+	assert.NoError(t, err)
+	f, err := os.Open(fn)
+	assert.NoError(t, err)
+	defer f.Close()
+	nt = New()
+	assert.NoError(t, nt.ReadCSVSchema(f, tensor.Tab, sc, SchemaValidate))
+	assert.Equal(t, dt.Schema(), nt.Schema())
+	for i, k := range kinds {
+		assert.Equal(t, k, nt.Columns.Values[i].DataType())
+		assert.Equal(t, dt.Columns.Values[i].String1D(1), nt.Columns.Values[i].String1D(1))
+	}
+}
+
+func TestReadCSVSchema(t *testing.T) {
+	sc := newSchemaTable().Schema()
+	sc.Column("Err").Range.SetMin(0).SetMax(1)
+
+	// columns in a different order, with bad values and an extra column
+	csv := "Err\tExtra\tName\tEpoch\tAct\tAct\n0.2\tx\ta\t1\t1\t2\nbad\tx\tb\t2.5\t3\t4\n1.5\tx\tc\t3\t5\t6\n"
+	dt := New()
+	err := dt.ReadCSVSchema(strings.NewReader(csv), tensor.Tab, sc, SchemaValidate)
+	assert.Error(t, err)
+	msg := err.Error()
+	assert.Contains(t, msg, `column "Extra": column is not in the schema`)
+	assert.Contains(t, msg, `row 1, column "Err": cannot parse "bad" as float64`)
+	assert.Contains(t, msg, `row 1, column "Epoch": cannot parse "2.5" as int`)
+	assert.Contains(t, msg, `row 2, column "Err": value 1.5 is above the maximum of 1`)
+	assert.Equal(t, 3, dt.NumRows())
+	assert.Equal(t, 4, dt.NumColumns())
+	assert.Equal(t, "c", dt.Column("Name").StringRow(2, 0))
+	assert.Equal(t, 6.0, dt.Column("Act").FloatRow(2, 1))
+	assert.True(t, math.IsNaN(dt.Column("Err").FloatRow(1, 0)))
+	assert.Equal(t, 1.5, dt.Column("Err").FloatRow(2, 0))
+
+	dt = New()
+	err = dt.ReadCSVSchema(strings.NewReader(csv), tensor.Tab, sc, SchemaCoerce)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, dt.Column("Err").FloatRow(2, 0))
+	assert.Equal(t, 0, dt.Column("Epoch").IntRow(1, 0))
+
+	// no headers: schema order
+	csv = "a\t1\t0.5\t1\t2\nb\t2\t0.25\t3\t4\n"
+	dt = New()
+	assert.NoError(t, dt.ReadCSVSchema(strings.NewReader(csv), tensor.Tab, sc, SchemaValidate))
+	assert.Equal(t, 2, dt.NumRows())
+	assert.Equal(t, 0.25, dt.Column("Err").FloatRow(1, 0))
+	assert.Equal(t, 4.0, dt.Column("Act").FloatRow(1, 1))
+
+	// typed headers with a mismatched type
+	src := newSchemaTable()
+	src.Column("Epoch").SetIntRow(5, 0, 0)
+	var b strings.Builder
+	assert.NoError(t, src.WriteCSV(&b, tensor.Tab, Headers))
+	sc.Column("Name").Type = "float64"
+	dt = New()
+	err = dt.ReadCSVSchema(strings.NewReader(b.String()), tensor.Tab, sc, SchemaValidate)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `column "Name": header type string does not match schema type float64`)
+	assert.Equal(t, 5, dt.Column("Epoch").IntRow(0, 0))
+}
+
+func TestOpenCSVSchema(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "log.tsv")
+	dt := newSchemaTable()
+	assert.NoError(t, dt.SaveCSV(fsx.Filename(fn), tensor.Tab, Headers))
+	sc := dt.Schema()
+	sc.Column("Epoch").Range.SetMax(0)
+	assert.NoError(t, sc.Save(fn+SchemaExt))
+
+	nt := New()
+	err := nt.OpenCSV(fsx.Filename(fn), tensor.Tab)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `row 1, column "Epoch": value 1 is above the maximum of 0`)
+	assert.Equal(t, "%", tensor.Units(nt.Columns.At("Err")))
+	assert.Equal(t, 2, nt.NumRows())
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// rowSet is a hash set of rows in a table, based on the values
+// of all the cells in a set of columns.
+type rowSet struct {
+	// cols are the columns used for hashing and comparing rows.
+	cols []*tensor.Rows
+
+	// rows has the list of rows (in the indexed view) for each hash.
+	rows map[uint64][]int
+}
+
+func newRowSet(cols []*tensor.Rows) *rowSet {
+	return &rowSet{cols: cols, rows: map[uint64][]int{}}
+}
+
+// hashRow returns the hash of all cells in given row of given columns,
+// where row is in the indexed view. Numeric values are hashed as float64,
+// so that equal values in columns of different numeric types match,
+// and all NaN values are equivalent, as are -0 and +0.
+func hashRow(cols []*tensor.Rows, row int) uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	for _, cl := range cols {
+		_, cells := cl.RowCellSize()
+		for c := range cells {
+			if cl.IsString() {
+				s := cl.StringRow(row, c)
+				binary.LittleEndian.PutUint64(b[:], uint64(len(s)))
+				h.Write(b[:])
+				h.Write([]byte(s))
+				continue
+			}
+			v := cl.FloatRow(row, c)
+			switch {
+			case math.IsNaN(v):
+				v = math.NaN()
+			case v == 0: // -0 == +0
+				v = 0
+			}
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+			h.Write(b[:])
+		}
+	}
+	return h.Sum64()
+}
+
+// rowsEqual returns true if all cells in row ra of columns ca are
+// the same as those in row rb of columns cb, with NaN equal to NaN.
+func rowsEqual(ca []*tensor.Rows, ra int, cb []*tensor.Rows, rb int) bool {
+	for i, cl := range ca {
+		ol := cb[i]
+		_, cells := cl.RowCellSize()
+		for c := range cells {
+			if cl.IsString() {
+				if cl.StringRow(ra, c) != ol.StringRow(rb, c) {
+					return false
+				}
+				continue
+			}
+			va, vb := cl.FloatRow(ra, c), ol.FloatRow(rb, c)
+			if va != vb && !(math.IsNaN(va) && math.IsNaN(vb)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// find returns the first row in the set that is equal to the given row
+// in given columns (which must match the set columns), or -1 if none.
+func (rs *rowSet) find(cols []*tensor.Rows, row int) int {
+	for _, sr := range rs.rows[hashRow(cols, row)] {
+		if rowsEqual(rs.cols, sr, cols, row) {
+			return sr
+		}
+	}
+	return -1
+}
+
+// add adds the given row of the set columns, returning false if an
+// equal row is already present, in which case it is not added.
+func (rs *rowSet) add(row int) bool {
+	h := hashRow(rs.cols, row)
+	for _, sr := range rs.rows[h] {
+		if rowsEqual(rs.cols, sr, rs.cols, row) {
+			return false
+		}
+	}
+	rs.rows[h] = append(rs.rows[h], row)
+	return true
+}
+
+// setColumns returns the columns of given names for the set operations,
+// or all columns if none are given, returning an error if not found.
+func (dt *Table) setColumns(columns ...string) ([]*tensor.Rows, error) {
+	if len(columns) == 0 {
+		columns = dt.Columns.Keys
+	}
+	cols := make([]*tensor.Rows, len(columns))
+	for i, nm := range columns {
+		cl, err := dt.ColumnTry(nm)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = cl
+	}
+	return cols, nil
+}
+
+// Duplicated returns a slice with a value for each row in the current
+// indexed view, which is true if the row is a duplicate of an earlier row,
+// in terms of the values of all cells in the given columns, or all
+// columns if none are specified. Returns an error if a column is not found.
+func (dt *Table) Duplicated(columns ...string) ([]bool, error) {
+	cols, err := dt.setColumns(columns...)
+	if err != nil {
+		return nil, err
+	}
+	rs := newRowSet(cols)
+	dups := make([]bool, dt.NumRows())
+	for r := range dups {
+		dups[r] = !rs.add(r)
+	}
+	return dups, nil
+}
+
+// DropDuplicates filters the indexes to exclude any rows that are duplicates
+// of an earlier row, in terms of the values of all cells in the given columns,
+// or all columns if none are specified. As with [Table.Filter], this only
+// affects the Indexes. Use [NewView] prior to calling to preserve the existing
+// view. Returns an error if a column is not found.
+func (dt *Table) DropDuplicates(columns ...string) error { //types:add
+	dups, err := dt.Duplicated(columns...)
+	if err != nil {
+		return err
+	}
+	dt.IndexesNeeded()
+	idxs := make([]int, 0, len(dt.Indexes))
+	for i, ix := range dt.Indexes {
+		if !dups[i] {
+			idxs = append(idxs, ix)
+		}
+	}
+	dt.Indexes = idxs
+	return nil
+}
+
+// setOpColumns returns the given columns for both tables, which must be
+// present in both tables with the same cell shape, and either string
+// or numeric in both.
+func setOpColumns(a, b *Table, columns ...string) (ca, cb []*tensor.Rows, err error) {
+	if len(columns) == 0 {
+		columns = a.Columns.Keys
+	}
+	ca, err = a.setColumns(columns...)
+	if err != nil {
+		return
+	}
+	cb, err = b.setColumns(columns...)
+	if err != nil {
+		return
+	}
+	for i, cl := range ca {
+		ol := cb[i]
+		if cl.IsString() != ol.IsString() || !slices.Equal(cl.ShapeSizes()[1:], ol.ShapeSizes()[1:]) {
+			err = fmt.Errorf("table: column %q is not compatible between tables", columns[i])
+			return
+		}
+	}
+	return
+}
+
+// filterSet returns a [NewView] of table a with the distinct rows
+// that are (or are not, if in is false) also present in table b.
+func filterSet(a, b *Table, in bool, columns ...string) (*Table, error) {
+	ca, cb, err := setOpColumns(a, b, columns...)
+	if err != nil {
+		return nil, err
+	}
+	bs := newRowSet(cb)
+	for r := range b.NumRows() {
+		bs.add(r)
+	}
+	as := newRowSet(ca)
+	vw := NewView(a)
+	vw.IndexesNeeded()
+	idxs := make([]int, 0, len(vw.Indexes))
+	for r, ix := range vw.Indexes {
+		if (bs.find(ca, r) >= 0) == in && as.add(r) {
+			idxs = append(idxs, ix)
+		}
+	}
+	vw.Indexes = idxs
+	return vw, nil
+}
+
+// Intersect returns a [NewView] of table a with the distinct rows that
+// are also present in table b, in terms of the values of all cells in the
+// given columns, or all columns of table a if none are specified.
+// The columns must be present in both tables with the same cell shapes.
+func Intersect(a, b *Table, columns ...string) (*Table, error) {
+	return filterSet(a, b, true, columns...)
+}
+
+// Except returns a [NewView] of table a with the distinct rows that
+// are not present in table b, in terms of the values of all cells in the
+// given columns, or all columns of table a if none are specified.
+// The columns must be present in both tables with the same cell shapes.
+func Except(a, b *Table, columns ...string) (*Table, error) {
+	return filterSet(a, b, false, columns...)
+}
+
+// Union returns a new table with the distinct rows from table a followed
+// by those from table b that are not present in table a, in terms of the
+// values of all cells in the given columns, or all columns of table a if
+// none are specified. The columns must be present in both tables with the
+// same cell shapes. Because the result combines rows from both tables, it is
+// a new table with the columns of table a, as in [Table.AppendRows],
+// instead of a view.
+func Union(a, b *Table, columns ...string) (*Table, error) {
+	if len(columns) == 0 {
+		columns = a.Columns.Keys
+	}
+	ex, err := Except(b, a, columns...)
+	if err != nil {
+		return nil, err
+	}
+	av := NewView(a)
+	if err := av.DropDuplicates(columns...); err != nil {
+		return nil, err
+	}
+	nt := av.New()
+	nt.AppendRows(ex.New())
+	return nt, nil
+}
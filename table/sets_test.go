@@ -0,0 +1,140 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package table
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSetsTable(names []string, vals []float64) *Table {
+	dt := New()
+	dt.AddStringColumn("Name")
+	dt.AddFloat64Column("Value")
+	dt.AddIntColumn("Act", 2)
+	dt.SetNumRows(len(names))
+	for i, nm := range names {
+		dt.Column("Name").SetStringRow(nm, i, 0)
+		dt.Column("Value").SetFloatRow(vals[i], i, 0)
+		dt.Column("Act").SetFloatRow(vals[i], i, 1)
+	}
+	return dt
+}
+
+func columnStrings(dt *Table, name string) []string {
+	cl := dt.Column(name)
+	vals := make([]string, cl.NumRows())
+	for i := range vals {
+		vals[i] = cl.StringRow(i, 0)
+	}
+	return vals
+}
+
+func TestDuplicated(t *testing.T) {
+	nan := math.NaN()
+	dt := newSetsTable([]string{"a", "b", "a", "c", "a", "c"}, []float64{1, 2, 1, nan, 3, nan})
+
+	dups, err := dt.Duplicated()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, false, true, false, false, true}, dups)
+
+	dups, err = dt.Duplicated("Name")
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, false, true, false, true, true}, dups)
+
+	_, err = dt.Duplicated("Nope")
+	assert.Error(t, err)
+
+	vw := NewView(dt)
+	assert.NoError(t, vw.DropDuplicates())
+	assert.Equal(t, []int{0, 1, 3, 4}, vw.Indexes)
+	assert.NoError(t, vw.DropDuplicates("Name"))
+	assert.Equal(t, []int{0, 1, 3}, vw.Indexes)
+	assert.Equal(t, 6, dt.NumRows())
+
+	// tensor cells are included
+	dt.Column("Act").SetFloatRow(5, 2, 1)
+	dups, err = dt.Duplicated()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, false, false, false, false, true}, dups)
+
+	// -0 and +0 are equal
+	dt = newSetsTable([]string{"a", "a"}, []float64{0, math.Copysign(0, -1)})
+	dups, err = dt.Duplicated()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, true}, dups)
+}
+
+func TestSetOps(t *testing.T) {
+	a := newSetsTable([]string{"a", "b", "b", "c"}, []float64{1, 2, 2, 3})
+	b := newSetsTable([]string{"c", "d", "b"}, []float64{3, 4, 5})
+
+	in, err := Intersect(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, in.Indexes)
+
+	in, err = Intersect(a, b, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, in.Indexes)
+
+	ex, err := Except(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, ex.Indexes)
+
+	ex, err = Except(a, b, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, ex.Indexes)
+
+	un, err := Union(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "b"}, columnStrings(un, "Name"))
+	assert.Equal(t, 5.0, un.Column("Act").FloatRow(4, 1))
+
+	un, err = Union(a, b, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, columnStrings(un, "Name"))
+
+	// all columns of table a are used by default, in the order of table b
+	d := New()
+	d.AddIntColumn("Act", 2)
+	d.AddStringColumn("Extra")
+	d.AddFloat64Column("Value")
+	d.AddStringColumn("Name")
+	d.SetNumRows(2)
+	d.Column("Name").SetStringRow("e", 0, 0)
+	d.Column("Name").SetStringRow("a", 1, 0)
+	d.Column("Value").SetFloatRow(math.Copysign(0, -1), 0, 0)
+	d.Column("Value").SetFloatRow(1, 1, 0)
+	d.Column("Act").SetFloatRow(1, 1, 1)
+	un, err = Union(a, d)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "e"}, columnStrings(un, "Name"))
+	e := newSetsTable([]string{"e"}, []float64{0})
+	in, err = Intersect(e, d)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, in.Indexes)
+
+	// numeric types are compared by value
+	c := New()
+	c.AddStringColumn("Name")
+	c.AddIntColumn("Value")
+	c.AddFloat32Column("Act", 2)
+	c.SetNumRows(1)
+	c.Column("Name").SetStringRow("a", 0, 0)
+	c.Column("Value").SetFloatRow(1, 0, 0)
+	c.Column("Act").SetFloatRow(1, 0, 1)
+	in, err = Intersect(a, c)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, in.Indexes)
+
+	c.DeleteColumnName("Act")
+	c.AddFloat32Column("Act", 3)
+	_, err = Intersect(a, c)
+	assert.Error(t, err)
+	_, err = Union(a, c, "Name", "Nope")
+	assert.Error(t, err)
+}
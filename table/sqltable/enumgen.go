@@ -0,0 +1,50 @@
+// Code generated by "core generate"; DO NOT EDIT.
+
+package sqltable
+
+import (
+	"cogentcore.org/core/enums"
+)
+
+var _CellModesValues = []CellModes{0, 1}
+
+// CellModesN is the highest valid value for type CellModes, plus one.
+const CellModesN CellModes = 2
+
+var _CellModesValueMap = map[string]CellModes{`Blobs`: 0, `Expand`: 1}
+
+var _CellModesDescMap = map[CellModes]string{0: `Blobs stores each tensor cell as a single BLOB value, using the [tensor.ToBinary] encoding that includes the type and cell shape, so that the column is restored with the same shape when read.`, 1: `Expand stores each value in the tensor cell as a separate column, named with the column name and the flat cell index, e.g., Act_0, Act_1, which allows individual values to be used in SQL queries. These are read back as separate scalar columns.`}
+
+var _CellModesMap = map[CellModes]string{0: `Blobs`, 1: `Expand`}
+
+// String returns the string representation of this CellModes value.
+func (i CellModes) String() string { return enums.String(i, _CellModesMap) }
+
+// SetString sets the CellModes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *CellModes) SetString(s string) error {
+	return enums.SetString(i, s, _CellModesValueMap, "CellModes")
+}
+
+// Int64 returns the CellModes value as an int64.
+func (i CellModes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the CellModes value from an int64.
+func (i *CellModes) SetInt64(in int64) { *i = CellModes(in) }
+
+// Desc returns the description of the CellModes value.
+func (i CellModes) Desc() string { return enums.Desc(i, _CellModesDescMap) }
+
+// CellModesValues returns all possible values for the type CellModes.
+func CellModesValues() []CellModes { return _CellModesValues }
+
+// Values returns all possible values for the type CellModes.
+func (i CellModes) Values() []enums.Enum { return enums.Values(_CellModesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i CellModes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *CellModes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "CellModes")
+}
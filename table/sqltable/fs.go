@@ -0,0 +1,139 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sqltable
+
+import (
+	"database/sql"
+	"path"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+)
+
+// FSTable is the name of the SQL table used by [WriteFS] and [ReadFS]
+// to store a [tensorfs] directory tree.
+const FSTable = "tensorfs"
+
+// WriteFS writes all of the nodes in the given [tensorfs] directory tree
+// to the [FSTable] SQL table in the given database, replacing any existing
+// contents. Each node is stored as a row with its Path relative to the
+// directory, and a Value with the [tensor.ToBinary] encoding of its tensor
+// (NULL for directories), so the entire tree can be restored with [ReadFS].
+// Values from mounted filesystems are loaded, and links to values are
+// stored as the value of their target, but links to directories are not
+// included, to avoid following circular links.
+// Use [WriteTable] with [tensorfs.DirTable] to write directories in a form
+// that is more directly suitable for SQL queries.
+func WriteFS(db *sql.DB, dir *tensorfs.Node) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	qname := QuoteName(FSTable)
+	if _, err := tx.Exec("DROP TABLE IF EXISTS " + qname); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.Exec("CREATE TABLE " + qname + " (Path TEXT PRIMARY KEY, Value BLOB)"); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	ins, err := tx.Prepare("INSERT INTO " + qname + " VALUES (?, ?)")
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if err := writeFSDir(ins, dir, ""); err != nil {
+		return errors.Join(err, ins.Close(), tx.Rollback())
+	}
+	if err := ins.Close(); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+func writeFSDir(ins *sql.Stmt, dir *tensorfs.Node, parPath string) error {
+	nds, err := dir.Nodes()
+	if err != nil {
+		return err
+	}
+	for _, nd := range nds {
+		fname := path.Join(parPath, nd.Name())
+		if nd.IsDir() {
+			if nd.IsLink() {
+				continue
+			}
+			if _, err := ins.Exec(fname, nil); err != nil {
+				return err
+			}
+			if err := writeFSDir(ins, nd, fname); err != nil {
+				return err
+			}
+			continue
+		}
+		tsr := nd.TensorValue()
+		if tsr == nil {
+			continue
+		}
+		if _, err := ins.Exec(fname, tensor.ToBinary(tsr.AsValues())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadFS reads the nodes stored by [WriteFS] in the given database
+// into the given [tensorfs] directory, replacing the values of any
+// existing nodes with the same paths.
+func ReadFS(db *sql.DB, dir *tensorfs.Node) error {
+	rows, err := db.Query("SELECT Path, Value FROM " + QuoteName(FSTable))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var errs []error
+	for rows.Next() {
+		var fname string
+		var b []byte
+		if err := rows.Scan(&fname, &b); err != nil {
+			return err
+		}
+		if b == nil {
+			dir.Dir(fname)
+			continue
+		}
+		tsr, err := fromBinary(b)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		dr, fn := path.Split(fname)
+		pdir := dir
+		if dr != "" {
+			pdir = dir.Dir(path.Dir(fname))
+		}
+		pdir.Set(fn, tsr)
+	}
+	errs = append(errs, rows.Err())
+	return errors.Join(errs...)
+}
+
+// SaveFS saves the given [tensorfs] directory tree into the SQLite
+// database file of given name, using [WriteFS].
+func SaveFS(dir *tensorfs.Node, filename string) error {
+	db, err := Open(filename)
+	if err != nil {
+		return err
+	}
+	return errors.Join(WriteFS(db, dir), db.Close())
+}
+
+// OpenFS opens the SQLite database file of given name, saved by [SaveFS],
+// into the given [tensorfs] directory, using [ReadFS].
+func OpenFS(dir *tensorfs.Node, filename string) error {
+	db, err := Open(filename)
+	if err != nil {
+		return err
+	}
+	return errors.Join(ReadFS(db, dir), db.Close())
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sqltable
+
+//go:generate core generate
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/core/base/reflectx"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	_ "modernc.org/sqlite" // pure Go sqlite driver
+)
+
+// DriverName is the name of the [database/sql] driver used by [Open],
+// which is a pure Go SQLite driver that does not require cgo.
+const DriverName = "sqlite"
+
+// CellModes are the ways of storing tensor cells of higher-dimensional
+// table columns in a SQL table.
+type CellModes int32 //enums:enum
+
+const (
+	// Blobs stores each tensor cell as a single BLOB value, using the
+	// [tensor.ToBinary] encoding that includes the type and cell shape,
+	// so that the column is restored with the same shape when read.
+	Blobs CellModes = iota
+
+	// Expand stores each value in the tensor cell as a separate column,
+	// named with the column name and the flat cell index, e.g., Act_0, Act_1,
+	// which allows individual values to be used in SQL queries.
+	// These are read back as separate scalar columns.
+	Expand
+)
+
+// Open opens the SQLite database file of given name, creating it
+// if it does not exist, using the pure Go [DriverName] driver.
+func Open(filename string) (*sql.DB, error) {
+	return sql.Open(DriverName, filename)
+}
+
+// QuoteName returns the given table or column name quoted
+// as a SQL identifier.
+func QuoteName(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// WriteTable writes the rows of the given table (in the current indexed view)
+// to a SQL table of given name in the given database, replacing any existing
+// table of that name. Higher-dimensional columns are stored according to the
+// given [CellModes]. The SQL column types are named according to the column
+// data types (e.g., INTEGER, REAL, FLOAT, TEXT), so that [ReadTable] restores
+// the same types, and missing (NaN) float values are stored as NULL.
+// All rows are written in a single transaction.
+func WriteTable(db *sql.DB, dt *table.Table, name string, mode CellModes) error {
+	var defs, names []string
+	for ci, cnm := range dt.Columns.Keys {
+		cl := dt.Columns.Values[ci]
+		_, cells := cl.Shape().RowCellSize()
+		if cl.NumDims() == 1 {
+			defs = append(defs, QuoteName(cnm)+" "+sqlType(cl.DataType()))
+			continue
+		}
+		if mode == Blobs {
+			defs = append(defs, QuoteName(cnm)+" BLOB")
+			continue
+		}
+		for c := range cells {
+			defs = append(defs, QuoteName(fmt.Sprintf("%s_%d", cnm, c))+" "+sqlType(cl.DataType()))
+		}
+	}
+	for range defs {
+		names = append(names, "?")
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	qname := QuoteName(name)
+	if _, err := tx.Exec("DROP TABLE IF EXISTS " + qname); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.Exec("CREATE TABLE " + qname + " (" + strings.Join(defs, ", ") + ")"); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	ins, err := tx.Prepare("INSERT INTO " + qname + " VALUES (" + strings.Join(names, ", ") + ")")
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	vals := make([]any, len(defs))
+	for r := range dt.NumRows() {
+		vals = vals[:0]
+		for ci := range dt.Columns.Keys {
+			cl := dt.ColumnByIndex(ci)
+			_, cells := cl.RowCellSize()
+			if cl.NumDims() > 1 && mode == Blobs {
+				vals = append(vals, tensor.ToBinary(cl.RowTensor(r)))
+				continue
+			}
+			for c := range cells {
+				vals = append(vals, sqlValue(cl, r, c))
+			}
+		}
+		if _, err := ins.Exec(vals...); err != nil {
+			return errors.Join(err, ins.Close(), tx.Rollback())
+		}
+	}
+	if err := ins.Close(); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// ReadTable reads the SQL table of given name from the given database
+// into a new [table.Table] with that name. See [Query] for details.
+func ReadTable(db *sql.DB, name string) (*table.Table, error) {
+	dt, err := Query(db, "SELECT * FROM "+QuoteName(name))
+	if dt != nil {
+		metadata.SetName(dt, name)
+	}
+	return dt, err
+}
+
+// Query runs the given SQL query in the given database, with optional
+// query arguments, and returns the result as a new [table.Table] with a
+// column for each result column. The column types are determined from the
+// declared SQL column types (as written by [WriteTable]) where available,
+// and otherwise from the returned values. BLOB values that were written
+// using the [Blobs] mode are restored as tensor cells, and NULL values
+// are NaN in float columns, and zero or empty otherwise.
+func Query(db *sql.DB, query string, args ...any) (*table.Table, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	nc := len(cts)
+	var data [][]any
+	for rows.Next() {
+		vals := make([]any, nc)
+		ptrs := make([]any, nc)
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		data = append(data, vals)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	dt := table.New()
+	dt.SetNumRows(len(data))
+	for ci, ct := range cts {
+		kind, cellSizes := columnKind(ct.DatabaseTypeName(), data, ci)
+		dt.AddColumnOfType(ct.Name(), kind, cellSizes...)
+		cl := dt.ColumnByIndex(ci)
+		for r, vals := range data {
+			setValue(cl, r, vals[ci])
+		}
+	}
+	return dt, nil
+}
+
+// sqlType returns the SQL column type name for given data type.
+// Names are chosen so that SQLite type affinity rules apply as expected,
+// while allowing the original data type to be restored by [kindFromSQL].
+func sqlType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "TEXT"
+	case reflect.Float64:
+		return "REAL"
+	case reflect.Float32:
+		return "FLOAT"
+	case reflect.Int, reflect.Int64:
+		return "INTEGER"
+	case reflect.Bool:
+		return "BOOLEAN"
+	}
+	return strings.ToUpper(kind.String())
+}
+
+// kindFromSQL returns the data type for given declared SQL column type,
+// and false if it is not a known type, in which case the type must be
+// determined from the values.
+func kindFromSQL(typ string) (reflect.Kind, bool) {
+	switch typ = strings.ToUpper(typ); typ {
+	case "":
+		return reflect.Invalid, false
+	case "BLOB":
+		return reflect.Slice, true
+	case "FLOAT":
+		return reflect.Float32, true
+	case "INT32":
+		return reflect.Int32, true
+	case "UINT8":
+		return reflect.Uint8, true
+	case "UINT32":
+		return reflect.Uint32, true
+	case "BOOL", "BOOLEAN":
+		return reflect.Bool, true
+	}
+	// SQLite type affinity rules
+	switch {
+	case strings.Contains(typ, "INT"):
+		return reflect.Int, true
+	case strings.Contains(typ, "CHAR"), strings.Contains(typ, "CLOB"), strings.Contains(typ, "TEXT"):
+		return reflect.String, true
+	}
+	return reflect.Float64, true
+}
+
+// columnKind returns the data type and cell sizes for the given column
+// of the data, based on the declared SQL type and the values.
+func columnKind(typ string, data [][]any, ci int) (reflect.Kind, []int) {
+	kind, ok := kindFromSQL(typ)
+	if ok && kind != reflect.Slice {
+		return kind, nil
+	}
+	for _, vals := range data {
+		switch v := vals[ci].(type) {
+		case nil:
+			continue
+		case []byte:
+			if cell, err := fromBinary(v); err == nil {
+				return cell.DataType(), cell.ShapeSizes()
+			}
+			return reflect.String, nil
+		case int64:
+			return reflect.Int, nil
+		case float64:
+			return reflect.Float64, nil
+		}
+		return reflect.String, nil
+	}
+	return reflect.Float64, nil
+}
+
+// sqlValue returns the value to write to the database for given
+// row and cell of given column.
+func sqlValue(cl *tensor.Rows, row, cell int) any {
+	switch {
+	case cl.IsString():
+		return cl.StringRow(row, cell)
+	case reflectx.KindIsFloat(cl.DataType()):
+		v := cl.FloatRow(row, cell)
+		if math.IsNaN(v) {
+			return nil
+		}
+		return v
+	}
+	return cl.IntRow(row, cell)
+}
+
+// setValue sets the given row of the given column from the given
+// value returned from the database.
+func setValue(cl *tensor.Rows, row int, val any) {
+	switch v := val.(type) {
+	case nil:
+		if reflectx.KindIsFloat(cl.DataType()) {
+			_, cells := cl.RowCellSize()
+			for c := range cells {
+				cl.SetFloatRow(math.NaN(), row, c)
+			}
+		}
+	case []byte:
+		if cl.NumDims() == 1 {
+			cl.SetStringRow(string(v), row, 0)
+			return
+		}
+		if cell, err := fromBinary(v); err == nil {
+			cl.RowTensor(row).CopyFrom(cell)
+		}
+	case string:
+		cl.SetStringRow(v, row, 0)
+	case int64:
+		cl.SetIntRow(int(v), row, 0)
+	case float64:
+		cl.SetFloatRow(v, row, 0)
+	default:
+		cl.SetStringRow(fmt.Sprint(v), row, 0)
+	}
+}
+
+// fromBinary returns the tensor encoded in given [tensor.ToBinary]
+// bytes, returning an error if the bytes are not a valid encoding.
+func fromBinary(b []byte) (tensor.Values, error) {
+	err := fmt.Errorf("sqltable: BLOB is not a valid tensor encoding")
+	hdr := func(i int) int {
+		var v int64
+		for j := range 8 {
+			v |= int64(b[i*8+j]) << (8 * j)
+		}
+		return int(v)
+	}
+	if len(b) < 16 {
+		return nil, err
+	}
+	kind, ndims := reflect.Kind(hdr(0)), hdr(1)
+	if ndims < 0 || ndims > 16 || len(b) < 8*(ndims+2) {
+		return nil, err
+	}
+	n := 1
+	for i := range ndims {
+		sz := hdr(i + 2)
+		if sz < 0 || sz > len(b) {
+			return nil, err
+		}
+		n *= sz
+	}
+	switch kind {
+	case reflect.String:
+	case reflect.Float64, reflect.Int, reflect.Int64, reflect.Uint64:
+		n *= 8
+	case reflect.Float32, reflect.Int32, reflect.Uint32:
+		n *= 4
+	case reflect.Int16, reflect.Uint16:
+		n *= 2
+	case reflect.Int8, reflect.Uint8, reflect.Bool:
+	default:
+		return nil, err
+	}
+	if kind != reflect.String && kind != reflect.Bool && len(b) != 8*(ndims+2)+n {
+		return nil, err
+	}
+	return tensor.FromBinary(b), nil
+}
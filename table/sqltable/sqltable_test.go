@@ -0,0 +1,113 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sqltable
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTable() *table.Table {
+	dt := table.New("Test")
+	dt.AddStringColumn("Name")
+	dt.AddIntColumn("Epoch")
+	dt.AddFloat32Column("Err")
+	dt.AddFloat64Column("Act", 2, 2)
+	dt.SetNumRows(3)
+	for r := range 3 {
+		dt.Column("Name").SetStringRow(string(rune('a'+r)), r, 0)
+		dt.Column("Epoch").SetIntRow(r*10, r, 0)
+		dt.Column("Err").SetFloatRow(float64(r)/4, r, 0)
+		for c := range 4 {
+			dt.Column("Act").SetFloatRow(float64(r*4+c), r, c)
+		}
+	}
+	dt.Column("Err").SetFloatRow(math.NaN(), 1, 0)
+	return dt
+}
+
+func TestTable(t *testing.T) {
+	db, err := Open(filepath.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dt := newTestTable()
+	assert.NoError(t, WriteTable(db, dt, "Test", Blobs))
+	rt, err := ReadTable(db, "Test")
+	assert.NoError(t, err)
+	assert.Equal(t, dt.Columns.Keys, rt.Columns.Keys)
+	assert.Equal(t, 3, rt.NumRows())
+	assert.Equal(t, dt.Column("Err").DataType(), rt.Column("Err").DataType())
+	assert.Equal(t, "b", rt.Column("Name").StringRow(1, 0))
+	assert.Equal(t, 20, rt.Column("Epoch").IntRow(2, 0))
+	assert.True(t, math.IsNaN(rt.Column("Err").FloatRow(1, 0)))
+	assert.Equal(t, 0.5, rt.Column("Err").FloatRow(2, 0))
+	assert.Equal(t, []int{3, 2, 2}, rt.Column("Act").ShapeSizes())
+	assert.Equal(t, 7.0, rt.Column("Act").FloatRow(1, 3))
+
+	// rewrite, with a filtered view
+	dt.Filter(func(dt *table.Table, row int) bool { return row != 0 })
+	assert.NoError(t, WriteTable(db, dt, "Test", Expand))
+	rt, err = ReadTable(db, "Test")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Name", "Epoch", "Err", "Act_0", "Act_1", "Act_2", "Act_3"}, rt.Columns.Keys)
+	assert.Equal(t, 2, rt.NumRows())
+	assert.Equal(t, 11.0, rt.Column("Act_3").FloatRow(1, 0))
+
+	qt, err := Query(db, `SELECT Name, Act_1 + Act_2 AS Sum, COUNT(*) OVER () AS N FROM Test WHERE Epoch > ?`, 15)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, qt.NumRows())
+	assert.Equal(t, "c", qt.Column("Name").StringRow(0, 0))
+	assert.Equal(t, 19.0, qt.Column("Sum").FloatRow(0, 0))
+	assert.Equal(t, 1, qt.Column("N").IntRow(0, 0))
+
+	_, err = ReadTable(db, "Nope")
+	assert.Error(t, err)
+}
+
+func TestFS(t *testing.T) {
+	dir, _ := tensorfs.NewDir("root")
+	tensorfs.DirFromTable(dir.Dir("Log"), newTestTable())
+	dir.Dir("Empty")
+	dir.Set("Names", tensor.NewStringFromValues("x", "y"))
+
+	fname := filepath.Join(t.TempDir(), "fs.db")
+	assert.NoError(t, SaveFS(dir, fname))
+
+	rd, _ := tensorfs.NewDir("root")
+	assert.NoError(t, OpenFS(rd, fname))
+	assert.Equal(t, dir.ListAll(), rd.ListAll())
+	act := rd.Dir("Log").Value("Act")
+	assert.Equal(t, []int{3, 2, 2}, act.ShapeSizes())
+	assert.Equal(t, 7.0, act.Float(1, 1, 1))
+	assert.Equal(t, "y", rd.Value("Names").String1D(1))
+
+	// mounted values are loaded, value links are stored as values,
+	// and directory links are not included
+	mdir := t.TempDir()
+	f, err := os.Create(filepath.Join(mdir, "data.npy"))
+	assert.NoError(t, err)
+	assert.NoError(t, tensor.WriteNPY(f, tensor.NewFloat64FromValues(1, 2, 3)))
+	assert.NoError(t, f.Close())
+	_, err = dir.MountDir("Mount", mdir)
+	assert.NoError(t, err)
+	_, err = dir.Link("NamesLink", "Names")
+	assert.NoError(t, err)
+	_, err = dir.Link("LogLink", "Log")
+	assert.NoError(t, err)
+	assert.NoError(t, SaveFS(dir, fname))
+	rd, _ = tensorfs.NewDir("root")
+	assert.NoError(t, OpenFS(rd, fname))
+	assert.Equal(t, []float64{1, 2, 3}, tensor.AsFloat64(rd.Dir("Mount").Value("data.npy")).Values)
+	assert.Equal(t, "y", rd.Value("NamesLink").String1D(1))
+	assert.Nil(t, rd.Node("LogLink"))
+}
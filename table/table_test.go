@@ -5,6 +5,8 @@
 package table
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -104,6 +106,24 @@ func TestSetNumRows(t *testing.T) {
 	assert.Equal(t, []int{0, 1, 2}, dt.Indexes)
 }
 
+func TestMmapColumn(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "acts.f32")
+	acts, err := tensor.NewMmap[float32](fname, 0, 4)
+	assert.NoError(t, err)
+	dt := New()
+	dt.AddIntColumn("Trial")
+	assert.NoError(t, dt.AddColumn("Acts", acts))
+	dt.SetNumRows(100)
+	assert.Equal(t, []int{100, 4}, acts.ShapeSizes())
+	acts.SetFloatRow(1, 99, 3)
+	dt.AddRows(1)
+	assert.Equal(t, 1.0, dt.Column("Acts").FloatRow(99, 3))
+	assert.NoError(t, acts.Close())
+	st, err := os.Stat(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101*4*4), st.Size())
+}
+
 func TestInsertDeleteRows(t *testing.T) {
 	dt := NewTestTable()
 	dt.IndexesNeeded()
@@ -41,34 +41,49 @@ func (dt *Table) InsertKeyColumns(args ...string) *Table {
 // ConfigFromTable configures the columns of this table according to the
 // values in the first two columns of given format table, conventionally named
 // Name, Type (but names are not used), which must be of the string type.
+// See [KindFromString] for the supported type strings.
 func (dt *Table) ConfigFromTable(ft *Table) error {
 	nmcol := ft.ColumnByIndex(0)
 	tycol := ft.ColumnByIndex(1)
 	var errs []error
 	for i := range ft.NumRows() {
 		name := nmcol.String1D(i)
-		typ := strings.ToLower(tycol.String1D(i))
-		kind := reflect.Float64
-		switch typ {
-		case "string":
-			kind = reflect.String
-		case "bool":
-			kind = reflect.Bool
-		case "float32":
-			kind = reflect.Float32
-		case "float64":
-			kind = reflect.Float64
-		case "int":
-			kind = reflect.Int
-		case "int32":
-			kind = reflect.Int32
-		case "byte", "uint8":
-			kind = reflect.Uint8
-		default:
-			err := fmt.Errorf("ConfigFromTable: type string %q not recognized", typ)
-			errs = append(errs, err)
+		kind, err := KindFromString(tycol.String1D(i))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ConfigFromTable: %w", err))
 		}
 		dt.AddColumnOfType(name, kind)
 	}
 	return errors.Join(errs...)
 }
+
+// KindFromString returns the [reflect.Kind] data type for given type string,
+// which is the lowercase Go type name: string, bool, float32, float64, int,
+// int32, int64, uint32, uint64, or byte (uint8), as returned by the String
+// method of the column DataType. Returns [reflect.Float64] and an error if
+// the type string is not recognized.
+func KindFromString(typ string) (reflect.Kind, error) {
+	switch strings.ToLower(strings.TrimSpace(typ)) {
+	case "string":
+		return reflect.String, nil
+	case "bool":
+		return reflect.Bool, nil
+	case "float32":
+		return reflect.Float32, nil
+	case "float64":
+		return reflect.Float64, nil
+	case "int":
+		return reflect.Int, nil
+	case "int32":
+		return reflect.Int32, nil
+	case "int64":
+		return reflect.Int64, nil
+	case "uint32":
+		return reflect.Uint32, nil
+	case "uint64":
+		return reflect.Uint64, nil
+	case "byte", "uint8":
+		return reflect.Uint8, nil
+	}
+	return reflect.Float64, fmt.Errorf("type string %q not recognized", typ)
+}
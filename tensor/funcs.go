@@ -245,7 +245,7 @@ func SetCalcFunc(tsr Tensor, fun func() error) {
 // given tensor. Returns an error if func not set, or any error from func itself.
 // Function is stored as CalcFunc in Metadata.
 func Calc(tsr Tensor) error {
-	fun, err := metadata.Get[func() error](*tsr.Metadata(), "CalcFunc")
+	fun, err := metadata.Get[func() error](tsr, "CalcFunc")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"unsafe"
+)
+
+// Hash is a content hash of a tensor, as returned by [ContentHash],
+// which is a SHA-256 digest.
+type Hash [sha256.Size]byte
+
+// String returns the hash as a hexadecimal string.
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// hashVersion is included in each hash, so that any change in the
+// encoding produces different hashes.
+const hashVersion = 1
+
+// ContentHash returns a stable hash of the content of the given tensor,
+// covering its data type, shape and values, which is the same for any two
+// tensors with equal content, regardless of how they were created, and on
+// any platform. It can be used to detect duplicate values, and as a key for
+// caching the results of expensive computations. Use [ContentHashMetadata]
+// to also include the metadata.
+func ContentHash(tsr Tensor) Hash {
+	h := sha256.New()
+	hashValues(h, tsr.AsValues())
+	return Hash(h.Sum(nil))
+}
+
+// ContentHashMetadata returns a stable hash of the content of the given
+// tensor as in [ContentHash], including its metadata, which is hashed by
+// the sorted keys and the formatted values. Values that are functions,
+// channels or pointers are skipped, as they do not have a stable format.
+func ContentHashMetadata(tsr Tensor) Hash {
+	h := sha256.New()
+	hashValues(h, tsr.AsValues())
+	md := *tsr.Metadata()
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		v := md[k]
+		switch reflect.ValueOf(v).Kind() {
+		case reflect.Func, reflect.Chan, reflect.Pointer, reflect.UnsafePointer:
+			continue
+		}
+		hashString(h, k)
+		hashString(h, fmt.Sprintf("%T", v))
+		hashString(h, fmt.Sprint(v))
+	}
+	return Hash(h.Sum(nil))
+}
+
+// hashValues writes the data type, shape and values of given tensor
+// to given hash, using a canonical little-endian encoding.
+func hashValues(h hash.Hash, tsr Values) {
+	var b []byte
+	b = binary.LittleEndian.AppendUint64(b, hashVersion)
+	typ := tsr.DataType()
+	if typ == reflect.Int {
+		typ = reflect.Int64 // same content as int64
+	}
+	b = append(b, typ.String()...)
+	b = binary.LittleEndian.AppendUint64(b, uint64(tsr.NumDims()))
+	for _, sz := range tsr.ShapeSizes() {
+		b = binary.LittleEndian.AppendUint64(b, uint64(sz))
+	}
+	h.Write(b)
+	n := tsr.Len()
+	if typ == reflect.String {
+		for i := range n {
+			hashString(h, tsr.String1D(i))
+		}
+		return
+	}
+	if native, ok := tsr.(interface{ Bytes() []byte }); ok && hashNativeOK(tsr) {
+		h.Write(native.Bytes())
+		return
+	}
+	b = b[:0]
+	for i := range n {
+		switch typ {
+		case reflect.Float64:
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(tsr.Float1D(i)))
+		case reflect.Float32:
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(tsr.Float1D(i))))
+		case reflect.Int64, reflect.Uint64:
+			b = binary.LittleEndian.AppendUint64(b, uint64(tsr.Int1D(i)))
+		case reflect.Int32, reflect.Uint32:
+			b = binary.LittleEndian.AppendUint32(b, uint32(tsr.Int1D(i)))
+		default: // byte, bool
+			b = append(b, byte(tsr.Int1D(i)))
+		}
+		if len(b) >= 4096 {
+			h.Write(b)
+			b = b[:0]
+		}
+	}
+	h.Write(b)
+}
+
+// hashNativeOK returns true if the native bytes of the given numerical
+// tensor are the same as the canonical little-endian encoding.
+func hashNativeOK(tsr Values) bool {
+	if binary.NativeEndian.Uint16([]byte{1, 0}) != 1 {
+		return false
+	}
+	switch tsr.DataType() {
+	case reflect.Bool:
+		return false
+	case reflect.Int:
+		return unsafe.Sizeof(int(0)) == 8
+	}
+	return true
+}
+
+// hashString writes the given string to given hash, preceded by its length.
+func hashString(h hash.Hash, s string) {
+	h.Write(binary.AppendUvarint(nil, uint64(len(s))))
+	h.Write([]byte(s))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"testing"
+
+	"cogentcore.org/core/base/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentHash(t *testing.T) {
+	a := NewFloat64FromValues(1, 2, 3, 4, 5, 6)
+	a.SetShapeSizes(2, 3)
+	b := NewFloat64(2, 3)
+	for i := range 6 {
+		b.SetFloat1D(float64(i+1), i)
+	}
+	assert.Equal(t, ContentHash(a), ContentHash(b))
+	assert.Len(t, ContentHash(a).String(), 64)
+
+	// a view has the same content as the values
+	assert.Equal(t, ContentHash(a), ContentHash(Reshape(a, 2, 3)))
+	sl := NewFloat64FromValues(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	sl.SetShapeSizes(3, 3)
+	assert.Equal(t, ContentHash(NewFloat64FromValues(4, 5, 6)), ContentHash(Reslice(sl, 1, FullAxis)))
+
+	// int and int64 have the same content
+	ni := NewIntFromValues(1, -2, 3)
+	n64 := NewNumber[int64](3)
+	for i, v := range []int64{1, -2, 3} {
+		n64.Values[i] = v
+	}
+	assert.Equal(t, ContentHash(ni), ContentHash(n64))
+
+	// shape, type and values are all covered
+	assert.NotEqual(t, ContentHash(a), ContentHash(Reshape(a, 3, 2)))
+	assert.NotEqual(t, ContentHash(a), ContentHash(NewFloat32FromValues(1, 2, 3, 4, 5, 6)))
+	b.SetFloat1D(7, 5)
+	assert.NotEqual(t, ContentHash(a), ContentHash(b))
+
+	s1 := NewStringFromValues("a", "bc")
+	s2 := NewStringFromValues("ab", "c")
+	assert.NotEqual(t, ContentHash(s1), ContentHash(s2))
+
+	bv := NewBool(3)
+	bv.SetBool1D(true, 1)
+	bv2 := NewBool(3)
+	assert.NotEqual(t, ContentHash(bv), ContentHash(bv2))
+	bv2.SetBool1D(true, 1)
+	assert.Equal(t, ContentHash(bv), ContentHash(bv2))
+
+	// metadata is only covered by ContentHashMetadata
+	c := a.Clone().(*Float64)
+	metadata.SetName(c, "c")
+	assert.Equal(t, ContentHash(a), ContentHash(c))
+	assert.NotEqual(t, ContentHashMetadata(a), ContentHashMetadata(c))
+	metadata.SetName(a, "c")
+	assert.Equal(t, ContentHashMetadata(a), ContentHashMetadata(c))
+	c.Metadata().Set("CalcFunc", func() error { return nil })
+	assert.Equal(t, ContentHashMetadata(a), ContentHashMetadata(c))
+}
@@ -64,6 +64,20 @@ func Precision(obj any) (int, error) {
 	return metadata.Get[int](obj, "Precision")
 }
 
+// SetUnits sets the "Units" metadata value that records the units
+// of measurement for the values (e.g., "ms"), for documentation,
+// table schemas, and plot labels.
+func SetUnits(obj any, units string) {
+	metadata.Set(obj, "Units", units)
+}
+
+// Units gets the "Units" metadata value that records the units
+// of measurement for the values. Returns "" if not set.
+func Units(obj any) string {
+	units, _ := metadata.Get[string](obj, "Units")
+	return units
+}
+
 // SaveCSV writes a tensor to a comma-separated-values (CSV) file
 // (where comma = any delimiter, specified in the delim arg).
 // Outer-most dims are rows in the file, and inner-most is column --
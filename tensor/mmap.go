@@ -0,0 +1,292 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/num"
+	"cogentcore.org/core/base/slicesx"
+)
+
+// Mmap is a [Number] tensor whose values are stored in a file that is
+// mapped into memory, instead of the Go heap, so that very large tensors
+// can be used without reading them into memory: the operating system
+// reads the data as it is accessed, and writes back changes.
+// The file has the raw values in native byte order, with no header.
+// Use [NewMmap] to open a file for reading and writing, and [OpenMmap]
+// to open one read-only. Mmap can be used as a [table.Table] column:
+// [Mmap.SetNumRows] and the other methods that change the size of the
+// tensor grow the file as needed. Growing may move the data to a new
+// memory location, so any views of the values (e.g., from SubSpace)
+// must be obtained again after that. Resizing through the embedded
+// [Number] or [Base] (e.g., tsr.Base.SetShapeSizes), or setting the Values
+// directly, can move the values into memory: they are then copied back
+// into the file by the next resize, [Mmap.Sync] or [Mmap.Close], so any
+// changes made in the meantime are not lost. On platforms without memory mapping,
+// the file is read into memory, and written back by [Mmap.Sync].
+type Mmap[T num.Number] struct {
+	Number[T]
+
+	// file is the open file, which is nil after Close.
+	file *os.File
+
+	// data is the mapped region of the file, which can extend beyond
+	// the values to allow for growth.
+	data []byte
+
+	// writable is whether the file was opened for writing.
+	writable bool
+}
+
+// NewMmap opens or creates the file at given path for reading and writing,
+// as a memory-mapped [Mmap] tensor with the given sizes per dimension (shape).
+// The file is resized to match the shape, preserving any existing values.
+// If no sizes are given, the tensor is 1D with the values in an existing
+// file. Changes to the values are written to the file, which is made
+// durable by [Mmap.Sync] or [Mmap.Close].
+func NewMmap[T num.Number](path string, sizes ...int) (*Mmap[T], error) {
+	return openMmap[T](path, true, sizes...)
+}
+
+// OpenMmap opens the file at given path read-only, as a memory-mapped
+// [Mmap] tensor with the given sizes per dimension (shape). The file must
+// have at least as many values as the shape. If no sizes are given, the
+// tensor is 1D with all the values in the file. Any changes to the values
+// are not written to the file, and growing the tensor beyond the size of
+// the file copies the values into memory.
+func OpenMmap[T num.Number](path string, sizes ...int) (*Mmap[T], error) {
+	return openMmap[T](path, false, sizes...)
+}
+
+func openMmap[T num.Number](path string, writable bool, sizes ...int) (*Mmap[T], error) {
+	flag := os.O_RDONLY
+	if writable {
+		flag = os.O_RDWR | os.O_CREATE
+	}
+	f, err := os.OpenFile(path, flag, 0666)
+	if err != nil {
+		return nil, err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	esz := mmapElemSize[T]()
+	tsr := &Mmap[T]{file: f, writable: writable}
+	if len(sizes) == 0 {
+		sizes = []int{int(st.Size()) / esz}
+	}
+	tsr.shape.SetShapeSizes(sizes...)
+	need := int64(tsr.Len() * esz)
+	switch {
+	case writable && st.Size() != need:
+		err = f.Truncate(need)
+	case !writable && st.Size() < need:
+		err = fmt.Errorf("tensor.OpenMmap: file %q has %d values, less than shape %v", path, st.Size()/int64(esz), sizes)
+	}
+	if err == nil && need > 0 {
+		tsr.data, err = mapFile(f, int(need), writable)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	tsr.setValues(tsr.Len())
+	return tsr, nil
+}
+
+// mmapElemSize returns the size in bytes of a value of type T.
+func mmapElemSize[T num.Number]() int {
+	var v T
+	return int(unsafe.Sizeof(v))
+}
+
+// setValues sets the Values to the first n values in the mapped data.
+func (tsr *Mmap[T]) setValues(n int) {
+	if n == 0 {
+		tsr.Values = nil
+		return
+	}
+	tsr.Values = unsafe.Slice((*T)(unsafe.Pointer(unsafe.SliceData(tsr.data))), n)
+}
+
+// detached returns whether the Values are no longer the mapped data,
+// as a result of being resized through the embedded [Number] or [Base]
+// or set directly.
+func (tsr *Mmap[T]) detached() bool {
+	return tsr.data != nil && len(tsr.Values) > 0 && unsafe.Pointer(unsafe.SliceData(tsr.Values)) != unsafe.Pointer(unsafe.SliceData(tsr.data))
+}
+
+// resize sets the number of values to n, growing the file and
+// remapping it as needed. Any detached values are copied back into
+// the mapped data.
+func (tsr *Mmap[T]) resize(n int) {
+	var vals []T
+	if tsr.detached() {
+		vals = tsr.Values
+	}
+	tsr.resizeData(n)
+	copy(tsr.Values, vals)
+}
+
+// resizeData sets the number of values to n for [Mmap.resize].
+func (tsr *Mmap[T]) resizeData(n int) {
+	esz := mmapElemSize[T]()
+	need := n * esz
+	switch {
+	case tsr.data == nil && tsr.file == nil: // closed or copied to memory
+		tsr.Values = slicesx.SetLength(tsr.Values, n)
+		return
+	case need <= len(tsr.data):
+		tsr.setValues(n)
+		return
+	case !tsr.writable:
+		tsr.toMemory(n)
+		return
+	}
+	// grow geometrically, so that adding rows one at a time is efficient;
+	// the file is truncated to the values by Close.
+	capacity := max(need, 2*len(tsr.data))
+	err := errors.Join(unmapFile(tsr.file, tsr.data, true), tsr.file.Truncate(int64(capacity)))
+	tsr.data = nil
+	if err == nil {
+		tsr.data, err = mapFile(tsr.file, capacity, true)
+	}
+	if errors.Log(err) != nil {
+		tsr.Values = nil
+		tsr.toMemory(n)
+		return
+	}
+	tsr.setValues(n)
+}
+
+// toMemory copies the values into memory with given length, and
+// releases the file, for growing a read-only tensor or after an error.
+func (tsr *Mmap[T]) toMemory(n int) {
+	vals := make([]T, n)
+	copy(vals, tsr.Values)
+	errors.Log(tsr.release())
+	tsr.Values = vals
+}
+
+// SetShapeSizes sets the dimension sizes of the tensor, and resizes
+// the file as needed, preserving existing values.
+func (tsr *Mmap[T]) SetShapeSizes(sizes ...int) {
+	tsr.shape.SetShapeSizes(sizes...)
+	tsr.resize(tsr.shape.Len())
+}
+
+// SetNumRows sets the number of rows (outermost dimension) in a
+// RowMajor organized tensor, and resizes the file as needed.
+func (tsr *Mmap[T]) SetNumRows(rows int) {
+	if tsr.NumDims() == 0 {
+		tsr.shape.SetShapeSizes(0)
+	}
+	_, cells := tsr.shape.RowCellSize()
+	tsr.shape.Sizes[0] = rows
+	tsr.resize(rows * cells)
+}
+
+// AppendFrom appends values from other tensor into this tensor,
+// which must have the same cell size as this tensor.
+func (tsr *Mmap[T]) AppendFrom(frm Values) Values {
+	rows, cell := tsr.shape.RowCellSize()
+	frows, fcell := frm.Shape().RowCellSize()
+	if cell != fcell {
+		errors.Log(fmt.Errorf("tensor.AppendFrom: cell sizes do not match: %d != %d", cell, fcell))
+		return tsr
+	}
+	tsr.SetNumRows(rows + frows)
+	tsr.CopyCellsFrom(frm, rows*cell, 0, frows*fcell)
+	return tsr
+}
+
+// AppendRow adds a row and sets values to given values.
+func (tsr *Mmap[T]) AppendRow(val Values) {
+	nrow := tsr.appendRow()
+	tsr.SetRowTensor(val, nrow)
+}
+
+// AppendRowFloat adds a row and sets float value(s), up to number of cells.
+func (tsr *Mmap[T]) AppendRowFloat(val ...float64) {
+	nrow := tsr.appendRow()
+	_, sz := tsr.shape.RowCellSize()
+	for i := range min(sz, len(val)) {
+		tsr.SetFloatRow(val[i], nrow, i)
+	}
+}
+
+// AppendRowInt adds a row and sets int value(s), up to number of cells.
+func (tsr *Mmap[T]) AppendRowInt(val ...int) {
+	nrow := tsr.appendRow()
+	_, sz := tsr.shape.RowCellSize()
+	for i := range min(sz, len(val)) {
+		tsr.SetIntRow(val[i], nrow, i)
+	}
+}
+
+// AppendRowString adds a row and sets string value(s), up to number of cells.
+func (tsr *Mmap[T]) AppendRowString(val ...string) {
+	nrow := tsr.appendRow()
+	_, sz := tsr.shape.RowCellSize()
+	for i := range min(sz, len(val)) {
+		tsr.SetStringRow(val[i], nrow, i)
+	}
+}
+
+// appendRow adds a row and returns its index.
+func (tsr *Mmap[T]) appendRow() int {
+	if tsr.NumDims() == 0 {
+		tsr.shape.SetShapeSizes(0)
+	}
+	nrow := tsr.DimSize(0)
+	tsr.SetNumRows(nrow + 1)
+	return nrow
+}
+
+// Sync writes any changes to the values to the file, and waits
+// until they are stored. It does nothing if the tensor is read-only.
+func (tsr *Mmap[T]) Sync() error {
+	if tsr.writable && tsr.detached() {
+		tsr.resize(tsr.Len())
+	}
+	if !tsr.writable || tsr.file == nil || tsr.data == nil {
+		return nil
+	}
+	return syncFile(tsr.file, tsr.data[:tsr.Len()*mmapElemSize[T]()])
+}
+
+// Close writes any changes to the file, truncates it to the size of the
+// values, and closes it. The file can be larger than the values before
+// it is closed, to allow for growth. The tensor must not be used after
+// it is closed.
+func (tsr *Mmap[T]) Close() error {
+	defer func() { tsr.Values = nil }()
+	if tsr.file == nil {
+		return nil
+	}
+	err := errors.Join(tsr.Sync(), unmapFile(tsr.file, tsr.data, tsr.writable))
+	if tsr.writable {
+		err = errors.Join(err, tsr.file.Truncate(int64(tsr.Len()*mmapElemSize[T]())))
+	}
+	err = errors.Join(err, tsr.file.Close())
+	tsr.file, tsr.data = nil, nil
+	return err
+}
+
+// release unmaps and closes the file.
+func (tsr *Mmap[T]) release() error {
+	if tsr.file == nil {
+		return nil
+	}
+	err := errors.Join(unmapFile(tsr.file, tsr.data, tsr.writable), tsr.file.Close())
+	tsr.file, tsr.data = nil, nil
+	return err
+}
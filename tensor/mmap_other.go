@@ -0,0 +1,39 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package tensor
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile reads the first size bytes of given file into memory,
+// on platforms without memory mapping support.
+func mapFile(f *os.File, size int, writable bool) ([]byte, error) {
+	b := make([]byte, size)
+	_, err := f.ReadAt(b, 0)
+	if err == io.EOF {
+		err = nil
+	}
+	return b, err
+}
+
+// unmapFile writes the data from [mapFile] back to the file if writable.
+func unmapFile(f *os.File, b []byte, writable bool) error {
+	if b == nil || !writable {
+		return nil
+	}
+	return syncFile(f, b)
+}
+
+// syncFile writes the given data from [mapFile] to the file.
+func syncFile(f *os.File, b []byte) error {
+	if _, err := f.WriteAt(b, 0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMmap(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "data.f32")
+	tsr, err := NewMmap[float32](fname, 3, 2)
+	assert.NoError(t, err)
+	for i := range tsr.Len() {
+		tsr.SetFloat1D(float64(i), i)
+	}
+	assert.NoError(t, tsr.Sync())
+	b, err := os.ReadFile(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, 24, len(b))
+
+	// growing adds zero rows, with geometric growth of the file
+	for range 10 {
+		tsr.AppendRowFloat(7, 8)
+	}
+	assert.Equal(t, []int{13, 2}, tsr.ShapeSizes())
+	assert.Equal(t, 5.0, tsr.Float(2, 1))
+	assert.Equal(t, 8.0, tsr.Float(12, 1))
+	tsr.SetNumRows(4)
+	row := NewFloat32(1, 2)
+	row.Set1D(2, 1)
+	tsr.AppendFrom(row)
+	assert.Equal(t, 2.0, tsr.Float(4, 1))
+	assert.NoError(t, tsr.Close())
+	st, err := os.Stat(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), st.Size())
+
+	ro, err := OpenMmap[float32](fname)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{10}, ro.ShapeSizes())
+	assert.Equal(t, 7.0, ro.Float1D(6))
+	ro.SetFloat1D(100, 0) // private, not written to file
+	ro.SetShapeSizes(5, 2)
+	ro.SetNumRows(8) // copied into memory
+	assert.Equal(t, 100.0, ro.Float1D(0))
+	assert.Equal(t, 0.0, ro.Float(7, 1))
+	assert.NoError(t, ro.Close())
+
+	rw, err := NewMmap[float32](fname, 5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, rw.Float1D(0))
+	assert.Equal(t, 2.0, rw.Float(4, 1))
+	assert.NoError(t, rw.Close())
+
+	_, err = OpenMmap[float32](fname, 100)
+	assert.Error(t, err)
+	_, err = OpenMmap[float32](filepath.Join(t.TempDir(), "none"))
+	assert.Error(t, err)
+
+	// resizing through the embedded Base moves the values into memory,
+	// and they are copied back into the file
+	rw, err = NewMmap[float32](fname, 5, 2)
+	assert.NoError(t, err)
+	rw.Base.SetShapeSizes(20, 2)
+	rw.SetFloat(9, 19, 1)
+	rw.Number.SetNumRows(21)
+	rw.SetFloat(3, 20, 0)
+	assert.Equal(t, 2.0, rw.Float(4, 1))
+	assert.NoError(t, rw.Sync())
+	rw.Values = append(rw.Values[:0:0], rw.Values...)
+	rw.SetFloat(11, 0, 0)
+	assert.NoError(t, rw.Close())
+	ro, err = OpenMmap[float32](fname)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, ro.Len())
+	assert.Equal(t, 11.0, ro.Float1D(0))
+	assert.Equal(t, 2.0, ro.Float1D(9))
+	assert.Equal(t, 9.0, ro.Float1D(39))
+	assert.Equal(t, 3.0, ro.Float1D(40))
+	assert.NoError(t, ro.Close())
+
+	empty, err := NewMmap[int](filepath.Join(t.TempDir(), "empty"), 0, 3)
+	assert.NoError(t, err)
+	empty.AppendRowInt(1, 2, 3)
+	assert.Equal(t, 3, empty.Int(0, 2))
+	assert.NoError(t, empty.Close())
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package tensor
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// mapFile maps the first size bytes of given file into memory. If
+// writable, the mapping is shared, so that changes are written to the
+// file, and otherwise it is a private copy-on-write mapping, so that
+// changes do not affect the file.
+func mapFile(f *os.File, size int, writable bool) ([]byte, error) {
+	flags := unix.MAP_PRIVATE
+	if writable {
+		flags = unix.MAP_SHARED
+	}
+	return unix.Mmap(int(f.Fd()), 0, size, unix.PROT_READ|unix.PROT_WRITE, flags)
+}
+
+// unmapFile releases the mapping from [mapFile].
+func unmapFile(f *os.File, b []byte, writable bool) error {
+	if b == nil {
+		return nil
+	}
+	return unix.Munmap(b)
+}
+
+// syncFile writes the changes in given mapped data to the file.
+func syncFile(f *os.File, b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	return unix.Msync(b, unix.MS_SYNC)
+}
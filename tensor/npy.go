@@ -0,0 +1,347 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// npyMagic is the magic string at the start of a NumPy .npy file.
+const npyMagic = "\x93NUMPY"
+
+var (
+	npyDescrRE   = regexp.MustCompile(`['"]descr['"]\s*:\s*['"]([^'"]*)['"]`)
+	npyFortranRE = regexp.MustCompile(`['"]fortran_order['"]\s*:\s*(True|False)`)
+	npyShapeRE   = regexp.MustCompile(`['"]shape['"]\s*:\s*\(([^)]*)\)`)
+)
+
+// ReadNPY reads a tensor from the given reader in the NumPy .npy format,
+// returning a new [Values] tensor of the corresponding type and shape.
+// Floating point (f4, f8), integer (i1-i8, u1-u8), bool (b1) and
+// string (S, U) data types are supported, in either byte order and in
+// Fortran or C order. Integer types that are not directly supported are
+// widened: i1 and i2 to int32, u2 to uint32, and i8 is read as int.
+// A scalar (0-dimensional) array is returned as a 1D tensor with 1 value.
+func ReadNPY(r io.Reader) (Values, error) {
+	var pre [8]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return nil, fmt.Errorf("tensor.ReadNPY: %w", err)
+	}
+	if string(pre[:6]) != npyMagic {
+		return nil, fmt.Errorf("tensor.ReadNPY: not a .npy file")
+	}
+	var hlen int
+	switch pre[6] {
+	case 1:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, fmt.Errorf("tensor.ReadNPY: %w", err)
+		}
+		hlen = int(binary.LittleEndian.Uint16(b[:]))
+	case 2, 3:
+		var b [4]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, fmt.Errorf("tensor.ReadNPY: %w", err)
+		}
+		hlen = int(binary.LittleEndian.Uint32(b[:]))
+	default:
+		return nil, fmt.Errorf("tensor.ReadNPY: unsupported version %d", pre[6])
+	}
+	hdr := make([]byte, hlen)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, fmt.Errorf("tensor.ReadNPY: %w", err)
+	}
+	descr, fortran, sizes, err := parseNPYHeader(string(hdr))
+	if err != nil {
+		return nil, err
+	}
+	order, kind, size, err := parseNPYDescr(descr)
+	if err != nil {
+		return nil, err
+	}
+	n := 1
+	for _, sz := range sizes {
+		n *= sz
+	}
+	data := make([]byte, n*size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("tensor.ReadNPY: %w", err)
+	}
+	if fortran && len(sizes) > 1 {
+		data = npyFromFortran(data, sizes, size)
+	}
+	if len(sizes) == 0 {
+		sizes = []int{1}
+	}
+	return npyValues(data, order, kind, size, sizes)
+}
+
+// parseNPYHeader parses the given .npy header dictionary.
+func parseNPYHeader(hdr string) (descr string, fortran bool, sizes []int, err error) {
+	dm := npyDescrRE.FindStringSubmatch(hdr)
+	fm := npyFortranRE.FindStringSubmatch(hdr)
+	sm := npyShapeRE.FindStringSubmatch(hdr)
+	if dm == nil || fm == nil || sm == nil {
+		return "", false, nil, fmt.Errorf("tensor.ReadNPY: invalid header: %q", hdr)
+	}
+	for _, f := range strings.Split(sm[1], ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		sz, err := strconv.Atoi(strings.TrimSuffix(f, "L"))
+		if err != nil || sz < 0 {
+			return "", false, nil, fmt.Errorf("tensor.ReadNPY: invalid shape: %q", sm[1])
+		}
+		sizes = append(sizes, sz)
+	}
+	return dm[1], fm[1] == "True", sizes, nil
+}
+
+// parseNPYDescr parses the given .npy data type description,
+// returning the byte order, the type kind character, and the
+// size of each value in bytes.
+func parseNPYDescr(descr string) (binary.ByteOrder, byte, int, error) {
+	if len(descr) < 2 {
+		return nil, 0, 0, fmt.Errorf("tensor.ReadNPY: invalid data type: %q", descr)
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	switch descr[0] {
+	case '>':
+		order = binary.BigEndian
+		descr = descr[1:]
+	case '<', '|', '=':
+		descr = descr[1:]
+	}
+	kind := descr[0]
+	size, err := strconv.Atoi(descr[1:])
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("tensor.ReadNPY: invalid data type: %q", descr)
+	}
+	ok := false
+	switch kind {
+	case 'f':
+		ok = size == 4 || size == 8
+	case 'i', 'u':
+		ok = size == 1 || size == 2 || size == 4 || size == 8
+	case 'b':
+		ok = size == 1
+	case 'S':
+		ok = true
+	case 'U':
+		ok = true
+		size *= 4
+	}
+	if !ok {
+		return nil, 0, 0, fmt.Errorf("tensor.ReadNPY: unsupported data type: %q", descr)
+	}
+	return order, kind, size, nil
+}
+
+// npyFromFortran returns the given data of values of given size in bytes
+// in Fortran (column-major) order, permuted into C (row-major) order.
+func npyFromFortran(data []byte, sizes []int, size int) []byte {
+	nd := len(sizes)
+	strides := make([]int, nd)
+	st := 1
+	for d := range nd {
+		strides[d] = st
+		st *= sizes[d]
+	}
+	out := make([]byte, len(data))
+	idx := make([]int, nd)
+	for ci := 0; ci < len(data)/size; ci++ {
+		fi := 0
+		for d := range nd {
+			fi += idx[d] * strides[d]
+		}
+		copy(out[ci*size:(ci+1)*size], data[fi*size:(fi+1)*size])
+		for d := nd - 1; d >= 0; d-- {
+			idx[d]++
+			if idx[d] < sizes[d] {
+				break
+			}
+			idx[d] = 0
+		}
+	}
+	return out
+}
+
+// npyValues returns a new tensor of given shape with the given
+// .npy data of given byte order, type kind, and size in bytes.
+func npyValues(data []byte, order binary.ByteOrder, kind byte, size int, sizes []int) (Values, error) {
+	var typ reflect.Kind
+	switch kind {
+	case 'f':
+		typ = reflect.Float64
+		if size == 4 {
+			typ = reflect.Float32
+		}
+	case 'i':
+		typ = reflect.Int32
+		if size == 8 {
+			typ = reflect.Int
+		}
+	case 'u':
+		switch size {
+		case 1:
+			typ = reflect.Uint8
+		case 8:
+			typ = reflect.Uint64
+		default:
+			typ = reflect.Uint32
+		}
+	case 'b':
+		typ = reflect.Bool
+	default:
+		typ = reflect.String
+	}
+	tsr := NewOfType(typ, sizes...)
+	for i := range tsr.Len() {
+		b := data[i*size : (i+1)*size]
+		switch kind {
+		case 'f':
+			if size == 4 {
+				tsr.SetFloat1D(float64(math.Float32frombits(order.Uint32(b))), i)
+			} else {
+				tsr.SetFloat1D(math.Float64frombits(order.Uint64(b)), i)
+			}
+		case 'i', 'u':
+			var v uint64
+			switch size {
+			case 1:
+				v = uint64(b[0])
+			case 2:
+				v = uint64(order.Uint16(b))
+			case 4:
+				v = uint64(order.Uint32(b))
+			default:
+				v = order.Uint64(b)
+			}
+			if kind == 'i' && size < 8 { // sign extend
+				shift := 64 - 8*size
+				v = uint64(int64(v<<shift) >> shift)
+			}
+			tsr.SetInt1D(int(v), i)
+		case 'b':
+			tsr.SetInt1D(int(b[0]), i)
+		case 'S':
+			tsr.SetString1D(string(bytes.TrimRight(b, "\x00")), i)
+		case 'U':
+			var s strings.Builder
+			for j := 0; j < size; j += 4 {
+				r := rune(order.Uint32(b[j:]))
+				if r == 0 {
+					break
+				}
+				s.WriteRune(r)
+			}
+			tsr.SetString1D(s.String(), i)
+		}
+	}
+	return tsr, nil
+}
+
+// WriteNPY writes the given tensor to the given writer in the NumPy
+// .npy format, in C order with little-endian byte order, so that it can
+// be read by numpy.load. Strings are written as fixed-width unicode (U),
+// and int as 64-bit integers (i8).
+func WriteNPY(w io.Writer, tsr Tensor) error {
+	vals, ok := tsr.(Values)
+	if !ok {
+		vals = Clone(tsr)
+	}
+	var descr string
+	var data any
+	switch v := vals.(type) {
+	case *Float64:
+		descr, data = "<f8", v.Values
+	case *Float32:
+		descr, data = "<f4", v.Values
+	case *Int:
+		ints := make([]int64, len(v.Values))
+		for i, x := range v.Values {
+			ints[i] = int64(x)
+		}
+		descr, data = "<i8", ints
+	case *Number[int64]:
+		descr, data = "<i8", v.Values
+	case *Number[uint64]:
+		descr, data = "<u8", v.Values
+	case *Int32:
+		descr, data = "<i4", v.Values
+	case *Uint32:
+		descr, data = "<u4", v.Values
+	case *Byte:
+		descr, data = "|u1", v.Values
+	case *Bool:
+		bs := make([]byte, v.Len())
+		for i := range bs {
+			if v.Bool1D(i) {
+				bs[i] = 1
+			}
+		}
+		descr, data = "|b1", bs
+	case *String:
+		n := 1
+		for _, s := range v.Values {
+			n = max(n, utf8.RuneCountInString(s))
+		}
+		rs := make([]uint32, n*len(v.Values))
+		for i, s := range v.Values {
+			j := i * n
+			for _, r := range s {
+				rs[j] = uint32(r)
+				j++
+			}
+		}
+		descr, data = fmt.Sprintf("<U%d", n), rs
+	default:
+		return fmt.Errorf("tensor.WriteNPY: unsupported data type: %v", vals.DataType())
+	}
+	var shape strings.Builder
+	for i, sz := range vals.ShapeSizes() {
+		if i > 0 {
+			shape.WriteString(", ")
+		}
+		shape.WriteString(strconv.Itoa(sz))
+	}
+	if vals.NumDims() == 1 {
+		shape.WriteString(",")
+	}
+	hdr := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shape.String())
+	bw := bufio.NewWriter(w)
+	ver, pre := byte(1), 10
+	if len(hdr)+pre+1 > math.MaxUint16 {
+		ver, pre = 2, 12
+	}
+	pad := 64 - (pre+len(hdr)+1)%64
+	if pad == 64 {
+		pad = 0
+	}
+	hdr += strings.Repeat(" ", pad) + "\n"
+	bw.WriteString(npyMagic)
+	bw.Write([]byte{ver, 0})
+	if ver == 1 {
+		binary.Write(bw, binary.LittleEndian, uint16(len(hdr)))
+	} else {
+		binary.Write(bw, binary.LittleEndian, uint32(len(hdr)))
+	}
+	bw.WriteString(hdr)
+	if err := binary.Write(bw, binary.LittleEndian, data); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNPY(t *testing.T) {
+	f64 := NewFloat64FromValues(1.5, -2, 3)
+	f64.SetShapeSizes(3, 1)
+	f32 := NewFloat32FromValues(1, 2, 3, 4, 5, 6)
+	f32.SetShapeSizes(2, 3)
+	tsrs := []Values{
+		f64, f32,
+		NewIntFromValues(-1, 2, 3),
+		NewBoolFromValues(true, false, true),
+		NewStringFromValues("a", "bcd", "éf"),
+		NewNumberFromValues[uint64](1, 1<<63),
+	}
+	for _, tsr := range tsrs {
+		var b bytes.Buffer
+		assert.NoError(t, WriteNPY(&b, tsr))
+		assert.Equal(t, 0, (10+int(binary.LittleEndian.Uint16(b.Bytes()[8:])))%64)
+		rt, err := ReadNPY(&b)
+		assert.NoError(t, err)
+		assert.Equal(t, tsr.ShapeSizes(), rt.ShapeSizes())
+		assert.Equal(t, tsr.DataType(), rt.DataType())
+		for i := range tsr.Len() {
+			assert.Equal(t, tsr.String1D(i), rt.String1D(i))
+		}
+	}
+}
+
+func TestNPYRead(t *testing.T) {
+	npy := func(hdr string, data ...any) []byte {
+		var b bytes.Buffer
+		b.WriteString(npyMagic + "\x01\x00")
+		binary.Write(&b, binary.LittleEndian, uint16(len(hdr)))
+		b.WriteString(hdr)
+		for _, d := range data {
+			binary.Write(&b, binary.BigEndian, d)
+		}
+		return b.Bytes()
+	}
+	rt, err := ReadNPY(bytes.NewReader(npy("{'descr': '>i2', 'fortran_order': True, 'shape': (2, 3), }\n", []int16{1, 4, 2, 5, 3, -6})))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, rt.ShapeSizes())
+	assert.Equal(t, []int32{1, 2, 3, 4, 5, -6}, rt.(*Int32).Values)
+
+	rt, err = ReadNPY(bytes.NewReader(npy("{'descr': '|S2', 'fortran_order': False, 'shape': (), }\n", []byte("a\x00"))))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", rt.String1D(0))
+
+	_, err = ReadNPY(bytes.NewReader(npy("{'descr': '<c16', 'fortran_order': False, 'shape': (1,), }\n")))
+	assert.Error(t, err)
+	_, err = ReadNPY(bytes.NewReader([]byte("not npy")))
+	assert.Error(t, err)
+}
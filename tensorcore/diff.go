@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorcore
+
+import (
+	"cogentcore.org/core/colors"
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/styles"
+	"cogentcore.org/lab/table"
+)
+
+// DiffStyler returns a [Table.CellStyler] function that highlights the
+// differences in the given [table.Diff] result table, for a view of the
+// given table dt, which is either table a (if isB is false) or table b
+// of the comparison. Removed rows in table a and added rows in table b are
+// highlighted with the error and success colors respectively, and changed
+// cells are highlighted with the warning color.
+func DiffStyler(diff, dt *table.Table, isB bool) func(s *styles.Style, row, col int) {
+	rowCol := "RowA"
+	rowKind := table.DiffRemoved.String()
+	if isB {
+		rowCol = "RowB"
+		rowKind = table.DiffAdded.String()
+	}
+	rows := map[int]bool{}
+	cells := map[[2]int]bool{}
+	kinds := diff.Column("Kind")
+	rcol := diff.Column(rowCol)
+	cols := diff.Column("Column")
+	for i := range diff.NumRows() {
+		row := rcol.IntRow(i, 0)
+		if row < 0 {
+			continue
+		}
+		switch kinds.StringRow(i, 0) {
+		case rowKind:
+			rows[row] = true
+		case table.DiffChanged.String():
+			if ci := dt.ColumnIndex(cols.StringRow(i, 0)); ci >= 0 {
+				cells[[2]int{row, ci}] = true
+			}
+		}
+	}
+	return func(s *styles.Style, row, col int) {
+		switch {
+		case cells[[2]int{row, col}]:
+			s.Background = colors.Scheme.Warn.Container
+			s.Color = colors.Scheme.Warn.OnContainer
+		case rows[row] && isB:
+			s.Background = colors.Scheme.Success.Container
+			s.Color = colors.Scheme.Success.OnContainer
+		case rows[row]:
+			s.Background = colors.Scheme.Error.Container
+			s.Color = colors.Scheme.Error.OnContainer
+		}
+	}
+}
+
+// DiffTables is a widget that displays the differences between two tables,
+// as computed by [table.Diff], with side-by-side [Table] views of the two
+// tables highlighting the differences (see [DiffStyler]), and a [Table]
+// view of the diff result table below them.
+type DiffTables struct {
+	core.Splits
+
+	// Diff is the result table from the last [DiffTables.SetTables] call.
+	Diff *table.Table `set:"-"`
+}
+
+func (dt *DiffTables) Init() {
+	dt.Splits.Init()
+	dt.SetTiles(core.TileSplit, core.TileSpan)
+	dt.TileSplits = []float32{.7, .3}
+	dt.Styler(func(s *styles.Style) {
+		s.Direction = styles.Column
+	})
+}
+
+// SetTables compares tables a and b using [table.Diff] with the given
+// key columns and numeric tolerance, and displays the results.
+// Returns any error from [table.Diff].
+func (dt *DiffTables) SetTables(a, b *table.Table, keyColumns []string, tolerance float64) error {
+	diff, err := table.Diff(a, b, keyColumns, tolerance)
+	if err != nil {
+		return err
+	}
+	dt.Diff = diff
+	if !dt.HasChildren() {
+		for range 3 {
+			NewTable(dt).SetReadOnly(true)
+		}
+	}
+	dt.Child(0).(*Table).SetCellStyler(DiffStyler(diff, a, false)).SetTable(a)
+	dt.Child(1).(*Table).SetCellStyler(DiffStyler(diff, b, true)).SetTable(b)
+	dt.Child(2).(*Table).SetTable(diff)
+	dt.Update()
+	return nil
+}
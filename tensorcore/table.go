@@ -45,6 +45,11 @@ type Table struct {
 	// ColumnGridStyle has per column grid display styles.
 	ColumnGridStyle map[int]*GridStyle `set:"-"`
 
+	// CellStyler is an optional function that applies additional styling
+	// to each cell, given the row in the indexed view of the Table and
+	// the column index (e.g., to highlight particular values).
+	CellStyler func(s *styles.Style, row, col int) `json:"-" xml:"-"`
+
 	// current sort index.
 	SortIndex int
 
@@ -353,6 +358,10 @@ func (tb *Table) MakeRow(p *tree.Plan, i int) {
 				w.SetProperty(core.ListColProperty, fli)
 				w.Styler(func(s *styles.Style) {
 					s.Grow.Set(0, 0)
+					if tb.CellStyler != nil {
+						si, _, _ := svi.SliceIndex(i)
+						tb.CellStyler(s, si, fli)
+					}
 				})
 				wb.Updater(func() {
 					si, vi, invis := svi.SliceIndex(i)
@@ -474,9 +483,13 @@ func (tb *Table) EditGlobalGridStyle() {
 	d.RunWindowDialog(tb)
 }
 
-func (tb *Table) HasStyler() bool { return false }
+func (tb *Table) HasStyler() bool { return tb.CellStyler != nil }
 
-func (tb *Table) StyleRow(w core.Widget, idx, fidx int) {}
+func (tb *Table) StyleRow(w core.Widget, idx, fidx int) {
+	if tb.CellStyler != nil {
+		tb.CellStyler(&w.AsWidget().Styles, idx, fidx)
+	}
+}
 
 // SortFieldName returns the name of the field being sorted, along with :up or
 // :down depending on descending
@@ -6,12 +6,22 @@ import (
 	"cogentcore.org/core/colors/colormap"
 	"cogentcore.org/core/core"
 	"cogentcore.org/core/math32/minmax"
+	"cogentcore.org/core/styles"
 	"cogentcore.org/core/tree"
 	"cogentcore.org/core/types"
 	"cogentcore.org/lab/table"
 	"cogentcore.org/lab/tensor"
 )
 
+var _ = types.AddType(&types.Type{Name: "cogentcore.org/lab/tensorcore.DiffTables", IDName: "diff-tables", Doc: "DiffTables is a widget that displays the differences between two tables,\nas computed by [table.Diff], with side-by-side [Table] views of the two\ntables highlighting the differences (see [DiffStyler]), and a [Table]\nview of the diff result table below them.", Embeds: []types.Field{{Name: "Splits"}}, Fields: []types.Field{{Name: "Diff", Doc: "Diff is the result table from the last [DiffTables.SetTables] call."}}})
+
+// NewDiffTables returns a new [DiffTables] with the given optional parent:
+// DiffTables is a widget that displays the differences between two tables,
+// as computed by [table.Diff], with side-by-side [Table] views of the two
+// tables highlighting the differences (see [DiffStyler]), and a [Table]
+// view of the diff result table below them.
+func NewDiffTables(parent ...tree.Node) *DiffTables { return tree.New[DiffTables](parent...) }
+
 var _ = types.AddType(&types.Type{Name: "cogentcore.org/lab/tensorcore.Layout", IDName: "layout", Doc: "Layout are layout options for displaying tensors.", Directives: []types.Directive{{Tool: "types", Directive: "add", Args: []string{"--setters"}}}, Fields: []types.Field{{Name: "OddRow", Doc: "OddRow means that even-numbered dimensions are displayed as Y*X rectangles.\nThis determines along which dimension to display any remaining\nodd dimension: OddRow = true = organize vertically along row\ndimension, false = organize horizontally across column dimension."}, {Name: "TopZero", Doc: "TopZero means that the Y=0 coordinate is displayed from the top-down;\notherwise the Y=0 coordinate is displayed from the bottom up,\nwhich is typical for emergent network patterns."}, {Name: "Image", Doc: "Image will display the data as a bitmap image. If a 2D tensor, then it will\nbe a greyscale image. If a 3D tensor with size of either the first\nor last dim = either 3 or 4, then it is a RGB(A) color image."}}})
 
 // SetOddRow sets the [Layout.OddRow]:
@@ -75,12 +85,21 @@ func (t *GridStyle) SetFontSize(v float32) *GridStyle { t.FontSize = v; return t
 // ColumnRotation is the rotation angle in degrees for column labels
 func (t *GridStyle) SetColumnRotation(v float32) *GridStyle { t.ColumnRotation = v; return t }
 
-var _ = types.AddType(&types.Type{Name: "cogentcore.org/lab/tensorcore.Table", IDName: "table", Doc: "Table provides a GUI widget for representing [table.Table] values.", Embeds: []types.Field{{Name: "ListBase"}}, Fields: []types.Field{{Name: "Table", Doc: "Table is the table that we're a view of."}, {Name: "GridStyle", Doc: "GridStyle has global grid display styles. GridStylers on the Table\nare applied to this on top of defaults."}, {Name: "ColumnGridStyle", Doc: "ColumnGridStyle has per column grid display styles."}, {Name: "SortIndex", Doc: "current sort index."}, {Name: "SortDescending", Doc: "whether current sort order is descending."}, {Name: "nCols", Doc: "number of columns in table (as of last update)."}, {Name: "headerWidths", Doc: "headerWidths has number of characters in each header, per visfields."}, {Name: "colMaxWidths", Doc: "colMaxWidths records maximum width in chars of string type fields."}, {Name: "blankString", Doc: "blank values for out-of-range rows."}, {Name: "blankFloat"}, {Name: "blankCells", Doc: "blankCells has per column blank tensor cells."}}})
+var _ = types.AddType(&types.Type{Name: "cogentcore.org/lab/tensorcore.Table", IDName: "table", Doc: "Table provides a GUI widget for representing [table.Table] values.", Embeds: []types.Field{{Name: "ListBase"}}, Fields: []types.Field{{Name: "Table", Doc: "Table is the table that we're a view of."}, {Name: "GridStyle", Doc: "GridStyle has global grid display styles. GridStylers on the Table\nare applied to this on top of defaults."}, {Name: "ColumnGridStyle", Doc: "ColumnGridStyle has per column grid display styles."}, {Name: "CellStyler", Doc: "CellStyler is an optional function that applies additional styling\nto each cell, given the row in the indexed view of the Table and\nthe column index (e.g., to highlight particular values)."}, {Name: "SortIndex", Doc: "current sort index."}, {Name: "SortDescending", Doc: "whether current sort order is descending."}, {Name: "nCols", Doc: "number of columns in table (as of last update)."}, {Name: "headerWidths", Doc: "headerWidths has number of characters in each header, per visfields."}, {Name: "colMaxWidths", Doc: "colMaxWidths records maximum width in chars of string type fields."}, {Name: "blankString", Doc: "blank values for out-of-range rows."}, {Name: "blankFloat"}, {Name: "blankCells", Doc: "blankCells has per column blank tensor cells."}}})
 
 // NewTable returns a new [Table] with the given optional parent:
 // Table provides a GUI widget for representing [table.Table] values.
 func NewTable(parent ...tree.Node) *Table { return tree.New[Table](parent...) }
 
+// SetCellStyler sets the [Table.CellStyler]:
+// CellStyler is an optional function that applies additional styling
+// to each cell, given the row in the indexed view of the Table and
+// the column index (e.g., to highlight particular values).
+func (t *Table) SetCellStyler(v func(s *styles.Style, row, col int)) *Table {
+	t.CellStyler = v
+	return t
+}
+
 // SetSortIndex sets the [Table.SortIndex]:
 // current sort index.
 func (t *Table) SetSortIndex(v int) *Table { t.SortIndex = v; return t }
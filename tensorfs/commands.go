@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/fs"
 	"path"
+	"slices"
 	"strings"
 
 	"cogentcore.org/core/base/errors"
@@ -73,6 +74,8 @@ func Mkdir(dir string) *Node {
 }
 
 // List lists files using arguments (options and path) from the current directory.
+// The path can be a [Node.Glob] pattern, in which case the paths of all the
+// matching nodes are listed.
 func List(opts ...string) error {
 	if CurDir == nil {
 		CurDir = CurRoot
@@ -91,13 +94,23 @@ func List(opts ...string) error {
 		opts = opts[1:]
 	}
 	dir := CurDir
-	if len(opts) > 0 {
+	var ls string
+	switch {
+	case len(opts) > 0 && isPattern(opts[0]):
+		nds, err := CurDir.Glob(opts[0])
+		if errors.Log(err) != nil {
+			return err
+		}
+		ls = CurDir.listPaths(nds, long)
+	case len(opts) > 0:
 		nd, err := CurDir.DirAtPath(opts[0])
 		if err == nil {
 			dir = nd
 		}
+		fallthrough
+	default:
+		ls = dir.List(long, recursive)
 	}
-	ls := dir.List(long, recursive)
 	if ListOutput != nil {
 		fmt.Fprintln(ListOutput, ls)
 	} else {
@@ -111,6 +124,10 @@ func List(opts ...string) error {
 // This is the direct pointer to the node, so changes
 // to it will change the node. Clone the tensor to make
 // a new copy disconnected from the original.
+// The path can be a [Node.Glob] pattern, in which case the values of
+// all the matching value nodes are returned as a new tensor, with an
+// outermost dimension for each value, which must all have the same
+// data type and shape, e.g., to get the same metric from multiple runs.
 func Get(name string) tensor.Tensor {
 	if CurDir == nil {
 		CurDir = CurRoot
@@ -120,6 +137,9 @@ func Get(name string) tensor.Tensor {
 		errors.Log(err)
 		return nil
 	}
+	if isPattern(name) {
+		return getPattern(name)
+	}
 	nd, err := CurDir.NodeAtPath(name)
 	if errors.Log(err) != nil {
 		return nil
@@ -129,7 +149,7 @@ func Get(name string) tensor.Tensor {
 		errors.Log(err)
 		return nil
 	}
-	return nd.Tensor
+	return nd.TensorValue()
 }
 
 // Set sets tensor to given name or path relative to the
@@ -150,7 +170,7 @@ func Set(name string, tsr tensor.Tensor) error {
 			err := &fs.PathError{Op: "Set", Path: name, Err: errors.New("existing node is a directory, not a data node")}
 			return errors.Log(err)
 		}
-		itm.Tensor = tsr
+		itm.setTensor(tsr)
 		return nil
 	}
 	cd := CurDir
@@ -173,3 +193,99 @@ func Set(name string, tsr tensor.Tensor) error {
 func SetCopy(name string, tsr tensor.Tensor) error {
 	return Set(name, tensor.Clone(tsr))
 }
+
+// TakeSnapshot makes a [Snapshot] of the current directory with given
+// name, using [Node.Snapshot].
+func TakeSnapshot(name string) error {
+	if CurDir == nil {
+		CurDir = CurRoot
+	}
+	if name == "" {
+		err := &fs.PathError{Op: "TakeSnapshot", Path: name, Err: errors.New("name must not be empty")}
+		return errors.Log(err)
+	}
+	CurDir.Snapshot(name)
+	return nil
+}
+
+// RestoreSnapshot restores the current directory to the [Snapshot] of
+// given name, using [Node.Restore].
+func RestoreSnapshot(name string) error {
+	if CurDir == nil {
+		CurDir = CurRoot
+	}
+	return errors.Log(CurDir.Restore(name))
+}
+
+// History lists the history of the node at the given path relative to the
+// current directory, or the current directory itself if no path is given.
+// For a directory, it lists the snapshots made by [Node.Snapshot], and
+// the changes since each of them, and for a value, it lists the previous
+// versions kept according to [Node.KeepVersions].
+func History(name ...string) error {
+	if CurDir == nil {
+		CurDir = CurRoot
+	}
+	nd := CurDir
+	if len(name) > 0 {
+		var err error
+		nd, err = CurDir.NodeAtPath(name[0])
+		if errors.Log(err) != nil {
+			return err
+		}
+	}
+	var b strings.Builder
+	const tfmt = "2006-01-02 15:04:05"
+	if nd.IsDir() {
+		for _, sn := range nd.Snapshots() {
+			chs, _ := nd.Diff(sn.Name)
+			fmt.Fprintf(&b, "%s\t%s\t%d changes\n", sn.Name, sn.Time.Format(tfmt), len(chs))
+			for _, ch := range chs {
+				fmt.Fprintf(&b, "\t%s\n", ch)
+			}
+		}
+	} else {
+		for i, v := range nd.Versions() {
+			fmt.Fprintf(&b, "%d\t%s\t%s\n", i, v.Time.Format(tfmt), v.Tensor.Label())
+		}
+	}
+	if ListOutput != nil {
+		fmt.Fprint(ListOutput, b.String())
+	} else {
+		fmt.Print(b.String())
+	}
+	return nil
+}
+
+// getPattern returns the values of all value nodes matching given
+// [Node.Glob] pattern, stacked along a new outermost dimension.
+func getPattern(pattern string) tensor.Tensor {
+	nds, err := CurDir.Glob(pattern)
+	if errors.Log(err) != nil {
+		return nil
+	}
+	var vals []tensor.Values
+	for _, nd := range nds {
+		if tsr := nd.TensorValue(); tsr != nil {
+			vals = append(vals, tsr.AsValues())
+		}
+	}
+	if len(vals) == 0 {
+		err := &fs.PathError{Op: "Get", Path: pattern, Err: errors.New("no values match pattern")}
+		errors.Log(err)
+		return nil
+	}
+	v0 := vals[0]
+	for _, v := range vals[1:] {
+		if v.DataType() != v0.DataType() || !slices.Equal(v.ShapeSizes(), v0.ShapeSizes()) {
+			err := &fs.PathError{Op: "Get", Path: pattern, Err: errors.New("values matching pattern do not all have the same data type and shape")}
+			errors.Log(err)
+			return nil
+		}
+	}
+	out := tensor.NewOfType(v0.DataType(), append([]int{len(vals)}, v0.ShapeSizes()...)...)
+	for i, v := range vals {
+		out.SetRowTensor(v, i)
+	}
+	return out
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"cogentcore.org/core/base/errors"
+)
+
+// compute is the computation of a computed value set by [Node.SetCompute].
+type compute struct {
+	// fun is the function that computes the value.
+	fun func() error
+
+	// inputs are the nodes that the value is computed from.
+	inputs []*Node
+
+	// revisions are the revisions of the inputs when the value
+	// was last computed, which is nil if it has not been computed.
+	revisions []uint64
+}
+
+// calcStates are the states of nodes during a computation.
+type calcStates int
+
+const (
+	calcVisiting calcStates = iota + 1
+	calcDone
+	calcFailed
+)
+
+// calcRun is the state of one run of computations by
+// [Node.Calc] or [Node.CalcAll].
+type calcRun struct {
+	// root is the root directory of the tree of the computation.
+	root *Node
+
+	// all is the directory for [Node.CalcAll], or nil for [Node.Calc].
+	all *Node
+
+	// states are the states of the nodes visited, which are used
+	// to detect cycles and to compute each value at most once.
+	states map[*Node]calcStates
+
+	// updated are the nodes that have been computed, which are sent
+	// [EventUpdated] events after all of the computation is done,
+	// so that the [Node.Watch] functions can call [Node.CalcAll].
+	updated []*Node
+}
+
+// covers returns true if this is a [Node.CalcAll] run that computed all
+// of the values in given directory, so that it is up to date.
+func (cr *calcRun) covers(dir *Node) bool {
+	if cr == nil || cr.all == nil {
+		return false
+	}
+	for cur := dir; cur != nil; cur = cur.ParentDir() {
+		if cur == cr.all {
+			return true
+		}
+	}
+	return false
+}
+
+// calcTree is the state of the computations of computed values
+// in a tree of nodes, which is stored on its root directory.
+type calcTree struct {
+	// mu serializes the computations in the tree,
+	// so that each is only computed once for a given set of inputs.
+	mu sync.Mutex
+
+	// evMu protects active and events.
+	evMu sync.Mutex
+
+	// active is true while a computation is running.
+	active bool
+
+	// events are the events for nodes in the tree sent while active,
+	// which are queued and then sent after mu is unlocked, so that the
+	// [Node.Watch] functions (e.g., [Node.AutoCalc]) can call [Node.Calc]
+	// or [Node.CalcAll] for events raised by the compute functions
+	// (e.g., replacing a value) without deadlocking.
+	events []Event
+}
+
+// root returns the root directory of the tree containing this node.
+func (nd *Node) root() *Node {
+	for p := nd.ParentDir(); p != nil; p = nd.ParentDir() {
+		nd = p
+	}
+	return nd
+}
+
+// calcTree returns the [calcTree] of the tree containing this node,
+// making it if it does not exist and create is true.
+func (nd *Node) calcTree(create bool) *calcTree {
+	root := nd.root()
+	root.mu.RLock()
+	ct := root.calcs
+	root.mu.RUnlock()
+	if ct != nil || !create {
+		return ct
+	}
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	if root.calcs == nil {
+		root.calcs = &calcTree{}
+	}
+	return root.calcs
+}
+
+// queueEvent queues the given event if a computation is running in
+// the tree containing its node, returning true if it was queued.
+func queueEvent(ev Event) bool {
+	ct := ev.Node.calcTree(false)
+	if ct == nil {
+		return false
+	}
+	ct.evMu.Lock()
+	defer ct.evMu.Unlock()
+	if !ct.active {
+		return false
+	}
+	ct.events = append(ct.events, ev)
+	return true
+}
+
+// runCalc runs the given computation function with a new calcRun in the
+// tree containing given node, for given [Node.CalcAll] directory, if any.
+// It then sends the events queued during the computation and the update
+// events for the computed nodes, which refer to the calcRun for the
+// computed nodes, so that [Node.AutoCalc] can skip them.
+func runCalc(nd, all *Node, fun func(cr *calcRun) error) error {
+	cr := &calcRun{root: nd.root(), all: all, states: map[*Node]calcStates{}}
+	ct := cr.root.calcTree(true)
+	ct.mu.Lock()
+	ct.evMu.Lock()
+	ct.active = true
+	ct.evMu.Unlock()
+	err := fun(cr)
+	ct.evMu.Lock()
+	ct.active = false
+	events := ct.events
+	ct.events = nil
+	ct.evMu.Unlock()
+	ct.mu.Unlock()
+	for _, ev := range events {
+		if slices.Contains(cr.updated, ev.Node.target()) {
+			ev.run = cr
+		}
+		ev.Node.sendEvent(ev)
+	}
+	for _, nd := range cr.updated {
+		nd.sendEvent(Event{Type: EventUpdated, Node: nd, run: cr})
+	}
+	return err
+}
+
+// Revision returns the revision of the value of this node, which is
+// incremented each time the value is replaced (e.g., by [Set]) or
+// updated with [Node.SendUpdate]. It is used to determine when computed
+// values set by [Node.SetCompute] need to be recomputed. Changes to the
+// tensor values that are not followed by a SendUpdate are not tracked.
+func (nd *Node) Revision() uint64 {
+	return nd.target().revision.Load()
+}
+
+// SetCompute makes this value node a computed value, whose value is
+// computed by the given function from the values of the given input nodes,
+// like a cell in a spreadsheet. The function should update the value
+// of this node, either in place or by replacing it. [Node.Calc] and
+// [Node.CalcAll] call the function only when the [Node.Revision] of any
+// of the inputs has changed since the last time it was called, after first
+// computing any inputs that are themselves computed, so that the values
+// are computed in dependency order. A value with no inputs is only computed
+// once. The inputs must be in the same tree as this node, with the same
+// root directory, so that the computations in each tree are independent.
+// It is an error if they are not, or if this would make a dependency cycle,
+// in which case the computation is not set. Use a nil function to remove it.
+// The function must not call Calc or CalcAll itself.
+func (nd *Node) SetCompute(fun func() error, inputs ...*Node) error {
+	nd = nd.target()
+	if nd.IsDir() {
+		return fmt.Errorf("tensorfs SetCompute: %q is a directory", nd.Path())
+	}
+	if fun == nil {
+		nd.mu.Lock()
+		nd.compute = nil
+		nd.mu.Unlock()
+		return nil
+	}
+	root := nd.root()
+	for _, in := range inputs {
+		if in.target().root() != root {
+			return fmt.Errorf("tensorfs SetCompute: %q: input %q is not in the same tree", nd.Path(), in.Path())
+		}
+		if in.target() == nd || in.dependsOn(nd, map[*Node]bool{}) {
+			return fmt.Errorf("tensorfs SetCompute: %q: dependency cycle through input %q", nd.Path(), in.Path())
+		}
+	}
+	nd.mu.Lock()
+	nd.compute = &compute{fun: fun, inputs: slices.Clone(inputs)}
+	nd.mu.Unlock()
+	return nil
+}
+
+// Inputs returns the input nodes of a computed value
+// set by [Node.SetCompute], or nil if it is not computed.
+func (nd *Node) Inputs() []*Node {
+	cp := nd.target().getCompute()
+	if cp == nil {
+		return nil
+	}
+	return slices.Clone(cp.inputs)
+}
+
+// IsComputed returns true if this is a computed value
+// set by [Node.SetCompute].
+func (nd *Node) IsComputed() bool {
+	return nd.target().getCompute() != nil
+}
+
+// Calc computes this computed value set by [Node.SetCompute] if any of
+// its inputs have changed, after first computing any of its inputs that
+// are computed and have changed inputs. It returns any errors from
+// the compute functions, in which case the values that depend on those
+// that failed are not computed. It does nothing if this is not computed.
+// Computations in the same tree are run one at a time.
+func (nd *Node) Calc() error {
+	return runCalc(nd.target(), nil, nd.calc)
+}
+
+// calc computes this value if needed, as part of given run.
+func (nd *Node) calc(cr *calcRun) error {
+	nd = nd.target()
+	switch cr.states[nd] {
+	case calcVisiting:
+		return fmt.Errorf("tensorfs Calc: %q: dependency cycle", nd.Path())
+	case calcDone, calcFailed:
+		return nil
+	}
+	cp := nd.getCompute()
+	if cp == nil {
+		cr.states[nd] = calcDone
+		return nil
+	}
+	cr.states[nd] = calcVisiting
+	var errs []error
+	failed := false
+	for _, in := range cp.inputs {
+		if in.target().root() != cr.root {
+			cr.states[nd] = calcFailed
+			return fmt.Errorf("tensorfs Calc: %q: input %q is not in the same tree", nd.Path(), in.Path())
+		}
+		err := in.calc(cr)
+		errs = append(errs, err)
+		failed = failed || err != nil || cr.states[in.target()] == calcFailed
+	}
+	if failed {
+		cr.states[nd] = calcFailed
+		return errors.Join(errs...)
+	}
+	cr.states[nd] = calcDone
+	revs := make([]uint64, len(cp.inputs))
+	for i, in := range cp.inputs {
+		revs[i] = in.Revision()
+	}
+	if cp.revisions != nil && slices.Equal(revs, cp.revisions) {
+		return nil
+	}
+	if err := cp.fun(); err != nil {
+		cr.states[nd] = calcFailed
+		return fmt.Errorf("tensorfs Calc: %q: %w", nd.Path(), err)
+	}
+	cp.revisions = revs
+	nd.revision.Add(1)
+	cr.updated = append(cr.updated, nd)
+	return nil
+}
+
+// AutoCalc makes the computed values in this directory and all of its
+// subdirectories update automatically, by calling [Node.CalcAll] whenever
+// a value within it is replaced or updated (see [Node.SendUpdate]),
+// so that it behaves like a spreadsheet. Changes made while CalcAll is
+// running result in another call after it is done, except for the changes
+// to the computed values made by a CalcAll of this directory or one
+// containing it, which are already up to date. It returns a function
+// that stops the automatic updates.
+func (d *Node) AutoCalc() (cancel func()) {
+	var running, pending atomic.Bool
+	return d.Watch(func(ev Event) {
+		if ev.Type != EventUpdated && ev.Type != EventReplaced {
+			return
+		}
+		if ev.run.covers(d) {
+			return
+		}
+		pending.Store(true)
+		for running.CompareAndSwap(false, true) {
+			for pending.Swap(false) {
+				errors.Log(d.CalcAll())
+			}
+			running.Store(false)
+			if !pending.Load() {
+				return
+			}
+		}
+	})
+}
+
+// getCompute returns the compute for this node, under the lock.
+func (nd *Node) getCompute() *compute {
+	nd.mu.RLock()
+	defer nd.mu.RUnlock()
+	return nd.compute
+}
+
+// dependsOn returns true if this node is computed from the given node,
+// directly or indirectly, using given map of nodes already checked.
+func (nd *Node) dependsOn(other *Node, checked map[*Node]bool) bool {
+	nd = nd.target()
+	if checked[nd] {
+		return false
+	}
+	checked[nd] = true
+	cp := nd.getCompute()
+	if cp == nil {
+		return false
+	}
+	for _, in := range cp.inputs {
+		if in.target() == other || in.dependsOn(other, checked) {
+			return true
+		}
+	}
+	return false
+}
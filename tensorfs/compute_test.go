@@ -0,0 +1,177 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	a := dir.Float64("a", 1)
+	b := dir.Float64("b", 1)
+	c := dir.Dir("sub").Float64("c", 1)
+	an, bn, cn := dir.Node("a"), dir.Node("b"), dir.Node("sub").Node("c")
+	calls := map[string]int{}
+	// defined in reverse order, to check dependency order
+	assert.NoError(t, cn.SetCompute(func() error {
+		calls["c"]++
+		c.Set1D(a.Float1D(0)+b.Float1D(0), 0)
+		return nil
+	}, an, bn))
+	assert.NoError(t, bn.SetCompute(func() error {
+		calls["b"]++
+		b.Set1D(2*a.Float1D(0), 0)
+		return nil
+	}, an))
+	assert.True(t, cn.IsComputed())
+	assert.Equal(t, []*Node{an, bn}, cn.Inputs())
+
+	a.Set1D(1, 0)
+	assert.NoError(t, cn.Calc())
+	assert.Equal(t, 3.0, c.Float1D(0))
+	assert.Equal(t, map[string]int{"b": 1, "c": 1}, calls)
+
+	assert.NoError(t, dir.CalcAll())
+	assert.Equal(t, map[string]int{"b": 1, "c": 1}, calls)
+
+	// in-place changes are only tracked with SendUpdate
+	a.Set1D(2, 0)
+	assert.NoError(t, dir.CalcAll())
+	assert.Equal(t, 3.0, c.Float1D(0))
+	rev := an.Revision()
+	an.SendUpdate()
+	assert.Equal(t, rev+1, an.Revision())
+	assert.NoError(t, dir.CalcAll())
+	assert.Equal(t, 6.0, c.Float1D(0))
+	assert.Equal(t, map[string]int{"b": 2, "c": 2}, calls)
+
+	// cycles
+	assert.Error(t, an.SetCompute(func() error { return nil }, cn))
+	assert.Error(t, an.SetCompute(func() error { return nil }, an))
+	assert.False(t, an.IsComputed())
+
+	// errors stop dependent computations
+	assert.NoError(t, bn.SetCompute(func() error { return errors.New("bad") }, an))
+	an.SendUpdate()
+	assert.Error(t, dir.CalcAll())
+	assert.Equal(t, 2, calls["c"])
+	assert.NoError(t, bn.SetCompute(nil))
+	assert.False(t, bn.IsComputed())
+}
+
+func TestAutoCalc(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	a := dir.Float64("a", 1)
+	an := dir.Node("a")
+	dir.Float64("sq", 1)
+	sqn := dir.Node("sq")
+	assert.NoError(t, sqn.SetCompute(func() error {
+		// replacing the value sends an event during the computation
+		SetTensor(dir, tensor.NewFloat64Scalar(a.Float1D(0)*a.Float1D(0)), "sq")
+		return nil
+	}, an))
+	n := 0
+	tsr := dir.Float64("legacy", 1)
+	tensor.SetCalcFunc(tsr, func() error { n++; return nil })
+
+	cancel := dir.AutoCalc()
+	a.Set1D(3, 0)
+	an.SendUpdate()
+	assert.Equal(t, 9.0, dir.Value("sq").Float1D(0))
+	// the events for the computed value do not cause another CalcAll
+	assert.Equal(t, 1, n)
+	cancel()
+	a.Set1D(4, 0)
+	an.SendUpdate()
+	assert.Equal(t, 9.0, dir.Value("sq").Float1D(0))
+}
+
+func TestAutoCalcReplace(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	a := dir.Float64("a", 1)
+	a.Set1D(2, 0)
+	an := dir.Node("a")
+	dir.Float64("out", 1)
+	out := dir.Node("out")
+	assert.NoError(t, out.SetCompute(func() error {
+		SetTensor(dir, tensor.NewFloat64Scalar(10*a.Float1D(0)), "out")
+		return nil
+	}, an))
+	cancel := dir.AutoCalc()
+	defer cancel()
+
+	// calling Calc directly while AutoCalc receives the replace event
+	// from within the computation must not deadlock
+	done := make(chan error)
+	go func() { done <- out.Calc() }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Calc deadlocked")
+	}
+	assert.Equal(t, 20.0, dir.Value("out").Float1D(0))
+
+	a.Set1D(3, 0)
+	an.SendUpdate()
+	assert.Equal(t, 30.0, dir.Value("out").Float1D(0))
+}
+
+func TestCalcTrees(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	dir.Float64("a", 1)
+	an := dir.Node("a")
+	dir.Float64("out", 1)
+	out := dir.Node("out")
+	started, release := make(chan bool), make(chan bool)
+	assert.NoError(t, out.SetCompute(func() error {
+		started <- true
+		<-release
+		return nil
+	}, an))
+
+	other, err := NewDir("other")
+	assert.NoError(t, err)
+	b := other.Float64("b", 1)
+	other.Float64("sum", 1)
+	sn := other.Node("sum")
+	assert.NoError(t, sn.SetCompute(func() error {
+		SetTensor(other, tensor.NewFloat64Scalar(b.Float1D(0)+1), "sum")
+		return nil
+	}, other.Node("b")))
+	assert.Error(t, sn.SetCompute(func() error { return nil }, an))
+	var events []Event
+	other.Watch(func(ev Event) { events = append(events, ev) })
+
+	done := make(chan error)
+	go func() { done <- out.Calc() }()
+	<-started
+	// a computation in another tree is not blocked, and its
+	// events are sent in the goroutine that made the change
+	calced := make(chan error)
+	go func() { calced <- sn.Calc() }()
+	select {
+	case err := <-calced:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Calc in another tree blocked")
+	}
+	assert.Equal(t, 1.0, other.Value("sum").Float1D(0))
+	events = nil
+	other.Set("b", tensor.NewFloat64Scalar(2))
+	assert.Equal(t, 1, len(events))
+	release <- true
+	assert.NoError(t, <-done)
+}
@@ -20,18 +20,24 @@ const (
 	Overwrite = true
 )
 
-// CopyFromValue copies value from given source node, cloning it.
+// CopyFromValue copies value from given source node, cloning it,
+// and sends an [EventReplaced] event.
 func (d *Node) CopyFromValue(frd *Node) {
+	d.mu.Lock()
 	d.modTime = time.Now()
-	d.Tensor = tensor.Clone(frd.Tensor)
+	d.mu.Unlock()
+	d.setTensor(tensor.Clone(frd.TensorValue()))
 }
 
 // Clone returns a copy of this node, recursively cloning directory nodes
-// if it is a directory.
+// if it is a directory. A link node is copied as a link to the same target.
 func (nd *Node) Clone() *Node {
+	if nd.IsLink() {
+		cp, _ := newNode(nil, nd.name, func(cp *Node) { cp.link = nd.link })
+		return cp
+	}
 	if !nd.IsDir() {
-		cp, _ := newNode(nil, nd.name)
-		cp.Tensor = tensor.Clone(nd.Tensor)
+		cp, _ := newNode(nil, nd.name, func(cp *Node) { cp.Tensor = tensor.Clone(nd.TensorValue()) })
 		return cp
 	}
 	nodes, _ := nd.Nodes()
@@ -75,7 +81,7 @@ func (dir *Node) Copy(overwrite bool, to string, from ...string) error {
 			continue
 		}
 		if targf == "" {
-			if trg, ok := targd.nodes.AtTry(frd.name); ok { // target exists
+			if trg, ok := targd.nodeAt(frd.name); ok { // target exists
 				switch {
 				case trg.IsDir() && frd.IsDir():
 					// todo: copy all nodes from frd into trg
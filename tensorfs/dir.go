@@ -38,11 +38,7 @@ func NewDir(name string, parent ...*Node) (*Node, error) {
 	if len(parent) == 1 {
 		par = parent[0]
 	}
-	dir, err := newNode(par, name)
-	if dir != nil && dir.nodes == nil {
-		dir.nodes = &Nodes{}
-	}
-	return dir, err
+	return newNode(par, name, func(nd *Node) { nd.nodes = &Nodes{} })
 }
 
 // Dir creates a new directory under given dir with the specified name
@@ -57,7 +53,7 @@ func (dir *Node) Dir(name string) *Node {
 		return dir
 	}
 	path := strings.Split(name, "/")
-	if cd := dir.nodes.At(path[0]); cd != nil {
+	if cd, ok := dir.nodeAt(path[0]); ok {
 		if len(path) > 1 {
 			return cd.Dir(strings.Join(path[1:], "/"))
 		}
@@ -75,7 +71,8 @@ func (dir *Node) Dir(name string) *Node {
 // nodes, and it will panic if this node is not a directory.
 // Returns nil if no node of given name exists.
 func (dir *Node) Node(name string) *Node {
-	return dir.nodes.At(name)
+	nd, _ := dir.nodeAt(name)
+	return nd
 }
 
 // Value returns the [tensor.Tensor] value for given node
@@ -83,7 +80,7 @@ func (dir *Node) Node(name string) *Node {
 // found, and will return nil if it is not a Value
 // (i.e., it is a directory).
 func (dir *Node) Value(name string) tensor.Tensor {
-	return dir.nodes.At(name).Tensor
+	return dir.Node(name).TensorValue()
 }
 
 // Nodes returns a slice of Nodes in given directory by names variadic list.
@@ -93,16 +90,13 @@ func (dir *Node) Nodes(names ...string) ([]*Node, error) {
 	if err := dir.mustDir("Nodes", ""); err != nil {
 		return nil, err
 	}
-	var nds []*Node
 	if len(names) == 0 {
-		for _, it := range dir.nodes.Values {
-			nds = append(nds, it)
-		}
-		return nds, nil
+		return dir.nodeList(), nil
 	}
+	var nds []*Node
 	var errs []error
 	for _, nm := range names {
-		dt := dir.nodes.At(nm)
+		dt := dir.Node(nm)
 		if dt != nil {
 			nds = append(nds, dt)
 		} else {
@@ -123,18 +117,21 @@ func (dir *Node) Values(names ...string) ([]tensor.Tensor, error) {
 	}
 	var nds []tensor.Tensor
 	if len(names) == 0 {
-		for _, it := range dir.nodes.Values {
-			if it.Tensor != nil {
-				nds = append(nds, it.Tensor)
+		for _, it := range dir.nodeList() {
+			if tsr := it.TensorValue(); tsr != nil {
+				nds = append(nds, tsr)
 			}
 		}
 		return nds, nil
 	}
 	var errs []error
 	for _, nm := range names {
-		it := dir.nodes.At(nm)
-		if it != nil && it.Tensor != nil {
-			nds = append(nds, it.Tensor)
+		var tsr tensor.Tensor
+		if it := dir.Node(nm); it != nil {
+			tsr = it.TensorValue()
+		}
+		if tsr != nil {
+			nds = append(nds, tsr)
 		} else {
 			err := fmt.Errorf("tensorfs Dir %q node not found: %q", dir.Path(), nm)
 			errs = append(errs, err)
@@ -149,20 +146,24 @@ func (dir *Node) Values(names ...string) ([]tensor.Tensor, error) {
 // the entire subtree. The function can filter out directories to prune
 // the tree, e.g., using `IsDir` method.
 // If func is nil, all Value nodes are returned.
+// Link nodes are skipped, so that linked nodes are only included once.
 func (dir *Node) ValuesFunc(include func(nd *Node) bool) []tensor.Tensor {
 	if err := dir.mustDir("ValuesFunc", ""); err != nil {
 		return nil
 	}
 	var nds []tensor.Tensor
-	for _, it := range dir.nodes.Values {
+	for _, it := range dir.nodeList() {
 		if include != nil && !include(it) {
 			continue
 		}
+		if it.IsLink() {
+			continue
+		}
 		if it.IsDir() {
 			subs := it.ValuesFunc(include)
 			nds = append(nds, subs...)
 		} else {
-			nds = append(nds, it.Tensor)
+			nds = append(nds, it.TensorValue())
 		}
 	}
 	return nds
@@ -174,15 +175,19 @@ func (dir *Node) ValuesFunc(include func(nd *Node) bool) []tensor.Tensor {
 // (e.g., order added).
 // The function can filter out directories to prune the tree.
 // If func is nil, all leaf Nodes are returned.
+// Link nodes are skipped, so that linked nodes are only included once.
 func (dir *Node) NodesFunc(include func(nd *Node) bool) []*Node {
 	if err := dir.mustDir("NodesFunc", ""); err != nil {
 		return nil
 	}
 	var nds []*Node
-	for _, it := range dir.nodes.Values {
+	for _, it := range dir.nodeList() {
 		if include != nil && !include(it) {
 			continue
 		}
+		if it.IsLink() {
+			continue
+		}
 		if it.IsDir() {
 			subs := it.NodesFunc(include)
 			nds = append(nds, subs...)
@@ -199,6 +204,7 @@ func (dir *Node) NodesFunc(include func(nd *Node) bool) []*Node {
 // directory level traversed in alphabetical order.
 // The function can filter out directories to prune the tree.
 // If func is nil, all Values are returned.
+// Link nodes are skipped, so that linked nodes are only included once.
 func (dir *Node) ValuesAlphaFunc(include func(nd *Node) bool) []tensor.Tensor {
 	if err := dir.mustDir("ValuesAlphaFunc", ""); err != nil {
 		return nil
@@ -206,15 +212,18 @@ func (dir *Node) ValuesAlphaFunc(include func(nd *Node) bool) []tensor.Tensor {
 	names := dir.dirNamesAlpha()
 	var nds []tensor.Tensor
 	for _, nm := range names {
-		it := dir.nodes.At(nm)
-		if include != nil && !include(it) {
+		it := dir.Node(nm)
+		if it == nil || (include != nil && !include(it)) {
+			continue
+		}
+		if it.IsLink() {
 			continue
 		}
 		if it.IsDir() {
 			subs := it.ValuesAlphaFunc(include)
 			nds = append(nds, subs...)
 		} else {
-			nds = append(nds, it.Tensor)
+			nds = append(nds, it.TensorValue())
 		}
 	}
 	return nds
@@ -227,6 +236,7 @@ func (dir *Node) ValuesAlphaFunc(include func(nd *Node) bool) []tensor.Tensor {
 // (e.g., order added).
 // The function can filter out directories to prune the tree.
 // If func is nil, all leaf Nodes are returned.
+// Link nodes are skipped, so that linked nodes are only included once.
 func (dir *Node) NodesAlphaFunc(include func(nd *Node) bool) []*Node {
 	if err := dir.mustDir("NodesAlphaFunc", ""); err != nil {
 		return nil
@@ -234,8 +244,11 @@ func (dir *Node) NodesAlphaFunc(include func(nd *Node) bool) []*Node {
 	names := dir.dirNamesAlpha()
 	var nds []*Node
 	for _, nm := range names {
-		it := dir.nodes.At(nm)
-		if include != nil && !include(it) {
+		it := dir.Node(nm)
+		if it == nil || (include != nil && !include(it)) {
+			continue
+		}
+		if it.IsLink() {
 			continue
 		}
 		if it.IsDir() {
@@ -275,7 +288,7 @@ func (dir *Node) NodeAtPath(name string) (*Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	nd, ok := sd.nodes.AtTry(file)
+	nd, ok := sd.nodeAt(file)
 	if !ok {
 		if dirPath == "" && (file == dir.name || file == ".") {
 			return dir, nil
@@ -288,7 +301,7 @@ func (dir *Node) NodeAtPath(name string) (*Node, error) {
 // Path returns the full path to this data node
 func (dir *Node) Path() string {
 	pt := dir.name
-	cur := dir.Parent
+	cur := dir.ParentDir()
 	loops := make(map[*Node]struct{})
 	for {
 		if cur == nil {
@@ -299,14 +312,14 @@ func (dir *Node) Path() string {
 		}
 		pt = path.Join(cur.name, pt)
 		loops[cur] = struct{}{}
-		cur = cur.Parent
+		cur = cur.ParentDir()
 	}
 }
 
 // dirNamesAlpha returns the names of nodes in the directory
 // sorted alphabetically. Node must be dir by this point.
 func (dir *Node) dirNamesAlpha() []string {
-	names := slices.Clone(dir.nodes.Keys)
+	names := dir.nodeNames()
 	sort.Strings(names)
 	return names
 }
@@ -314,10 +327,14 @@ func (dir *Node) dirNamesAlpha() []string {
 // dirNamesByTime returns the names of nodes in the directory
 // sorted by modTime. Node must be dir by this point.
 func (dir *Node) dirNamesByTime() []string {
-	names := slices.Clone(dir.nodes.Keys)
-	slices.SortFunc(names, func(a, b string) int {
-		return dir.nodes.At(a).ModTime().Compare(dir.nodes.At(b).ModTime())
+	nds := dir.nodeList()
+	slices.SortFunc(nds, func(a, b *Node) int {
+		return a.ModTime().Compare(b.ModTime())
 	})
+	names := make([]string, len(nds))
+	for i, nd := range nds {
+		names[i] = nd.name
+	}
 	return names
 }
 
@@ -330,7 +347,7 @@ func (dir *Node) mustDir(op, path string) error {
 	return nil
 }
 
-// Add adds an node to this directory data node.
+// Add adds an node to this directory data node, sending an [EventAdded] event.
 // The only errors are if this node is not a directory,
 // or the name already exists, in which case an [fs.ErrExist] is returned.
 // Names must be unique within a directory.
@@ -338,9 +355,46 @@ func (dir *Node) Add(it *Node) error {
 	if err := dir.mustDir("Add", it.name); err != nil {
 		return err
 	}
+	dir = dir.resolve()
+	dir.mu.Lock()
 	err := dir.nodes.Add(it.name, it)
+	if err == nil {
+		it.mu.Lock()
+		it.Parent = dir
+		it.mu.Unlock()
+	}
+	dir.mu.Unlock()
 	if err != nil {
 		return fs.ErrExist
 	}
+	it.send(EventAdded)
 	return nil
 }
+
+// Delete removes the nodes with given names from this directory,
+// sending an [EventRemoved] event for each. Returns an error if this
+// node is not a directory, or for any nodes not found.
+func (dir *Node) Delete(names ...string) error {
+	if err := dir.mustDir("Delete", ""); err != nil {
+		return err
+	}
+	dir = dir.resolve()
+	var errs []error
+	for _, nm := range names {
+		dir.mu.Lock()
+		it, ok := dir.nodes.AtTry(nm)
+		if ok {
+			dir.nodes.DeleteByKey(nm)
+		}
+		dir.mu.Unlock()
+		if !ok {
+			errs = append(errs, fmt.Errorf("tensorfs Dir %q node not found: %q", dir.Path(), nm))
+			continue
+		}
+		it.send(EventRemoved)
+		it.mu.Lock()
+		it.Parent = nil
+		it.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
@@ -6,8 +6,10 @@ package tensorfs
 
 import (
 	"bytes"
+	"sync"
 	"testing"
 
+	"cogentcore.org/lab/tensor"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -76,6 +78,51 @@ next/
 	assert.Equal(t, ll, nls)
 }
 
+func TestDirTableChanges(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	a := dir.Float64("a", 3)
+	b := dir.Float64("b", 2)
+
+	// the view does not resize the columns or set the DirTable
+	vt := DirTableView(dir, nil)
+	assert.Equal(t, 2, vt.NumRows())
+	assert.Equal(t, []string{"a", "b"}, vt.Columns.Keys)
+	assert.Equal(t, 2, b.DimSize(0))
+	assert.Nil(t, dir.dirTable())
+
+	dt := DirTable(dir, nil)
+	assert.Equal(t, 3, dt.NumRows())
+	assert.Equal(t, 3, b.DimSize(0))
+	assert.Same(t, dt, DirTable(dir, nil))
+
+	// replaced and re-added values are used
+	na := tensor.NewFloat64(4)
+	dir.Set("a", na)
+	dt = DirTable(dir, nil)
+	assert.Equal(t, any(na), any(dt.Column("a").Tensor))
+	assert.Equal(t, 4, dt.NumRows())
+	assert.NoError(t, dir.Delete("b"))
+	nb := dir.Float64("b", 4)
+	dt = DirTable(dir, nil)
+	assert.Equal(t, any(nb), any(dt.Column("b").Tensor))
+	assert.NotEqual(t, any(a), any(dt.Column("a").Tensor))
+
+	// concurrent with replacing values
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			dir.Set("a", tensor.NewFloat64(4))
+		}
+	}()
+	for range 100 {
+		DirTable(dir, nil)
+	}
+	wg.Wait()
+}
+
 func TestDirTar(t *testing.T) {
 	dir, err := NewDir("root")
 	assert.NoError(t, err)
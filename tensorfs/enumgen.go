@@ -0,0 +1,93 @@
+// Code generated by "core generate"; DO NOT EDIT.
+
+package tensorfs
+
+import (
+	"cogentcore.org/core/enums"
+)
+
+var _ChangeTypesValues = []ChangeTypes{0, 1, 2}
+
+// ChangeTypesN is the highest valid value for type ChangeTypes, plus one.
+const ChangeTypesN ChangeTypes = 3
+
+var _ChangeTypesValueMap = map[string]ChangeTypes{`Added`: 0, `Removed`: 1, `Modified`: 2}
+
+var _ChangeTypesDescMap = map[ChangeTypes]string{0: `ChangeAdded is a node that is only present in the second snapshot.`, 1: `ChangeRemoved is a node that is only present in the first snapshot.`, 2: `ChangeModified is a value that is different between the snapshots, or a node that is a directory in one and a value in the other.`}
+
+var _ChangeTypesMap = map[ChangeTypes]string{0: `Added`, 1: `Removed`, 2: `Modified`}
+
+// String returns the string representation of this ChangeTypes value.
+func (i ChangeTypes) String() string { return enums.String(i, _ChangeTypesMap) }
+
+// SetString sets the ChangeTypes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *ChangeTypes) SetString(s string) error {
+	return enums.SetString(i, s, _ChangeTypesValueMap, "ChangeTypes")
+}
+
+// Int64 returns the ChangeTypes value as an int64.
+func (i ChangeTypes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the ChangeTypes value from an int64.
+func (i *ChangeTypes) SetInt64(in int64) { *i = ChangeTypes(in) }
+
+// Desc returns the description of the ChangeTypes value.
+func (i ChangeTypes) Desc() string { return enums.Desc(i, _ChangeTypesDescMap) }
+
+// ChangeTypesValues returns all possible values for the type ChangeTypes.
+func ChangeTypesValues() []ChangeTypes { return _ChangeTypesValues }
+
+// Values returns all possible values for the type ChangeTypes.
+func (i ChangeTypes) Values() []enums.Enum { return enums.Values(_ChangeTypesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i ChangeTypes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *ChangeTypes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "ChangeTypes")
+}
+
+var _EventTypesValues = []EventTypes{0, 1, 2, 3}
+
+// EventTypesN is the highest valid value for type EventTypes, plus one.
+const EventTypesN EventTypes = 4
+
+var _EventTypesValueMap = map[string]EventTypes{`Added`: 0, `Removed`: 1, `Replaced`: 2, `Updated`: 3}
+
+var _EventTypesDescMap = map[EventTypes]string{0: `EventAdded is sent when a node is added to a directory.`, 1: `EventRemoved is sent when a node is removed from a directory.`, 2: `EventReplaced is sent when the Tensor of an existing node is replaced with a different one, e.g., by [Node.Set].`, 3: `EventUpdated is sent by [Node.SendUpdate] to signal that the values of the Tensor of a node have been updated.`}
+
+var _EventTypesMap = map[EventTypes]string{0: `Added`, 1: `Removed`, 2: `Replaced`, 3: `Updated`}
+
+// String returns the string representation of this EventTypes value.
+func (i EventTypes) String() string { return enums.String(i, _EventTypesMap) }
+
+// SetString sets the EventTypes value from its string representation,
+// and returns an error if the string is invalid.
+func (i *EventTypes) SetString(s string) error {
+	return enums.SetString(i, s, _EventTypesValueMap, "EventTypes")
+}
+
+// Int64 returns the EventTypes value as an int64.
+func (i EventTypes) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the EventTypes value from an int64.
+func (i *EventTypes) SetInt64(in int64) { *i = EventTypes(in) }
+
+// Desc returns the description of the EventTypes value.
+func (i EventTypes) Desc() string { return enums.Desc(i, _EventTypesDescMap) }
+
+// EventTypesValues returns all possible values for the type EventTypes.
+func EventTypesValues() []EventTypes { return _EventTypesValues }
+
+// Values returns all possible values for the type EventTypes.
+func (i EventTypes) Values() []enums.Enum { return enums.Values(_EventTypesValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i EventTypes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *EventTypes) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "EventTypes")
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// Glob returns the nodes within this directory whose paths relative to it
+// match the given pattern, in directory order, using the [path.Match] syntax
+// for each element of the path, e.g., Stats/*/Train/Err*. In addition, a path
+// element of ** matches zero or more directories, so **/Err matches all nodes
+// named Err at any depth, without descending into link nodes.
+// The only possible error is [path.ErrBadPattern].
+func (dir *Node) Glob(pattern string) ([]*Node, error) {
+	if err := dir.mustDir("Glob", pattern); err != nil {
+		return nil, err
+	}
+	segs := strings.Split(path.Clean(pattern), "/")
+	for _, seg := range segs {
+		if _, err := path.Match(seg, ""); err != nil {
+			return nil, err
+		}
+	}
+	var nds []*Node
+	seen := map[*Node]bool{}
+	dir.glob(segs, func(nd *Node) {
+		if !seen[nd] {
+			seen[nd] = true
+			nds = append(nds, nd)
+		}
+	})
+	return nds, nil
+}
+
+// glob calls the add function for each node within this directory
+// that matches the given pattern path elements.
+func (dir *Node) glob(segs []string, add func(nd *Node)) {
+	seg, rest := segs[0], segs[1:]
+	next := func(nd *Node) {
+		if len(rest) == 0 {
+			add(nd)
+		} else if nd.IsDir() {
+			nd.glob(rest, add)
+		}
+	}
+	switch {
+	case seg == ".":
+		next(dir)
+	case seg == "..":
+		if dir.ParentDir() != nil {
+			next(dir.ParentDir())
+		}
+	case seg == "**":
+		if len(rest) == 0 {
+			dir.walk(func(nd *Node) { add(nd) })
+			return
+		}
+		dir.glob(rest, add)
+		for _, it := range dir.nodeList() {
+			if it.IsDir() && !it.IsLink() {
+				it.glob(segs, add)
+			}
+		}
+	case !isPattern(seg):
+		if it, ok := dir.nodeAt(seg); ok {
+			next(it)
+		}
+	default:
+		for _, it := range dir.nodeList() {
+			if ok, _ := path.Match(seg, it.name); ok {
+				next(it)
+			}
+		}
+	}
+}
+
+// walk calls the given function on all nodes within this directory,
+// recursively, in directory order, skipping link nodes.
+func (dir *Node) walk(fun func(nd *Node)) {
+	for _, it := range dir.nodeList() {
+		if it.IsLink() {
+			continue
+		}
+		fun(it)
+		if it.IsDir() {
+			it.walk(fun)
+		}
+	}
+}
+
+// Find returns the value nodes within this directory, recursively in
+// directory order and skipping link nodes, that match the given query,
+// which is a space-separated list of conditions that must all be true:
+//   - name=pattern: the name matches the [path.Match] pattern, e.g., name=Err*
+//   - type=kind: the data type, e.g., type=float32
+//   - ndims=n: the number of dimensions
+//   - shape=sizes: the comma-separated shape sizes, where * matches any size,
+//     e.g., shape=*,5,5
+//   - key=pattern: the metadata value for key, formatted as a string,
+//     matches the pattern, e.g., Units=ms
+//   - key: the metadata key is present.
+//
+// Each condition can be negated, as name!=Err* or !key.
+func (dir *Node) Find(query string) ([]*Node, error) {
+	if err := dir.mustDir("Find", query); err != nil {
+		return nil, err
+	}
+	var conds []findCond
+	for _, f := range strings.Fields(query) {
+		cd, err := parseFindCond(f)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cd)
+	}
+	var nds []*Node
+	dir.walk(func(nd *Node) {
+		tsr := nd.TensorValue()
+		if tsr == nil {
+			return
+		}
+		for _, cd := range conds {
+			if cd.match(nd, tsr) == cd.not {
+				return
+			}
+		}
+		nds = append(nds, nd)
+	})
+	return nds, nil
+}
+
+// findCond is one condition of a [Node.Find] query.
+type findCond struct {
+	// key is the name of the property or metadata key.
+	key string
+
+	// value is the value to match, which is empty if has is true.
+	value string
+
+	// has is true for a metadata key presence condition.
+	has bool
+
+	// not is true if the condition is negated.
+	not bool
+}
+
+func parseFindCond(s string) (findCond, error) {
+	var cd findCond
+	key, value, ok := strings.Cut(s, "=")
+	switch {
+	case !ok:
+		key, cd.not = strings.CutPrefix(key, "!")
+		cd.has = true
+	case strings.HasSuffix(key, "!"):
+		key = strings.TrimSuffix(key, "!")
+		cd.not = true
+	}
+	if key == "" {
+		return cd, fmt.Errorf("tensorfs Find: invalid condition %q", s)
+	}
+	if _, err := path.Match(value, ""); err != nil {
+		return cd, fmt.Errorf("tensorfs Find: invalid condition %q: %w", s, err)
+	}
+	cd.key, cd.value = key, value
+	return cd, nil
+}
+
+// match returns true if the given node with given tensor matches
+// the condition, prior to any negation.
+func (cd *findCond) match(nd *Node, tsr tensor.Tensor) bool {
+	if cd.has {
+		_, ok := (*tsr.Metadata())[cd.key]
+		return ok
+	}
+	switch cd.key {
+	case "name":
+		return matchPattern(cd.value, nd.name)
+	case "type":
+		return matchPattern(cd.value, tsr.DataType().String())
+	case "ndims":
+		return cd.value == strconv.Itoa(tsr.NumDims())
+	case "shape":
+		szs := strings.Split(cd.value, ",")
+		return slices.EqualFunc(szs, tsr.ShapeSizes(), func(s string, sz int) bool {
+			return s == "*" || s == strconv.Itoa(sz)
+		})
+	}
+	v, ok := (*tsr.Metadata())[cd.key]
+	return ok && matchPattern(cd.value, fmt.Sprint(v))
+}
+
+// matchPattern returns true if the given string matches the
+// [path.Match] pattern, which has already been validated.
+func matchPattern(pattern, s string) bool {
+	ok, _ := path.Match(pattern, s)
+	return ok
+}
+
+// isPattern returns true if the given path contains
+// any [path.Match] pattern characters.
+func isPattern(pth string) bool {
+	return strings.ContainsAny(pth, `*?[\`)
+}
+
+// relPath returns the path of given node relative to this directory.
+func (dir *Node) relPath(nd *Node) string {
+	var names []string
+	for cur := nd; cur != nil && cur != dir; cur = cur.ParentDir() {
+		names = append(names, cur.name)
+	}
+	if len(names) == 0 {
+		return "."
+	}
+	slices.Reverse(names)
+	return path.Join(names...)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bytes"
+	"testing"
+
+	"cogentcore.org/core/base/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeRunsNode(t *testing.T) *Node {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	for r, run := range []string{"Run0", "Run1"} {
+		rd := dir.Dir("Stats/" + run)
+		tr := rd.Dir("Train")
+		tr.Float64("Err", 4).Set1D(float64(r), 0)
+		tr.Float64("Loss", 4)
+		metadata.Set(tr.Float32("Act", 4, 5, 5), "Units", "Hz")
+		rd.Dir("Test").Float64("Err", 4)
+		rd.StringValue("Name", 1)
+	}
+	return dir
+}
+
+func paths(dir *Node, nds []*Node) []string {
+	ps := make([]string, len(nds))
+	for i, nd := range nds {
+		ps[i] = dir.relPath(nd)
+	}
+	return ps
+}
+
+func TestGlob(t *testing.T) {
+	dir := makeRunsNode(t)
+	nds, err := dir.Glob("Stats/*/Train/Err*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stats/Run0/Train/Err", "Stats/Run1/Train/Err"}, paths(dir, nds))
+
+	nds, err = dir.Glob("Stats/**/Err")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stats/Run0/Train/Err", "Stats/Run0/Test/Err", "Stats/Run1/Train/Err", "Stats/Run1/Test/Err"}, paths(dir, nds))
+
+	nds, err = dir.Glob("**/Run1/*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stats/Run1/Train", "Stats/Run1/Test", "Stats/Run1/Name"}, paths(dir, nds))
+
+	nds, err = dir.Glob("Stats/Run0/**")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(nds))
+
+	nds, err = dir.Glob("Stats/Run?/Name")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(nds))
+
+	nds, err = dir.Glob("Stats/Run0/Train/../Test/Err")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stats/Run0/Test/Err"}, paths(dir, nds))
+
+	nds, err = dir.Glob("Nope/*")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(nds))
+
+	_, err = dir.Glob("Stats/[")
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	dir := makeRunsNode(t)
+	nds, err := dir.Find("type=float64 name=Err")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(nds))
+
+	nds, err = dir.Find("shape=*,5,5")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stats/Run0/Train/Act", "Stats/Run1/Train/Act"}, paths(dir, nds))
+
+	nds, err = dir.Find("Units=H*")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(nds))
+
+	nds, err = dir.Find("!Units ndims=1 type!=string name!=Loss")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(nds))
+
+	nds, err = dir.Find("")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(nds))
+
+	_, err = dir.Find("=x")
+	assert.Error(t, err)
+	_, err = dir.Find("name=[")
+	assert.Error(t, err)
+}
+
+func TestGlobCommands(t *testing.T) {
+	var b bytes.Buffer
+	ListOutput = &b
+	defer func() { ListOutput = nil; CurDir = CurRoot }()
+	CurDir = makeRunsNode(t)
+
+	assert.NoError(t, List("Stats/*/Test"))
+	assert.Equal(t, "Stats/Run0/Test/ Stats/Run1/Test/ \n", b.String())
+	b.Reset()
+	assert.NoError(t, List("-l", "Stats/*/Train/Err"))
+	assert.Contains(t, b.String(), "Stats/Run1/Train/Err ")
+
+	errs := Get("Stats/*/Train/Err")
+	assert.Equal(t, []int{2, 4}, errs.ShapeSizes())
+	assert.Equal(t, 1.0, errs.Float(1, 0))
+	assert.Nil(t, Get("Stats/*/Train/*"))
+	assert.Nil(t, Get("Stats/*/None"))
+}
@@ -64,14 +64,14 @@ func (nd *Node) Sub(dir string) (fs.FS, error) {
 		}
 		cd = rest
 		if root == ".." {
-			if cur.Parent != nil {
-				cur = cur.Parent
+			if cur.ParentDir() != nil {
+				cur = cur.ParentDir()
 				continue
 			} else {
 				return nil, &fs.PathError{Op: "Sub", Path: dir, Err: errors.New("already at root")}
 			}
 		}
-		sd, ok := cur.nodes.AtTry(root)
+		sd, ok := cur.nodeAt(root)
 		if !ok {
 			return nil, &fs.PathError{Op: "Sub", Path: dir, Err: errors.New("directory not found")}
 		}
@@ -90,9 +90,11 @@ func (nd *Node) ReadDir(dir string) ([]fs.DirEntry, error) {
 		return nil, err
 	}
 	names := sd.dirNamesAlpha()
-	ents := make([]fs.DirEntry, len(names))
-	for i, nm := range names {
-		ents[i] = sd.nodes.At(nm)
+	ents := make([]fs.DirEntry, 0, len(names))
+	for _, nm := range names {
+		if it := sd.Node(nm); it != nil {
+			ents = append(ents, it)
+		}
 	}
 	return ents, nil
 }
@@ -122,17 +124,23 @@ func (nd *Node) Name() string { return nd.name }
 // Size returns the size of known data Values, or it uses
 // the Sizer interface, otherwise returns 0.
 func (nd *Node) Size() int64 {
-	if nd.Tensor == nil {
+	if t := nd.target(); !t.isLoaded() {
+		return t.sourceSize()
+	}
+	tsr := nd.TensorValue()
+	if tsr == nil {
 		return 0
 	}
-	return nd.Tensor.AsValues().Sizeof()
+	return tsr.AsValues().Sizeof()
 }
 
 func (nd *Node) IsDir() bool {
-	return nd.nodes != nil
+	return nd.target().nodes != nil
 }
 
 func (nd *Node) ModTime() time.Time {
+	nd.mu.RLock()
+	defer nd.mu.RUnlock()
 	return nd.modTime
 }
 
@@ -145,10 +153,10 @@ func (nd *Node) Mode() fs.FileMode {
 
 // Sys returns the Dir or Value
 func (nd *Node) Sys() any {
-	if nd.Tensor != nil {
-		return nd.Tensor
+	if tsr := nd.TensorValue(); tsr != nil {
+		return tsr
 	}
-	return nd.nodes
+	return nd.target().nodes
 }
 
 //////// DirEntry interface
@@ -164,10 +172,13 @@ func (nd *Node) Info() (fs.FileInfo, error) {
 //////// Misc
 
 func (nd *Node) KnownFileInfo() fileinfo.Known {
-	if nd.Tensor == nil {
+	if t := nd.target(); !t.isLoaded() && !t.IsDir() {
+		return fileinfo.Tensor
+	}
+	tsr := nd.TensorValue()
+	if tsr == nil {
 		return fileinfo.Unknown
 	}
-	tsr := nd.Tensor
 	if tsr.Len() > 1 {
 		return fileinfo.Tensor
 	}
@@ -182,8 +193,9 @@ func (nd *Node) KnownFileInfo() fileinfo.Known {
 // This is the actual underlying data, so make a copy if it can be
 // unintentionally modified or retained more than for immediate use.
 func (nd *Node) Bytes() []byte {
-	if nd.Tensor == nil || nd.Tensor.NumDims() == 0 || nd.Tensor.Len() == 0 {
+	tsr := nd.TensorValue()
+	if tsr == nil || tsr.NumDims() == 0 || tsr.Len() == 0 {
 		return nil
 	}
-	return nd.Tensor.AsValues().Bytes()
+	return tsr.AsValues().Bytes()
 }
@@ -0,0 +1,50 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+)
+
+// ContentHash returns the [tensor.ContentHash] of the value of this node,
+// covering its data type, shape and values, and false if it is not a value.
+func (nd *Node) ContentHash() (tensor.Hash, bool) {
+	tsr := nd.TensorValue()
+	if tsr == nil {
+		return tensor.Hash{}, false
+	}
+	return tensor.ContentHash(tsr), true
+}
+
+// Duplicates returns groups of value nodes within this directory, recursively
+// in directory order and skipping link nodes, that have identical content,
+// as determined by [Node.ContentHash]. Each group has at least two nodes,
+// and the groups are in the order of their first nodes. [Save] only stores
+// the data for each group once.
+func (dir *Node) Duplicates() [][]*Node {
+	if err := dir.mustDir("Duplicates", ""); errors.Log(err) != nil {
+		return nil
+	}
+	var hashes []tensor.Hash
+	groups := map[tensor.Hash][]*Node{}
+	dir.walk(func(nd *Node) {
+		h, ok := nd.ContentHash()
+		if !ok {
+			return
+		}
+		if _, has := groups[h]; !has {
+			hashes = append(hashes, h)
+		}
+		groups[h] = append(groups[h], nd)
+	})
+	var dups [][]*Node
+	for _, h := range hashes {
+		if g := groups[h]; len(g) > 1 {
+			dups = append(dups, g)
+		}
+	}
+	return dups
+}
@@ -0,0 +1,499 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"math/bits"
+	"os"
+	"slices"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+)
+
+// The HDF5 files written by [WriteHDF5] use a small subset of the HDF5
+// format version 2 (as written by HDF5 1.8 and later), which can be read by
+// h5py, MATLAB, and any other HDF5 based tools:
+//
+//   - A version 2 superblock, with 8 byte offsets and lengths.
+//   - Version 2 object headers, with all messages in the first chunk.
+//   - Groups use compact link storage, with a link message for each member.
+//   - Datasets use contiguous storage, written after their object header.
+//
+// All data is little-endian, and strings are fixed length, null padded,
+// UTF-8 strings, using the maximum length of the strings in each value.
+const (
+	// hdf5Signature is the HDF5 file format signature.
+	hdf5Signature = "\x89HDF\r\n\x1a\n"
+
+	// hdf5Undefined is the undefined address.
+	hdf5Undefined = ^uint64(0)
+
+	// hdf5SuperblockSize is the size of the version 2 superblock.
+	hdf5SuperblockSize = 48
+)
+
+// HDF5 object header message types.
+const (
+	hdf5Dataspace = 0x01
+	hdf5LinkInfo  = 0x02
+	hdf5Datatype  = 0x03
+	hdf5FillValue = 0x05
+	hdf5Link      = 0x06
+	hdf5Layout    = 0x08
+	hdf5GroupInfo = 0x0A
+	hdf5Attribute = 0x0C
+)
+
+// SaveHDF5 saves the given directory tree to the given HDF5 file,
+// using [WriteHDF5].
+func SaveHDF5(dir *Node, filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	return errors.Join(WriteHDF5(f, dir, nil), f.Close())
+}
+
+// WriteHDF5 writes the given directory tree to the given writer in the
+// HDF5 format, which is a self-describing hierarchical format that can be
+// read from Python (h5py), MATLAB (h5read), and many other tools, using
+// given include function to select nodes to include (all if nil).
+// Directories are written as groups, and values as datasets of the same
+// shape, with the metadata of each value written as attributes of its dataset,
+// for metadata values that are strings, string slices, bools, ints, or floats.
+// Link nodes are not included. Bool values are written as an enumerated type
+// that h5py reads as bool, and int values as 64-bit integers. Errors are
+// returned for values of unsupported types, which are skipped.
+func WriteHDF5(w io.Writer, dir *Node, include func(nd *Node) bool) error {
+	if err := dir.mustDir("WriteHDF5", ""); err != nil {
+		return err
+	}
+	var errs []error
+	root := newHDF5Object(dir, include, &errs)
+	off := int64(hdf5SuperblockSize)
+	root.layout(&off)
+
+	bw := bufio.NewWriter(w)
+	sb := []byte(hdf5Signature)
+	sb = append(sb, 2, 8, 8, 0)
+	sb = binary.LittleEndian.AppendUint64(sb, 0)
+	sb = binary.LittleEndian.AppendUint64(sb, hdf5Undefined)
+	sb = binary.LittleEndian.AppendUint64(sb, uint64(off))
+	sb = binary.LittleEndian.AppendUint64(sb, uint64(root.addr))
+	sb = binary.LittleEndian.AppendUint32(sb, lookup3(sb))
+	bw.Write(sb)
+	if err := root.write(bw); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, bw.Flush())
+	return errors.Join(errs...)
+}
+
+// hdf5Object is a group or dataset in an HDF5 file.
+type hdf5Object struct {
+	// name is the name of the object within its group.
+	name string
+
+	// children are the members of a group, which is nil for a dataset.
+	children []*hdf5Object
+
+	// values is the value of a dataset.
+	values tensor.Values
+
+	// datatype is the datatype message of a dataset.
+	datatype []byte
+
+	// strLen is the length of the strings for a string dataset.
+	strLen int
+
+	// attrs are the attribute messages of a dataset.
+	attrs [][]byte
+
+	// addr is the address of the object header.
+	addr int64
+
+	// dataAddr and dataSize are the address and size of the dataset data.
+	dataAddr, dataSize int64
+}
+
+// newHDF5Object returns a new hdf5Object for given node,
+// adding any errors to given list.
+func newHDF5Object(nd *Node, include func(nd *Node) bool, errs *[]error) *hdf5Object {
+	ob := &hdf5Object{name: nd.name}
+	if nd.IsDir() {
+		ob.children = []*hdf5Object{}
+		for _, it := range nd.nodeList() {
+			if it.IsLink() || (include != nil && !include(it)) {
+				continue
+			}
+			if !it.IsDir() && it.TensorValue() == nil {
+				continue
+			}
+			if ch := newHDF5Object(it, include, errs); ch != nil {
+				ob.children = append(ob.children, ch)
+			}
+		}
+		return ob
+	}
+	tsr := nd.TensorValue()
+	ob.values = tsr.AsValues()
+	n := int64(ob.values.Len())
+	switch ob.values.DataType().String() {
+	case "float64":
+		ob.datatype, ob.dataSize = hdf5Float(8), 8*n
+	case "float32":
+		ob.datatype, ob.dataSize = hdf5Float(4), 4*n
+	case "int", "int64":
+		ob.datatype, ob.dataSize = hdf5FixedPoint(8, true), 8*n
+	case "uint64":
+		ob.datatype, ob.dataSize = hdf5FixedPoint(8, false), 8*n
+	case "int32":
+		ob.datatype, ob.dataSize = hdf5FixedPoint(4, true), 4*n
+	case "uint32":
+		ob.datatype, ob.dataSize = hdf5FixedPoint(4, false), 4*n
+	case "uint8":
+		ob.datatype, ob.dataSize = hdf5FixedPoint(1, false), n
+	case "bool":
+		ob.datatype, ob.dataSize = hdf5Bool(), n
+	case "string":
+		ob.strLen = 1
+		for i := range ob.values.Len() {
+			ob.strLen = max(ob.strLen, len(ob.values.String1D(i)))
+		}
+		ob.datatype, ob.dataSize = hdf5String(ob.strLen), int64(ob.strLen)*n
+	default:
+		*errs = append(*errs, fmt.Errorf("tensorfs WriteHDF5: %s: unsupported data type %s", nd.Path(), ob.values.DataType()))
+		return nil
+	}
+	md := *tsr.Metadata()
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		if at := hdf5AttributeValue(k, md[k]); at != nil {
+			ob.attrs = append(ob.attrs, at)
+		}
+	}
+	return ob
+}
+
+// layout sets the addresses of this object and all objects within it,
+// starting at given offset, which is advanced past them.
+func (ob *hdf5Object) layout(off *int64) {
+	ob.addr = *off
+	*off += int64(len(ob.header()))
+	if ob.children == nil {
+		ob.dataAddr = *off
+		*off += ob.dataSize
+		return
+	}
+	for _, ch := range ob.children {
+		ch.layout(off)
+	}
+}
+
+// header returns the object header for this object,
+// whose size does not depend on the addresses.
+func (ob *hdf5Object) header() []byte {
+	var msgs [][]byte
+	if ob.children != nil {
+		linfo := []byte{0, 0}
+		linfo = binary.LittleEndian.AppendUint64(linfo, hdf5Undefined)
+		linfo = binary.LittleEndian.AppendUint64(linfo, hdf5Undefined)
+		msgs = append(msgs, hdf5Message(hdf5LinkInfo, 0, linfo))
+		msgs = append(msgs, hdf5Message(hdf5GroupInfo, 0, []byte{0, 0}))
+		for _, ch := range ob.children {
+			msgs = append(msgs, hdf5Message(hdf5Link, 0, hdf5LinkMessage(ch.name, ch.addr)))
+		}
+	} else {
+		msgs = append(msgs, hdf5Message(hdf5Dataspace, 0, hdf5DataspaceMessage(ob.values.ShapeSizes())))
+		msgs = append(msgs, hdf5Message(hdf5Datatype, 1, ob.datatype))
+		msgs = append(msgs, hdf5Message(hdf5FillValue, 1, []byte{3, 0x09}))
+		layout := []byte{3, 1}
+		addr := uint64(ob.dataAddr)
+		if ob.dataSize == 0 {
+			addr = hdf5Undefined
+		}
+		layout = binary.LittleEndian.AppendUint64(layout, addr)
+		layout = binary.LittleEndian.AppendUint64(layout, uint64(ob.dataSize))
+		msgs = append(msgs, hdf5Message(hdf5Layout, 0, layout))
+		for _, at := range ob.attrs {
+			msgs = append(msgs, hdf5Message(hdf5Attribute, 0, at))
+		}
+	}
+	size := 0
+	for _, m := range msgs {
+		size += len(m)
+	}
+	b := []byte("OHDR")
+	b = append(b, 2, 0x02) // version 2, 4 byte chunk size
+	b = binary.LittleEndian.AppendUint32(b, uint32(size))
+	for _, m := range msgs {
+		b = append(b, m...)
+	}
+	return binary.LittleEndian.AppendUint32(b, lookup3(b))
+}
+
+// write writes this object and all objects within it,
+// in the order of their addresses.
+func (ob *hdf5Object) write(w *bufio.Writer) error {
+	if _, err := w.Write(ob.header()); err != nil {
+		return err
+	}
+	if ob.children == nil {
+		return ob.writeData(w)
+	}
+	for _, ch := range ob.children {
+		if err := ch.write(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeData writes the data of this dataset.
+func (ob *hdf5Object) writeData(w *bufio.Writer) error {
+	switch vals := ob.values.(type) {
+	case *tensor.Float64:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Float32:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Number[int64]:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Number[uint64]:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Int32:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Uint32:
+		return binary.Write(w, binary.LittleEndian, vals.Values)
+	case *tensor.Byte:
+		_, err := w.Write(vals.Values)
+		return err
+	}
+	var b []byte
+	for i := range ob.values.Len() {
+		switch ob.values.DataType().String() {
+		case "int":
+			b = binary.LittleEndian.AppendUint64(b, uint64(ob.values.Int1D(i)))
+		case "bool":
+			b = append(b, byte(ob.values.Int1D(i)))
+		case "string":
+			s := ob.values.String1D(i)
+			b = append(b, s...)
+			b = append(b, make([]byte, ob.strLen-len(s))...)
+		}
+		if len(b) >= 4096 {
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+			b = b[:0]
+		}
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// hdf5Message returns a version 2 object header message
+// of given type with given flags and data.
+func hdf5Message(typ, flags byte, data []byte) []byte {
+	b := []byte{typ}
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(data)))
+	b = append(b, flags)
+	return append(b, data...)
+}
+
+// hdf5LinkMessage returns a link message for a hard link with
+// given UTF-8 name to the object header at given address.
+func hdf5LinkMessage(name string, addr int64) []byte {
+	n := len(name)
+	var b []byte
+	switch {
+	case n < 1<<8:
+		b = []byte{1, 0x10, 1, byte(n)}
+	case n < 1<<16:
+		b = binary.LittleEndian.AppendUint16([]byte{1, 0x11, 1}, uint16(n))
+	default:
+		b = binary.LittleEndian.AppendUint32([]byte{1, 0x12, 1}, uint32(n))
+	}
+	b = append(b, name...)
+	return binary.LittleEndian.AppendUint64(b, uint64(addr))
+}
+
+// hdf5DataspaceMessage returns a version 2 dataspace message
+// for given shape sizes, which is a scalar if there are none.
+func hdf5DataspaceMessage(sizes []int) []byte {
+	if len(sizes) == 0 {
+		return []byte{2, 0, 0, 0}
+	}
+	b := []byte{2, byte(len(sizes)), 0, 1}
+	for _, sz := range sizes {
+		b = binary.LittleEndian.AppendUint64(b, uint64(sz))
+	}
+	return b
+}
+
+// hdf5FixedPoint returns a datatype message for a
+// little-endian integer of given size in bytes.
+func hdf5FixedPoint(size int, signed bool) []byte {
+	b := []byte{0x10, 0, 0, 0}
+	if signed {
+		b[1] = 0x08
+	}
+	b = binary.LittleEndian.AppendUint32(b, uint32(size))
+	b = binary.LittleEndian.AppendUint16(b, 0)
+	return binary.LittleEndian.AppendUint16(b, uint16(8*size))
+}
+
+// hdf5Float returns a datatype message for a little-endian
+// IEEE floating point number of given size in bytes (4 or 8).
+func hdf5Float(size int) []byte {
+	b := []byte{0x11, 0x20, byte(8*size - 1), 0}
+	b = binary.LittleEndian.AppendUint32(b, uint32(size))
+	b = binary.LittleEndian.AppendUint16(b, 0)
+	b = binary.LittleEndian.AppendUint16(b, uint16(8*size))
+	if size == 8 {
+		b = append(b, 52, 11, 0, 52)
+		return binary.LittleEndian.AppendUint32(b, 1023)
+	}
+	b = append(b, 23, 8, 0, 23)
+	return binary.LittleEndian.AppendUint32(b, 127)
+}
+
+// hdf5String returns a datatype message for a
+// null padded UTF-8 string of given fixed length.
+func hdf5String(size int) []byte {
+	return binary.LittleEndian.AppendUint32([]byte{0x13, 0x11, 0, 0}, uint32(size))
+}
+
+// hdf5Bool returns a datatype message for a bool, as an enumerated
+// type with FALSE and TRUE members, in the same way as h5py.
+func hdf5Bool() []byte {
+	b := []byte{0x18, 2, 0, 0}
+	b = binary.LittleEndian.AppendUint32(b, 1)
+	b = append(b, hdf5FixedPoint(1, true)...)
+	b = append(b, "FALSE\x00\x00\x00TRUE\x00\x00\x00\x00"...)
+	return append(b, 0, 1)
+}
+
+// hdf5AttributeValue returns a version 3 attribute message with given
+// name and value, or nil if the value is not of a supported type.
+func hdf5AttributeValue(name string, value any) []byte {
+	scalar := hdf5DataspaceMessage(nil)
+	switch v := value.(type) {
+	case string:
+		n := max(len(v), 1)
+		data := append([]byte(v), make([]byte, n-len(v))...)
+		return hdf5AttributeMessage(name, hdf5String(n), scalar, data)
+	case []string:
+		n := 1
+		for _, s := range v {
+			n = max(n, len(s))
+		}
+		var data []byte
+		for _, s := range v {
+			data = append(data, s...)
+			data = append(data, make([]byte, n-len(s))...)
+		}
+		return hdf5AttributeMessage(name, hdf5String(n), hdf5DataspaceMessage([]int{len(v)}), data)
+	case bool:
+		data := []byte{0}
+		if v {
+			data[0] = 1
+		}
+		return hdf5AttributeMessage(name, hdf5Bool(), scalar, data)
+	case int:
+		return hdf5AttributeMessage(name, hdf5FixedPoint(8, true), scalar, binary.LittleEndian.AppendUint64(nil, uint64(v)))
+	case float32:
+		return hdf5AttributeMessage(name, hdf5Float(4), scalar, binary.LittleEndian.AppendUint32(nil, math.Float32bits(v)))
+	case float64:
+		return hdf5AttributeMessage(name, hdf5Float(8), scalar, binary.LittleEndian.AppendUint64(nil, math.Float64bits(v)))
+	}
+	return nil
+}
+
+// hdf5AttributeMessage returns a version 3 attribute message with
+// given UTF-8 name, datatype and dataspace messages, and data.
+func hdf5AttributeMessage(name string, datatype, dataspace, data []byte) []byte {
+	b := []byte{3, 0}
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(name)+1))
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(datatype)))
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(dataspace)))
+	b = append(b, 1)
+	b = append(b, name...)
+	b = append(b, 0)
+	b = append(b, datatype...)
+	b = append(b, dataspace...)
+	if len(b)+len(data) > 0xFFFF {
+		return nil
+	}
+	return append(b, data...)
+}
+
+// lookup3 returns the Jenkins lookup3 hash (hashlittle) of given data
+// with an initial value of 0, which is the checksum used by HDF5.
+func lookup3(k []byte) uint32 {
+	a := 0xdeadbeef + uint32(len(k))
+	b, c := a, a
+	u32 := func(p []byte) uint32 {
+		var v uint32
+		for i, x := range p {
+			v |= uint32(x) << (8 * i)
+		}
+		return v
+	}
+	for len(k) > 12 {
+		a += u32(k[0:4])
+		b += u32(k[4:8])
+		c += u32(k[8:12])
+		a -= c
+		a ^= bits.RotateLeft32(c, 4)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 6)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 8)
+		b += a
+		a -= c
+		a ^= bits.RotateLeft32(c, 16)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 19)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 4)
+		b += a
+		k = k[12:]
+	}
+	if len(k) == 0 {
+		return c
+	}
+	a += u32(k[:min(len(k), 4)])
+	if len(k) > 4 {
+		b += u32(k[4:min(len(k), 8)])
+	}
+	if len(k) > 8 {
+		c += u32(k[8:])
+	}
+	c ^= b
+	c -= bits.RotateLeft32(b, 14)
+	a ^= c
+	a -= bits.RotateLeft32(c, 11)
+	b ^= a
+	b -= bits.RotateLeft32(a, 25)
+	c ^= b
+	c -= bits.RotateLeft32(b, 16)
+	a ^= c
+	a -= bits.RotateLeft32(c, 4)
+	b ^= a
+	b -= bits.RotateLeft32(a, 14)
+	c ^= b
+	c -= bits.RotateLeft32(b, 24)
+	return c
+}
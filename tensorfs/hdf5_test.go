@@ -0,0 +1,144 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup3(t *testing.T) {
+	assert.Equal(t, uint32(0xdeadbeef), lookup3(nil))
+	assert.Equal(t, uint32(0x17770551), lookup3([]byte("Four score and seven years ago")))
+}
+
+// hdf5TestObject is an object read back from an HDF5 file by readHDF5.
+type hdf5TestObject struct {
+	links    map[string]uint64
+	names    []string
+	dims     []uint64
+	class    byte
+	size     uint32
+	data     []byte
+	attrs    []string
+	hasGroup bool
+}
+
+// readHDF5 reads the object header at given address of the HDF5
+// file written by WriteHDF5, checking the structure and checksums.
+func readHDF5(t *testing.T, b []byte, addr uint64) *hdf5TestObject {
+	le := binary.LittleEndian
+	assert.Equal(t, "OHDR", string(b[addr:addr+4]))
+	assert.Equal(t, byte(2), b[addr+4])
+	size := uint64(le.Uint32(b[addr+6:]))
+	end := addr + 10 + size
+	assert.Equal(t, lookup3(b[addr:end]), le.Uint32(b[end:]))
+	ob := &hdf5TestObject{links: map[string]uint64{}}
+	for p := addr + 10; p < end; {
+		typ, msz := b[p], uint64(le.Uint16(b[p+1:]))
+		m := b[p+4 : p+4+msz]
+		switch typ {
+		case hdf5LinkInfo:
+			ob.hasGroup = true
+		case hdf5Link:
+			assert.Equal(t, byte(0x10), m[1])
+			n := uint64(m[3])
+			name := string(m[4 : 4+n])
+			ob.names = append(ob.names, name)
+			ob.links[name] = le.Uint64(m[4+n:])
+		case hdf5Dataspace:
+			for d := range int(m[1]) {
+				ob.dims = append(ob.dims, le.Uint64(m[4+8*d:]))
+			}
+		case hdf5Datatype:
+			ob.class = m[0] & 0x0f
+			ob.size = le.Uint32(m[4:])
+		case hdf5Layout:
+			assert.Equal(t, []byte{3, 1}, m[:2])
+			da, dn := le.Uint64(m[2:]), le.Uint64(m[10:])
+			if dn > 0 {
+				ob.data = b[da : da+dn]
+			}
+		case hdf5Attribute:
+			nl := le.Uint16(m[2:])
+			ob.attrs = append(ob.attrs, string(m[9:9+nl-1]))
+		}
+		p += 4 + msz
+	}
+	return ob
+}
+
+func TestHDF5(t *testing.T) {
+	dir := makeRunsNode(t)
+	bl := tensor.NewBool(3)
+	bl.SetInt1D(1, 2)
+	SetTensor(dir.Dir("Flags"), bl, "Done")
+	nm := dir.Node("Stats").Node("Run0").Value("Name")
+	nm.SetString1D("first run", 0)
+	metadata.Set(nm, "Count", 3)
+	dir.Link("Link", "Stats")
+
+	var b bytes.Buffer
+	assert.NoError(t, WriteHDF5(&b, dir, nil))
+	f := b.Bytes()
+	le := binary.LittleEndian
+	assert.Equal(t, hdf5Signature, string(f[:8]))
+	assert.Equal(t, lookup3(f[:44]), le.Uint32(f[44:]))
+	assert.Equal(t, uint64(len(f)), le.Uint64(f[28:]))
+
+	root := readHDF5(t, f, le.Uint64(f[36:]))
+	assert.True(t, root.hasGroup)
+	assert.Equal(t, []string{"Stats", "Flags"}, root.names)
+	stats := readHDF5(t, f, root.links["Stats"])
+	run1 := readHDF5(t, f, stats.links["Run1"])
+	assert.Equal(t, []string{"Train", "Test", "Name"}, run1.names)
+	train := readHDF5(t, f, run1.links["Train"])
+
+	errs := readHDF5(t, f, train.links["Err"])
+	assert.Equal(t, []uint64{4}, errs.dims)
+	assert.Equal(t, byte(1), errs.class)
+	assert.Equal(t, 1.0, math.Float64frombits(le.Uint64(errs.data)))
+
+	act := readHDF5(t, f, train.links["Act"])
+	assert.Equal(t, []uint64{4, 5, 5}, act.dims)
+	assert.Equal(t, uint32(4), act.size)
+	assert.Equal(t, 4*5*5*4, len(act.data))
+	assert.Contains(t, act.attrs, "Units")
+
+	name := readHDF5(t, f, readHDF5(t, f, stats.links["Run0"]).links["Name"])
+	assert.Equal(t, byte(3), name.class)
+	assert.Equal(t, "first run", string(bytes.TrimRight(name.data, "\x00")))
+	assert.Contains(t, name.attrs, "Count")
+
+	done := readHDF5(t, f, readHDF5(t, f, root.links["Flags"]).links["Done"])
+	assert.Equal(t, byte(8), done.class)
+	assert.Equal(t, []byte{0, 0, 1}, done.data)
+
+	b.Reset()
+	tsr := tensor.NewFloat64(0)
+	SetTensor(dir, tsr, "Empty")
+	assert.NoError(t, WriteHDF5(&b, dir, func(nd *Node) bool { return nd.name == "Empty" }))
+	root = readHDF5(t, b.Bytes(), le.Uint64(b.Bytes()[36:]))
+	assert.Equal(t, []string{"Empty"}, root.names)
+}
+
+func TestZip(t *testing.T) {
+	dir := makeRunsNode(t)
+	dir.Link("Link", "Stats")
+	var b bytes.Buffer
+	assert.NoError(t, Zip(&b, dir, nil))
+	ndir, err := NewDir("root")
+	assert.NoError(t, err)
+	assert.NoError(t, Unzip(bytes.NewReader(b.Bytes()), int64(b.Len()), ndir))
+	assert.Equal(t, dir.Node("Stats").ListAll(), ndir.Node("Stats").ListAll())
+	assert.Nil(t, ndir.Node("Link"))
+	assert.Equal(t, 1.0, ndir.Node("Stats").Node("Run1").Node("Train").Value("Err").Float1D(0))
+}
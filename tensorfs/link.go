@@ -0,0 +1,130 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+)
+
+// maxLinks is the maximum number of links that are followed in
+// resolving a link, to prevent infinite loops from circular links.
+const maxLinks = 40
+
+// Link makes a new link node in this directory with given name, which
+// refers to the node at given target path, so that the same node can appear
+// in multiple places, e.g., organized by condition and by date. The target path
+// is relative to this directory, and can use .. to refer to parent directories,
+// or it can start with / to be relative to the root directory. The target is
+// resolved each time the link is accessed, so it does not need to exist yet.
+// Access through the link, including [Node.Value], [Node.Dir] and the [fs] methods,
+// uses the target node, but recursive operations such as [Node.ValuesFunc],
+// [Node.Find] and [Node.Snapshot] do not follow links, so that the linked nodes
+// are only included once, in their actual location.
+// If a node of the given name already exists, it is returned with [fs.ErrExist].
+func (dir *Node) Link(name, target string) (*Node, error) {
+	if target == "" {
+		return nil, &fs.PathError{Op: "Link", Path: name, Err: errors.New("target path must not be empty")}
+	}
+	return newNode(dir, name, func(nd *Node) { nd.link = target })
+}
+
+// IsLink returns true if this node is a link made by [Node.Link].
+func (nd *Node) IsLink() bool {
+	return nd.link != ""
+}
+
+// LinkTarget returns the target path of this link node,
+// or an empty string if it is not a link.
+func (nd *Node) LinkTarget() string {
+	return nd.link
+}
+
+// Resolve returns the node that this link node refers to, following any
+// further links, or this node if it is not a link. It returns nil if the
+// target does not exist.
+func (nd *Node) Resolve() *Node {
+	return nd.resolveLinks(0)
+}
+
+// target returns the node that this node refers to, following links,
+// or this node if the target does not exist.
+func (nd *Node) target() *Node {
+	if nd.link == "" {
+		return nd
+	}
+	if t := nd.resolveLinks(0); t != nil {
+		return t
+	}
+	return nd
+}
+
+// resolve returns the [Node.target] of this node, and loads
+// the contents of the target if it is from a mounted filesystem.
+func (nd *Node) resolve() *Node {
+	t := nd.target()
+	if t.source != nil {
+		t.source.load(t)
+	}
+	return t
+}
+
+// resolveLinks returns the node that this node refers to, following
+// links, with given current depth of links that have been followed,
+// returning nil if not found.
+func (nd *Node) resolveLinks(depth int) *Node {
+	cur := nd
+	for cur.link != "" {
+		if depth >= maxLinks {
+			return nil
+		}
+		depth++
+		start, pth := cur.ParentDir(), cur.link
+		if strings.HasPrefix(pth, "/") {
+			for start != nil && start.ParentDir() != nil {
+				start = start.ParentDir()
+			}
+		}
+		if start == nil {
+			return nil
+		}
+		cur = start.walkPath(pth, depth)
+		if cur == nil {
+			return nil
+		}
+	}
+	return cur
+}
+
+// walkPath returns the node at given path relative to this directory,
+// following links, with given current depth of links that have been followed,
+// returning nil if not found.
+func (dir *Node) walkPath(pth string, depth int) *Node {
+	cur := dir
+	for _, seg := range strings.Split(pth, "/") {
+		switch seg {
+		case "", ".":
+			continue
+		case "..":
+			if cur.ParentDir() == nil {
+				return nil
+			}
+			cur = cur.ParentDir()
+			continue
+		}
+		if !cur.IsDir() {
+			return nil
+		}
+		it, ok := cur.nodeAt(seg)
+		if !ok {
+			return nil
+		}
+		if cur = it.resolveLinks(depth); cur == nil {
+			return nil
+		}
+	}
+	return cur
+}
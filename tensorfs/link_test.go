@@ -0,0 +1,69 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLink(t *testing.T) {
+	dir := makeRunsNode(t)
+	byCond := dir.Dir("ByCond/Train")
+	ln, err := byCond.Link("Run0", "../../Stats/Run0/Train")
+	assert.NoError(t, err)
+	assert.True(t, ln.IsLink())
+	assert.True(t, ln.IsDir())
+	assert.Equal(t, dir.Node("Stats").Node("Run0").Node("Train"), ln.Resolve())
+	assert.Equal(t, 1.0, dir.Node("Stats").Node("Run1").Node("Train").Value("Err").Float1D(0))
+
+	abs, err := dir.Dir("ByDate").Link("Err", "/Stats/Run1/Train/Err")
+	assert.NoError(t, err)
+	assert.False(t, abs.IsDir())
+	assert.Equal(t, 1.0, dir.Node("ByDate").Value("Err").Float1D(0))
+	dir.Node("ByDate").Value("Err").SetFloat1D(2, 0)
+	assert.Equal(t, 2.0, dir.Node("Stats").Node("Run1").Node("Train").Value("Err").Float1D(0))
+
+	nd, err := dir.NodeAtPath("ByCond/Train/Run0/Loss")
+	assert.NoError(t, err)
+	assert.Equal(t, dir.Node("Stats").Node("Run0").Node("Train").Node("Loss"), nd)
+	assert.Equal(t, "Run0 -> ../../Stats/Run0/Train\n", byCond.List(Long, DirOnly))
+
+	// links are not followed in recursive operations
+	assert.Equal(t, 10, len(dir.ValuesFunc(nil)))
+	nds, err := dir.Glob("**/Loss")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(nds))
+	nds, err = dir.Find("name=Err")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(nds))
+
+	// dangling and circular links
+	dang, err := dir.Link("Dangling", "Nope")
+	assert.NoError(t, err)
+	assert.Nil(t, dang.Resolve())
+	assert.False(t, dang.IsDir())
+	_, err = dir.Link("A", "B")
+	assert.NoError(t, err)
+	_, err = dir.Link("B", "A")
+	assert.NoError(t, err)
+	assert.Nil(t, dir.Node("A").Resolve())
+	_, err = dir.Link("Empty", "")
+	assert.Error(t, err)
+	_, err = dir.Link("A", "Stats")
+	assert.ErrorIs(t, err, fs.ErrExist)
+
+	fname := filepath.Join(t.TempDir(), "links.tfs")
+	assert.NoError(t, Save(dir, fname))
+	ld, err := Load(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, "../../Stats/Run0/Train", ld.Node("ByCond").Node("Train").Node("Run0").LinkTarget())
+	assert.Equal(t, 2.0, ld.Node("ByDate").Value("Err").Float1D(0))
+	cp := dir.Node("ByDate").Clone()
+	assert.Equal(t, "/Stats/Run1/Train/Err", cp.Node("Err").LinkTarget())
+}
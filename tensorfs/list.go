@@ -29,8 +29,11 @@ const (
 )
 
 func (nd *Node) String() string {
+	if nd.IsLink() {
+		return nd.name + " -> " + nd.link
+	}
 	if !nd.IsDir() {
-		lb := nd.Tensor.Label()
+		lb := nd.TensorValue().Label()
 		if !strings.HasPrefix(lb, nd.name) {
 			lb = nd.name + " " + lb
 		}
@@ -61,7 +64,7 @@ func (dir *Node) listShort(recursive bool, ident int) string {
 	for _, it := range nodes {
 		b.WriteString(indent.Tabs(ident))
 		if it.IsDir() {
-			if recursive {
+			if recursive && !it.IsLink() {
 				b.WriteString("\n" + it.listShort(recursive, ident+1))
 			} else {
 				b.WriteString(it.name + "/ ")
@@ -79,14 +82,40 @@ func (dir *Node) listLong(recursive bool, ident int) string {
 	nodes, _ := dir.Nodes()
 	for _, it := range nodes {
 		b.WriteString(indent.Tabs(ident))
-		if it.IsDir() {
+		switch {
+		case it.IsLink():
+			b.WriteString(it.name + " -> " + it.link + "\n")
+		case it.IsDir():
 			b.WriteString(it.name + "/\n")
 			if recursive {
 				b.WriteString(it.listLong(recursive, ident+1))
 			}
-		} else {
+		default:
 			b.WriteString(it.String() + "\n")
 		}
 	}
 	return b.String()
 }
+
+// listPaths returns a listing of the paths of the given nodes,
+// relative to this directory.
+func (dir *Node) listPaths(nds []*Node, long bool) string {
+	var b strings.Builder
+	for _, it := range nds {
+		pth := dir.relPath(it)
+		switch {
+		case long && it.IsLink():
+			pth += " -> " + it.link
+		case it.IsDir():
+			pth += "/"
+		case long:
+			pth += " " + strings.TrimSpace(strings.TrimPrefix(it.TensorValue().Label(), it.name))
+		}
+		if long {
+			b.WriteString(pth + "\n")
+		} else {
+			b.WriteString(pth + " ")
+		}
+	}
+	return b.String()
+}
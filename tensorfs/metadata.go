@@ -22,17 +22,26 @@ func (d *Node) SetMetaItems(key string, value any, names ...string) error {
 	return err
 }
 
-// CalcAll calls function set by [Node.SetCalcFunc] for all items
-// in this directory and all of its subdirectories.
-// Calls Calc on items from ValuesFunc(nil)
+// CalcAll computes all of the computed values set by [Node.SetCompute]
+// in this directory and all of its subdirectories whose inputs have changed,
+// in dependency order, as in [Node.Calc]. It also calls the function set by
+// [tensor.SetCalcFunc] for all values that have one, in directory order.
 func (d *Node) CalcAll() error {
-	var errs []error
-	items := d.ValuesFunc(nil)
-	for _, it := range items {
-		err := tensor.Calc(it)
-		if err != nil {
-			errs = append(errs, err)
+	return runCalc(d, d, func(cr *calcRun) error {
+		var errs []error
+		for _, nd := range d.NodesFunc(nil) {
+			if nd.IsComputed() {
+				errs = append(errs, nd.calc(cr))
+				continue
+			}
+			tsr := nd.TensorValue()
+			if tsr == nil {
+				continue
+			}
+			if _, ok := (*tsr.Metadata())["CalcFunc"]; ok {
+				errs = append(errs, tensor.Calc(tsr))
+			}
 		}
-	}
-	return errors.Join(errs...)
+		return errors.Join(errs...)
+	})
 }
@@ -0,0 +1,175 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+)
+
+// Mount makes a new directory node in this directory with given name,
+// with the contents of the given filesystem, which can be any [fs.FS],
+// including an OS directory (see [Node.MountDir]) or a zip file
+// (see [archive/zip.Reader]). The contents are only read on demand,
+// when first accessed: subdirectories become directory nodes, .csv and
+// .tsv files become directories with a value for each table column, with
+// the table as the [Node.DirTable], and .npy files become values, read
+// using [tensor.ReadNPY]. Other files are not included, and errors are
+// logged. Recursive operations, such as [Node.ValuesFunc], read all the
+// contents. Changes to the mounted nodes do not affect the filesystem.
+// If a node of the given name already exists, it is returned with [fs.ErrExist].
+func (dir *Node) Mount(name string, fsys fs.FS) (*Node, error) {
+	return newNode(dir, name, func(nd *Node) {
+		nd.nodes = &Nodes{}
+		nd.source = &source{fsys: fsys, path: "."}
+	})
+}
+
+// MountDir makes a new directory node in this directory with given name,
+// with the contents of the given OS directory, using [Node.Mount].
+func (dir *Node) MountDir(name, osDir string) (*Node, error) {
+	return dir.Mount(name, os.DirFS(osDir))
+}
+
+// source is a file or directory in a mounted filesystem,
+// which is the source of the contents of a node,
+// loaded on demand by [Node.resolve].
+type source struct {
+	// fsys is the mounted filesystem.
+	fsys fs.FS
+
+	// path is the path of the file or directory within fsys.
+	path string
+
+	// table is whether this is a CSV or TSV table file.
+	table bool
+
+	// once ensures that the contents are only loaded once.
+	once sync.Once
+
+	// loaded is set after the contents have been loaded.
+	loaded atomic.Bool
+}
+
+// load loads the contents of given node from the source, if not already loaded.
+func (src *source) load(nd *Node) {
+	src.once.Do(func() {
+		switch {
+		case nd.nodes == nil:
+			tsr, err := openNPY(src.fsys, src.path)
+			if errors.Log(err) == nil {
+				metadata.SetName(tsr, nd.name)
+				nd.mu.Lock()
+				nd.Tensor = tsr
+				nd.mu.Unlock()
+			}
+		case src.table:
+			src.loadTable(nd)
+		default:
+			src.loadDir(nd)
+		}
+		src.loaded.Store(true)
+	})
+}
+
+// loadDir adds a node for each supported entry in the source directory.
+func (src *source) loadDir(nd *Node) {
+	ents, err := fs.ReadDir(src.fsys, src.path)
+	if errors.Log(err) != nil {
+		return
+	}
+	var its []*Node
+	for _, ent := range ents {
+		name := ent.Name()
+		it := &Node{Parent: nd, name: name, source: &source{fsys: src.fsys, path: path.Join(src.path, name)}}
+		if info, err := ent.Info(); err == nil {
+			it.modTime = info.ModTime()
+		}
+		switch {
+		case ent.IsDir():
+			it.nodes = &Nodes{}
+		case tableDelim(name) >= 0:
+			it.nodes = &Nodes{}
+			it.source.table = true
+		case strings.EqualFold(path.Ext(name), ".npy"):
+		default:
+			continue
+		}
+		its = append(its, it)
+	}
+	nd.addLoaded(its)
+}
+
+// loadTable adds a node for each column of the source CSV or TSV table.
+func (src *source) loadTable(nd *Node) {
+	dt := table.New(nd.name)
+	if errors.Log(dt.OpenFS(src.fsys, src.path, tableDelim(src.path))) != nil {
+		return
+	}
+	its := make([]*Node, len(dt.Columns.Values))
+	for i, cl := range dt.Columns.Values {
+		name := dt.Columns.Keys[i]
+		metadata.SetName(cl, name)
+		its[i] = &Node{Parent: nd, name: name, modTime: nd.modTime, Tensor: cl}
+	}
+	nd.addLoaded(its)
+	nd.setDirTable(dt)
+}
+
+// addLoaded adds the given loaded nodes to this directory,
+// without sending events.
+func (nd *Node) addLoaded(its []*Node) {
+	nd.mu.Lock()
+	defer nd.mu.Unlock()
+	for _, it := range its {
+		nd.nodes.Add(it.name, it)
+	}
+}
+
+// isLoaded returns true if the node is not from a mounted filesystem,
+// or its contents have already been loaded.
+func (nd *Node) isLoaded() bool {
+	return nd.source == nil || nd.source.loaded.Load()
+}
+
+// sourceSize returns the size of the source file for this node.
+func (nd *Node) sourceSize() int64 {
+	info, err := fs.Stat(nd.source.fsys, nd.source.path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// tableDelim returns the delimiter for the table file of given name,
+// based on the extension, or -1 if it is not a table file.
+func tableDelim(name string) tensor.Delims {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".csv":
+		return tensor.Comma
+	case ".tsv":
+		return tensor.Tab
+	}
+	return -1
+}
+
+// openNPY opens the .npy file of given name in given filesystem.
+func openNPY(fsys fs.FS, filename string) (tensor.Values, error) {
+	f, err := fsys.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return tensor.ReadNPY(f)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bytes"
+	"testing"
+	"testing/fstest"
+
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMount(t *testing.T) {
+	var npy bytes.Buffer
+	assert.NoError(t, tensor.WriteNPY(&npy, tensor.NewFloat64FromValues(1, 2, 3)))
+	fsys := fstest.MapFS{
+		"data/trials.csv":   {Data: []byte("Trial,Err\n0,0.5\n1,0.25\n")},
+		"data/sub/wts.npy":  {Data: npy.Bytes()},
+		"data/sub/acts.tsv": {Data: []byte("Act\n1\n2\n3\n")},
+		"data/notes.txt":    {Data: []byte("ignored")},
+	}
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	mnt, err := dir.Mount("mnt", fsys)
+	assert.NoError(t, err)
+	assert.True(t, mnt.IsDir())
+	assert.False(t, mnt.isLoaded())
+
+	data := mnt.Node("data")
+	assert.True(t, mnt.isLoaded())
+	assert.Equal(t, []string{"sub", "trials.csv"}, data.nodeNames())
+	wts := data.Node("sub").Node("wts.npy")
+	assert.False(t, wts.isLoaded())
+	assert.Equal(t, int64(npy.Len()), wts.Size())
+	assert.Equal(t, 3.0, data.Node("sub").Value("wts.npy").Float1D(2))
+	assert.True(t, wts.isLoaded())
+
+	trials := data.Node("trials.csv")
+	assert.True(t, trials.IsDir())
+	assert.Equal(t, 0.25, trials.Value("Err").Float1D(1))
+	assert.Equal(t, 2, trials.DirTable.NumRows())
+
+	assert.Equal(t, 4, len(mnt.ValuesFunc(nil)))
+	nds, err := dir.Glob("mnt/**/Act")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(nds))
+
+	// changes only affect the nodes
+	trials.Float64("New", 2)
+	assert.Equal(t, []string{"Trial", "Err", "New"}, trials.nodeNames())
+}
@@ -4,10 +4,15 @@
 
 package tensorfs
 
+//go:generate core generate
+
 import (
 	"io/fs"
 	"path"
 	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cogentcore.org/core/base/errors"
@@ -21,28 +26,87 @@ import (
 // a [tensor] Value as a "file" equivalent, or a "directory" containing other Nodes.
 // The [tensor.Tensor] can represent everything from a single scalar value up to
 // n-dimensional collections of patterns, in a range of data types.
-// Directories have an ordered map of nodes.
+// Directories have an ordered map of nodes, and it is safe to add,
+// remove and look up nodes in directories from multiple goroutines,
+// but the Tensor values themselves must be protected separately.
+// Changes to nodes are sent to functions registered with [Node.Watch].
 type Node struct {
-	// Parent is the parent data directory.
+	// Parent is the parent data directory. It is protected by the
+	// node's lock, and is set by [Node.Add] and cleared by [Node.Delete]:
+	// use [Node.ParentDir] to access it safely.
 	Parent *Node
 
 	// name is the name of this node.  it is not a path.
 	name string
 
-	// modTime tracks time added to directory, used for ordering.
+	// modTime tracks time added to directory, used for ordering,
+	// protected by mu.
 	modTime time.Time
 
 	// Tensor is the tensor value for a file or leaf Node in the FS,
 	// represented using the universal [tensor] data type of
 	// [tensor.Tensor], which can represent anything from a scalar
 	// to n-dimensional data, in a range of data types.
+	// Use [Node.TensorValue] to get the value of links and mounted files.
 	Tensor tensor.Tensor
 
 	// nodes is for directory nodes, with all the nodes in the directory.
 	nodes *Nodes
 
+	// link is the target path for a link node made by [Node.Link].
+	link string
+
+	// source is the source of the contents of a node in a mounted
+	// filesystem made by [Node.Mount], which are loaded on demand.
+	source *source
+
+	// mu protects the nodes, watchers and replacement of the Tensor.
+	mu sync.RWMutex
+
+	// watchers are the functions registered by [Node.Watch], by id.
+	watchers map[uint64]func(ev Event)
+
+	// keepVersions is the number of previous versions of values to keep,
+	// set by [Node.KeepVersions].
+	keepVersions int
+
+	// versions are the previous versions of the Tensor value,
+	// protected by mu.
+	versions []Version
+
+	// valueTime is the time the current Tensor was set by replacing
+	// a previous one, or last updated while keeping versions, or zero
+	// if never replaced, protected by mu.
+	valueTime time.Time
+
+	// shared is whether the Tensor is shared with a [Snapshot] or is to
+	// be kept as a version, so that it must be cloned before it is
+	// written, as done by [Node.writable], protected by mu.
+	shared bool
+
+	// snapshots are the snapshots of a directory made by
+	// [Node.Snapshot], protected by mu.
+	snapshots []*Snapshot
+
+	// revision is incremented each time the value is replaced or
+	// updated, as returned by [Node.Revision].
+	revision atomic.Uint64
+
+	// compute is the computation of a computed value set by
+	// [Node.SetCompute], protected by mu.
+	compute *compute
+
+	// calcs is the state of the computations of computed values in
+	// the tree of a root directory, protected by mu.
+	calcs *calcTree
+
+	// mapped is the memory-mapped file data of a root directory
+	// returned by [Load], which is released by [Node.Close].
+	mapped *tensor.Mmap[byte]
+
 	// DirTable is a summary [table.Table] with columns comprised of Value
 	// nodes in the directory, which can be used for plotting or other operations.
+	// It is set by [DirTable], protected by mu.
 	DirTable *table.Table
 }
 
@@ -50,19 +114,33 @@ type Node struct {
 // If dir is not a directory, returns nil and an error.
 // If an node already exists in dir with that name, that node is returned
 // with an [fs.ErrExist] error, and the caller can decide how to proceed.
-// The modTime is set to now. The name must be unique within parent.
-func newNode(dir *Node, name string) (*Node, error) {
+// Otherwise, the given init function (if non-nil) is called to initialize
+// the new node before it is added to the directory, and an [EventAdded]
+// event is sent. The modTime is set to now. The name must be unique within parent.
+func newNode(dir *Node, name string, init func(nd *Node)) (*Node, error) {
 	if dir == nil {
-		return &Node{name: name, modTime: time.Now()}, nil
+		nd := &Node{name: name, modTime: time.Now()}
+		if init != nil {
+			init(nd)
+		}
+		return nd, nil
 	}
 	if err := dir.mustDir("newNode", name); err != nil {
 		return nil, err
 	}
+	dir = dir.resolve()
+	dir.mu.Lock()
 	if ex, ok := dir.nodes.AtTry(name); ok {
+		dir.mu.Unlock()
 		return ex, fs.ErrExist
 	}
 	d := &Node{Parent: dir, name: name, modTime: time.Now()}
+	if init != nil {
+		init(d)
+	}
 	dir.nodes.Add(name, d)
+	dir.mu.Unlock()
+	d.send(EventAdded)
 	return d, nil
 }
 
@@ -72,19 +150,34 @@ func newNode(dir *Node, name string) (*Node, error) {
 // type or sizes provided, for efficiency -- if there is doubt, check!),
 // otherwise a new tensor is created. It is fine to not pass any sizes and
 // use `SetShapeSizes` method later to set the size.
+// An existing tensor that is shared with a [Snapshot], or kept as a version
+// (see [Node.KeepVersions]), is first replaced with a copy, so that it can
+// be written without affecting the snapshot: values must be obtained from
+// this or the other value creation functions (e.g., [Node.Float64]) for
+// writing, not from [Node.Value] or a tensor obtained before the snapshot.
 func Value[T tensor.DataTypes](dir *Node, name string, sizes ...int) tensor.Values {
-	it := dir.Node(name)
-	if it != nil {
-		return it.Tensor.(tensor.Values)
+	return newValue(dir, name, func() tensor.Values { return tensor.New[T](sizes...) })
+}
+
+// newValue returns the tensor for the existing value Node with given name
+// in given directory, or a new one made by the given function.
+// An existing tensor is returned for writing, using [Node.writable].
+func newValue(dir *Node, name string, mk func() tensor.Values) tensor.Values {
+	if it, ok := dir.nodeAt(name); ok {
+		return it.writable().(tensor.Values)
+	}
+	nd, err := newNode(dir, name, func(nd *Node) {
+		tsr := mk()
+		metadata.SetName(tsr, name)
+		nd.Tensor = tsr
+	})
+	if err == fs.ErrExist {
+		return nd.writable().(tensor.Values)
 	}
-	tsr := tensor.New[T](sizes...)
-	metadata.SetName(tsr, name)
-	nd, err := newNode(dir, name)
 	if errors.Log(err) != nil {
 		return nil
 	}
-	nd.Tensor = tsr
-	return tsr
+	return nd.TensorValue().(tensor.Values)
 }
 
 // NewValues makes new tensor Node value(s) (as a [tensor.Tensor])
@@ -115,29 +208,16 @@ func Scalar[T tensor.DataTypes](dir *Node, name string) tensor.Values {
 // otherwise a new tensor is created. It is fine to not pass any sizes and
 // use `SetShapeSizes` method later to set the size.
 func ValueType(dir *Node, name string, typ reflect.Kind, sizes ...int) tensor.Values {
-	it := dir.Node(name)
-	if it != nil {
-		return it.Tensor.(tensor.Values)
-	}
-	tsr := tensor.NewOfType(typ, sizes...)
-	metadata.SetName(tsr, name)
-	nd, err := newNode(dir, name)
-	if errors.Log(err) != nil {
-		return nil
-	}
-	nd.Tensor = tsr
-	return tsr
+	return newValue(dir, name, func() tensor.Values { return tensor.NewOfType(typ, sizes...) })
 }
 
 // SetTensor creates / recycles a node and sets to given existing tensor with given name.
+// An [EventReplaced] event is sent if an existing node has a different tensor.
 func SetTensor(dir *Node, tsr tensor.Tensor, name string) *Node {
-	nd := dir.Node(name)
-	if nd != nil {
-		nd.Tensor = tsr
-	} else {
-		nd, _ = newNode(dir, name)
+	nd, err := newNode(dir, name, func(nd *Node) { nd.Tensor = tsr })
+	if err == fs.ErrExist {
+		nd.setTensor(tsr)
 	}
-	nd.Tensor = tsr
 	return nd
 }
 
@@ -152,37 +232,86 @@ func (dir *Node) Set(name string, tsr tensor.Tensor) *Node {
 // and any subdirectories, using given filter function.
 // This is a convenient mechanism for creating a plot of all the data
 // in a given directory.
-// If such was previously constructed, it is returned from "DirTable"
-// where it is stored for later use.
-// Row count is updated to current max row.
-// Set DirTable = nil to regenerate.
+// If such was previously constructed with the same column tensors,
+// it is returned from "DirTable" where it is stored for later use,
+// and otherwise it is rebuilt, for example after a value is replaced.
+// Row count is updated to current max row, which resizes any shorter
+// column tensors: use [DirTableView] for values that are being written
+// by other goroutines. Set DirTable = nil to regenerate.
 func DirTable(dir *Node, fun func(node *Node) bool) *table.Table {
-	nds := dir.NodesFunc(fun)
-	if dir.DirTable != nil {
-		if dir.DirTable.NumColumns() == len(nds) {
-			dir.DirTable.SetNumRowsToMax()
-			return dir.DirTable
-		}
+	dir = dir.resolve()
+	names, vals := dirColumns(dir, fun)
+	name := fsx.DirAndFile(string(dir.Path()))
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	if dt := dir.DirTable; dt != nil && slices.Equal(dt.Columns.Keys, names) &&
+		slices.EqualFunc(dt.Columns.Values, vals, func(a, b tensor.Values) bool { return any(a) == any(b) }) {
+		dt.SetNumRowsToMax()
+		return dt
 	}
-	dt := table.New(fsx.DirAndFile(string(dir.Path())))
-	for _, it := range nds {
-		if it.Tensor == nil || it.Tensor.NumDims() == 0 {
-			continue
-		}
-		tsr := it.Tensor
+	dt := table.New(name)
+	for i, tsr := range vals {
 		rows := tsr.DimSize(0)
 		if dt.Columns.Rows < rows {
 			dt.Columns.Rows = rows
 			dt.SetNumRows(dt.Columns.Rows)
 		}
+		dt.AddColumn(names[i], tsr)
+	}
+	dir.DirTable = dt
+	return dt
+}
+
+// DirTableView returns a new [table.Table] with the same columns as
+// [DirTable], sharing the tensor values without modifying them: the number
+// of rows is the minimum across the columns, so the columns are not resized,
+// and the [Node.DirTable] is not set. This is suitable for viewing values
+// that are being written by other goroutines, as in the plotcore Editor.
+func DirTableView(dir *Node, fun func(node *Node) bool) *table.Table {
+	dir = dir.resolve()
+	names, vals := dirColumns(dir, fun)
+	dt := table.New(fsx.DirAndFile(string(dir.Path())))
+	for i, tsr := range vals {
+		if rows := tsr.DimSize(0); i == 0 || rows < dt.Columns.Rows {
+			dt.Columns.Rows = rows
+		}
+		dt.Columns.Add(names[i], tsr)
+	}
+	return dt
+}
+
+// dirColumns returns the column names and tensor values for [DirTable]
+// of the values under given directory, using given filter function.
+func dirColumns(dir *Node, fun func(node *Node) bool) ([]string, []tensor.Values) {
+	var names []string
+	var vals []tensor.Values
+	for _, it := range dir.NodesFunc(fun) {
+		tsr := it.TensorValue()
+		if tsr == nil || tsr.NumDims() == 0 {
+			continue
+		}
 		nm := it.name
-		if it.Parent != dir {
+		if it.ParentDir() != dir {
 			nm = fsx.DirAndFile(string(it.Path()))
 		}
-		dt.AddColumn(nm, tsr.AsValues())
+		names = append(names, nm)
+		vals = append(vals, tsr.AsValues())
 	}
+	return names, vals
+}
+
+// setDirTable sets the [Node.DirTable] under the node lock.
+func (dir *Node) setDirTable(dt *table.Table) {
+	dir.mu.Lock()
 	dir.DirTable = dt
-	return dt
+	dir.mu.Unlock()
+}
+
+// dirTable returns the [Node.DirTable] under the node lock.
+func (dir *Node) dirTable() *table.Table {
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	return dir.DirTable
 }
 
 // DirFromTable sets tensor values under given directory node to the
@@ -196,13 +325,13 @@ func DirFromTable(dir *Node, dt *table.Table) {
 			dr = path.Dir(nm)
 			pdir = dir.Dir(dr)
 		}
-		nd, err := newNode(pdir, fn)
-		if err == nil || err == fs.ErrExist {
-			metadata.SetName(cl, fn)
-			nd.Tensor = cl
+		metadata.SetName(cl, fn)
+		nd, err := newNode(pdir, fn, func(nd *Node) { nd.Tensor = cl })
+		if err == fs.ErrExist {
+			nd.setTensor(cl)
 		}
 	}
-	dir.DirTable = dt
+	dir.setDirTable(dt)
 }
 
 // Float64 creates / returns a Node with given name as a [tensor.Float64]
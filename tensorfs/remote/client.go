@@ -0,0 +1,318 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+)
+
+// Client accesses a [tensorfs] directory tree served by a [Server].
+type Client struct {
+	// URL is the base URL of the server, e.g., http://localhost:8080.
+	URL string
+
+	// HTTP is the HTTP client used for requests.
+	HTTP *http.Client
+}
+
+// NewClient returns a new [Client] for the [Server] at given base URL.
+func NewClient(url string) *Client {
+	return &Client{URL: strings.TrimSuffix(url, "/"), HTTP: http.DefaultClient}
+}
+
+// List returns the [Info] for the nodes in the remote directory
+// at given path, in directory order.
+func (cl *Client) List(pth string) ([]Info, error) {
+	var infos []Info
+	return infos, cl.getJSON(listOp, pth, &infos)
+}
+
+// Stat returns the [Info] for the remote node at given path.
+func (cl *Client) Stat(pth string) (*Info, error) {
+	inf := &Info{}
+	return inf, cl.getJSON(statOp, pth, inf)
+}
+
+// Value returns a copy of the value of the remote node at given path.
+// The metadata of the value is not included.
+func (cl *Client) Value(pth string) (tensor.Values, error) {
+	resp, err := cl.get(context.Background(), valueOp, pth)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	tsr, err := fromBinary(b)
+	if err != nil {
+		return nil, fmt.Errorf("tensorfs remote: invalid value for %q: %w", pth, err)
+	}
+	return tsr, nil
+}
+
+// fromBinary returns the tensor for given [tensor.ToBinary] encoding,
+// after checking that the header, shape and data are consistent with
+// the length of the data, so that invalid data from the server does not
+// make [tensor.FromBinary] read out of bounds.
+func fromBinary(b []byte) (tensor.Values, error) {
+	hdr := func(i int) int {
+		var v int64
+		for j := range 8 {
+			v |= int64(b[i*8+j]) << (8 * j)
+		}
+		return int(v)
+	}
+	if len(b) < 16 {
+		return nil, errors.New("missing header")
+	}
+	kind, ndims := reflect.Kind(hdr(0)), hdr(1)
+	if ndims < 0 || ndims > 16 || len(b) < 8*(ndims+2) {
+		return nil, errors.New("invalid number of dimensions")
+	}
+	sizes := make([]int, ndims)
+	n := 1
+	for i := range sizes {
+		sz := hdr(i + 2)
+		if sz < 0 || sz > 8*len(b) {
+			return nil, errors.New("invalid shape")
+		}
+		sizes[i] = sz
+		n *= sz
+		if n > 8*len(b) { // at most 8 bool values per byte
+			return nil, errors.New("shape is larger than the data")
+		}
+	}
+	data := b[8*(ndims+2):]
+	size := 0
+	switch kind {
+	case reflect.String:
+		// each string is an 8 byte length followed by its bytes
+		off := 0
+		for range n {
+			if len(data)-off < 8 {
+				return nil, errors.New("invalid string data")
+			}
+			var l int64
+			for j := range 8 {
+				l |= int64(data[off+j]) << (8 * j)
+			}
+			if l < 0 || l > int64(len(data)-off-8) {
+				return nil, errors.New("invalid string data")
+			}
+			off += 8 + int(l)
+		}
+		if off != len(data) {
+			return nil, errors.New("invalid string data")
+		}
+	case reflect.Float64, reflect.Int, reflect.Int64, reflect.Uint64:
+		size = 8
+	case reflect.Float32, reflect.Int32, reflect.Uint32:
+		size = 4
+	case reflect.Uint8:
+		size = 1
+	case reflect.Bool: // bit-packed, after a byte with the number of bits in the last byte
+		if len(data) != (n+7)/8+1 {
+			return nil, errors.New("data length does not match the shape")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", kind)
+	}
+	if size > 0 && len(data) != n*size {
+		return nil, errors.New("data length does not match the shape")
+	}
+	if n == 0 {
+		return tensor.NewOfType(kind, sizes...), nil
+	}
+	return tensor.FromBinary(b), nil
+}
+
+// Watch calls given function for each [Event] on the remote node at
+// given path and all nodes within it, until the given context is done,
+// or the connection is closed, which returns an error.
+func (cl *Client) Watch(ctx context.Context, pth string, fun func(ev Event)) error {
+	resp, err := cl.get(ctx, watchOp, pth)
+	if err != nil {
+		return err
+	}
+	return readEvents(ctx, resp.Body, fun)
+}
+
+// Copy copies the remote directory at given path and everything within
+// it into given local directory, replacing the values of any existing
+// nodes with the same paths.
+func (cl *Client) Copy(pth string, dir *tensorfs.Node) error {
+	infos, err := cl.List(pth)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, inf := range infos {
+		ipth := path.Join(pth, inf.Name)
+		if inf.IsDir {
+			errs = append(errs, cl.Copy(ipth, dir.Dir(inf.Name)))
+			continue
+		}
+		tsr, err := cl.Value(ipth)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		dir.Set(inf.Name, tsr)
+	}
+	return errors.Join(errs...)
+}
+
+// Mount copies the remote directory at given path into given local
+// directory using [Client.Copy], and then keeps the local directory in
+// sync with the remote one in a separate goroutine, until the given context
+// is done. Changes are made using the [tensorfs.Node] methods, so functions
+// registered with [tensorfs.Node.Watch] on the local directory, including
+// the lab browser and plots, are automatically updated. Updated values are
+// copied into the existing local values where possible, followed by
+// [tensorfs.Node.SendUpdate]. Errors during syncing are logged, and syncing
+// stops if the connection is closed.
+func (cl *Client) Mount(ctx context.Context, pth string, dir *tensorfs.Node) error {
+	resp, err := cl.get(ctx, watchOp, pth)
+	if err != nil {
+		return err
+	}
+	if err := cl.Copy(pth, dir); err != nil {
+		resp.Body.Close()
+		return err
+	}
+	go func() {
+		err := readEvents(ctx, resp.Body, func(ev Event) {
+			errors.Log(cl.apply(pth, dir, ev))
+		})
+		if ctx.Err() == nil {
+			errors.Log(err)
+		}
+	}()
+	return nil
+}
+
+// apply applies given event on the remote directory at given path
+// to given local directory.
+func (cl *Client) apply(pth string, dir *tensorfs.Node, ev Event) error {
+	if ev.Path == "." {
+		if ev.Type == tensorfs.EventRemoved {
+			return nil
+		}
+		return cl.Copy(pth, dir)
+	}
+	rpth := path.Join(pth, ev.Path)
+	pdir, name := dir, path.Base(ev.Path)
+	if dp := path.Dir(ev.Path); dp != "." {
+		pdir = dir.Dir(dp)
+	}
+	if ev.Type == tensorfs.EventRemoved {
+		if pdir.Node(name) != nil {
+			return pdir.Delete(name)
+		}
+		return nil
+	}
+	inf, err := cl.Stat(rpth)
+	if err != nil {
+		return err
+	}
+	if inf.IsDir {
+		return cl.Copy(rpth, pdir.Dir(name))
+	}
+	tsr, err := cl.Value(rpth)
+	if err != nil {
+		return err
+	}
+	nd := pdir.Node(name)
+	if ev.Type != tensorfs.EventUpdated || nd == nil || nd.IsDir() {
+		pdir.Set(name, tsr)
+		return nil
+	}
+	cur := nd.Sys().(tensor.Tensor).AsValues()
+	if cur.DataType() != tsr.DataType() {
+		pdir.Set(name, tsr)
+		return nil
+	}
+	cur.SetShapeSizes(tsr.ShapeSizes()...)
+	cur.CopyFrom(tsr)
+	nd.SendUpdate()
+	return nil
+}
+
+// get performs a GET request for given operation and path,
+// returning an error for an HTTP error status.
+func (cl *Client) get(ctx context.Context, op, pth string) (*http.Response, error) {
+	if pth = nodePath(pth); pth == "." {
+		pth = ""
+	}
+	u := cl.URL + "/" + op + "/" + (&url.URL{Path: pth}).EscapedPath()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cl.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("tensorfs remote: %s %q: %s", op, pth, bytes.TrimSpace(msg))
+	}
+	return resp, nil
+}
+
+// getJSON performs a GET request for given operation and path,
+// decoding the JSON response into given value.
+func (cl *Client) getJSON(op, pth string, v any) error {
+	resp, err := cl.get(context.Background(), op, pth)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// readEvents reads the server-sent events from given stream,
+// calling given function for each, until the given context is done
+// or the stream ends, which returns an error. The stream is closed.
+func readEvents(ctx context.Context, r io.ReadCloser, fun func(ev Event)) error {
+	defer r.Close()
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		data, ok := strings.CutPrefix(sc.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var ev Event
+		if err := json.Unmarshal([]byte(data), &ev); err != nil {
+			return err
+		}
+		fun(ev)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return io.ErrUnexpectedEOF
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func testServer(t *testing.T) (*tensorfs.Node, *Client) {
+	root, err := tensorfs.NewDir("root")
+	assert.NoError(t, err)
+	stats := root.Dir("stats")
+	stats.Float64("Epoch", 3).SetFloat1D(2, 2)
+	stats.StringValue("Name", 2).SetString1D("b", 1)
+	root.Dir("stats/Trial").Int("Trial", 2, 2).SetInt1D(7, 3)
+	srv := httptest.NewServer(NewServer(root))
+	t.Cleanup(srv.Close)
+	return root, NewClient(srv.URL)
+}
+
+func TestClient(t *testing.T) {
+	_, cl := testServer(t)
+
+	infos, err := cl.List("")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(infos))
+	assert.Equal(t, "stats", infos[0].Name)
+	assert.True(t, infos[0].IsDir)
+
+	infos, err = cl.List("stats")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Epoch", "Name", "Trial"}, []string{infos[0].Name, infos[1].Name, infos[2].Name})
+
+	inf, err := cl.Stat("stats/Trial/Trial")
+	assert.NoError(t, err)
+	assert.Equal(t, "int", inf.Type)
+	assert.Equal(t, []int{2, 2}, inf.Shape)
+
+	tsr, err := cl.Value("stats/Trial/Trial")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2}, tsr.ShapeSizes())
+	assert.Equal(t, 7, tsr.Int1D(3))
+
+	tsr, err = cl.Value("stats/Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", tsr.String1D(1))
+
+	_, err = cl.Value("stats")
+	assert.Error(t, err)
+	_, err = cl.Stat("stats/Missing")
+	assert.Error(t, err)
+
+	local, err := tensorfs.NewDir("local")
+	assert.NoError(t, err)
+	assert.NoError(t, cl.Copy("stats", local))
+	assert.Equal(t, 2.0, local.Value("Epoch").Float1D(2))
+	assert.Equal(t, 7, local.Dir("Trial").Value("Trial").Int1D(3))
+}
+
+func TestMount(t *testing.T) {
+	root, cl := testServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, err := tensorfs.NewDir("local")
+	assert.NoError(t, err)
+	assert.NoError(t, cl.Mount(ctx, "", local))
+	epoch := local.Node("stats").Node("Epoch")
+	assert.Equal(t, 2.0, epoch.Sys().(tensor.Tensor).Float1D(2))
+
+	stats := root.Dir("stats")
+	stats.Float64("Loss", 2).SetFloat1D(0.5, 1)
+	ep := stats.Value("Epoch").(*tensor.Float64)
+	ep.SetNumRows(4)
+	ep.SetFloat1D(3, 3)
+	stats.Node("Epoch").SendUpdate()
+	assert.NoError(t, stats.Delete("Name"))
+
+	eventually := func(cond func() bool) {
+		assert.Eventually(t, cond, 5*time.Second, 10*time.Millisecond)
+	}
+	eventually(func() bool {
+		nd := local.Node("stats").Node("Loss")
+		return nd != nil && nd.Sys().(tensor.Tensor).Float1D(1) == 0.5
+	})
+	eventually(func() bool {
+		tsr := epoch.Sys().(tensor.Tensor)
+		return tsr.Len() == 4 && tsr.Float1D(3) == 3
+	})
+	eventually(func() bool {
+		return local.Node("stats").Node("Name") == nil
+	})
+	assert.Same(t, epoch, local.Node("stats").Node("Epoch"))
+}
+
+func TestFromBinary(t *testing.T) {
+	vals := []tensor.Values{
+		tensor.NewFloat64FromValues(1, 2, 3),
+		tensor.NewIntFromValues(4, 5),
+		tensor.NewStringFromValues("a", "", "bcd"),
+		tensor.NewBoolShape(tensor.NewShape(3)),
+		tensor.NewBoolShape(tensor.NewShape(100)),
+		tensor.NewFloat32(2, 0),
+		tensor.NewString(0),
+	}
+	for _, v := range vals {
+		b := tensor.ToBinary(v)
+		tsr, err := fromBinary(b)
+		assert.NoError(t, err)
+		assert.Equal(t, v.ShapeSizes(), tsr.ShapeSizes())
+		if v.Len() > 0 {
+			assert.Equal(t, v.String(), tsr.String())
+			_, err = fromBinary(b[:len(b)-1])
+			assert.Error(t, err)
+		}
+	}
+	b := tensor.ToBinary(tensor.NewFloat64FromValues(1, 2, 3))
+	bad := func(i int, v byte) []byte {
+		c := append([]byte(nil), b...)
+		c[i] = v
+		return c
+	}
+	for _, c := range [][]byte{b[:8], bad(8, 200), bad(16, 4), bad(16+7, 0x80), bad(0, 99)} {
+		_, err := fromBinary(c)
+		assert.Error(t, err)
+	}
+	// string length past the end
+	b = tensor.ToBinary(tensor.NewStringFromValues("ab"))
+	b[3*8] = 100
+	_, err := fromBinary(b)
+	assert.Error(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bad(16, 200))
+	}))
+	defer srv.Close()
+	_, err = NewClient(srv.URL).Value("x")
+	assert.Error(t, err)
+}
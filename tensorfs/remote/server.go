@@ -0,0 +1,249 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package remote provides access to a [tensorfs] directory tree over HTTP,
+// with a [Server] that exposes a tree, and a [Client] that accesses it
+// and can mount it into a local tree that is kept in sync with the remote one,
+// e.g., to view the live data of a headless simulation in the lab browser.
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"cogentcore.org/lab/tensor"
+	"cogentcore.org/lab/tensorfs"
+)
+
+// The HTTP API provided by the [Server] has the following endpoints,
+// where path is the path of a node relative to the root directory,
+// which is the root itself if empty:
+//
+//   - GET /list/path returns a JSON list of [Info] for the nodes in
+//     the directory, in directory order.
+//   - GET /stat/path returns the JSON [Info] for the node.
+//   - GET /value/path returns the [tensor.ToBinary] encoding of the value.
+//   - GET /watch/path returns a stream of server-sent events, each with
+//     the JSON [Event] for a change to the node or any node within it.
+//
+// Errors are returned with an HTTP error status and a text message.
+const (
+	listOp  = "list"
+	statOp  = "stat"
+	valueOp = "value"
+	watchOp = "watch"
+)
+
+// Info is the information about a node in a remote directory tree.
+type Info struct {
+	// Name is the name of the node.
+	Name string
+
+	// IsDir is whether the node is a directory.
+	IsDir bool
+
+	// Size is the size of the value data in bytes.
+	Size int64
+
+	// ModTime is the modification time of the node.
+	ModTime time.Time
+
+	// Type is the data type of the value, e.g., float64.
+	Type string `json:",omitempty"`
+
+	// Shape is the shape of the value.
+	Shape []int `json:",omitempty"`
+}
+
+// Event is a change to a node in a remote directory tree,
+// corresponding to a [tensorfs.Event].
+type Event struct {
+	// Type is the type of change.
+	Type tensorfs.EventTypes
+
+	// Path is the path of the node that changed, relative to the
+	// watched directory, which is "." for the directory itself.
+	Path string
+}
+
+// Server is an [http.Handler] that exposes a [tensorfs] directory tree,
+// for access by a [Client]. Only read access is supported. Nodes can be
+// added, removed and replaced while they are being served, but as with any
+// other access to a [tensorfs] tree, the values within a tensor are not
+// protected, so a value that is being written in place while it is read
+// may be sent with a mix of old and new data. There is no authentication,
+// so it should only be served on a local address (see [ListenAndServe]).
+type Server struct {
+	// Root is the root directory of the tree being served.
+	Root *tensorfs.Node
+
+	mux *http.ServeMux
+}
+
+// NewServer returns a new [Server] for given root directory.
+func NewServer(root *tensorfs.Node) *Server {
+	sv := &Server{Root: root, mux: http.NewServeMux()}
+	sv.handle(listOp, sv.list)
+	sv.handle(statOp, sv.stat)
+	sv.handle(valueOp, sv.value)
+	sv.handle(watchOp, sv.watch)
+	return sv
+}
+
+// ListenAndServe serves the given root directory over HTTP
+// on given network address, e.g., "localhost:8080", using a [Server].
+// The server has no authentication, so anyone who can connect to the
+// address can read all of the data: use a local address and an SSH
+// tunnel or similar to access it from another machine.
+func ListenAndServe(addr string, root *tensorfs.Node) error {
+	return http.ListenAndServe(addr, NewServer(root))
+}
+
+func (sv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sv.mux.ServeHTTP(w, r)
+}
+
+// handle registers given handler function for given operation,
+// passing the node at the request path.
+func (sv *Server) handle(op string, fun func(w http.ResponseWriter, r *http.Request, nd *tensorfs.Node)) {
+	hf := func(w http.ResponseWriter, r *http.Request) {
+		nd, err := sv.Root.NodeAtPath(nodePath(r.PathValue("path")))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		fun(w, r, nd)
+	}
+	sv.mux.HandleFunc("GET /"+op+"/{path...}", hf)
+}
+
+func (sv *Server) list(w http.ResponseWriter, r *http.Request, nd *tensorfs.Node) {
+	nds, err := nd.Nodes()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	infos := make([]Info, len(nds))
+	for i, it := range nds {
+		infos[i] = nodeInfo(it)
+	}
+	writeJSON(w, infos)
+}
+
+func (sv *Server) stat(w http.ResponseWriter, r *http.Request, nd *tensorfs.Node) {
+	writeJSON(w, nodeInfo(nd))
+}
+
+func (sv *Server) value(w http.ResponseWriter, r *http.Request, nd *tensorfs.Node) {
+	tsr, ok := nd.Sys().(tensor.Tensor)
+	if !ok {
+		http.Error(w, fmt.Sprintf("tensorfs remote: %q is not a value", nd.Path()), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(tensor.ToBinary(tsr.AsValues()))
+}
+
+func (sv *Server) watch(w http.ResponseWriter, r *http.Request, nd *tensorfs.Node) {
+	fl, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "tensorfs remote: streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	q := &eventQueue{ready: make(chan struct{}, 1)}
+	cancel := nd.Watch(func(ev tensorfs.Event) {
+		q.push(Event{Type: ev.Type, Path: relPath(nd, ev.Node)})
+	})
+	defer cancel()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fl.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-q.ready:
+		}
+		for _, ev := range q.pop() {
+			b, _ := json.Marshal(ev)
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", b); err != nil {
+				return
+			}
+		}
+		fl.Flush()
+	}
+}
+
+// eventQueue is a queue of events waiting to be sent to a client,
+// which never blocks the sender of the events. Multiple pending
+// updated events for the same node are combined into one.
+type eventQueue struct {
+	mu     sync.Mutex
+	events []Event
+	ready  chan struct{}
+}
+
+func (q *eventQueue) push(ev Event) {
+	q.mu.Lock()
+	if ev.Type != tensorfs.EventUpdated || !slices.Contains(q.events, ev) {
+		q.events = append(q.events, ev)
+	}
+	q.mu.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (q *eventQueue) pop() []Event {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	evs := q.events
+	q.events = nil
+	return evs
+}
+
+// nodeInfo returns the [Info] for given node.
+func nodeInfo(nd *tensorfs.Node) Info {
+	inf := Info{Name: nd.Name(), IsDir: nd.IsDir(), Size: nd.Size(), ModTime: nd.ModTime()}
+	if tsr, ok := nd.Sys().(tensor.Tensor); ok {
+		inf.Type = tsr.DataType().String()
+		inf.Shape = tsr.ShapeSizes()
+	}
+	return inf
+}
+
+// nodePath returns the [tensorfs.Node.NodeAtPath] path for given request path.
+func nodePath(pth string) string {
+	if pth == "" {
+		return "."
+	}
+	return path.Clean(pth)
+}
+
+// relPath returns the path of given node relative to given directory.
+func relPath(dir, nd *tensorfs.Node) string {
+	var names []string
+	for cur := nd; cur != nil && cur != dir; cur = cur.ParentDir() {
+		names = append(names, cur.Name())
+	}
+	if len(names) == 0 {
+		return "."
+	}
+	slices.Reverse(names)
+	return path.Join(names...)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,467 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/core/base/num"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+)
+
+// The native file format written by [Save] and read by [Load] is a
+// sequence of chunks, all in little-endian byte order:
+//
+//   - The file starts with the 8 byte [FileMagic] followed by
+//     the uint64 [FileVersion].
+//   - Each chunk has a 4 byte ASCII tag, 4 reserved zero bytes,
+//     a uint64 length of the payload, and then the payload,
+//     padded with zeros to a multiple of 8 bytes, so that all
+//     payloads are 8 byte aligned.
+//   - A "DATA" chunk has the raw data for one value, as returned by
+//     [tensor.Values.Bytes], except that int values are always stored
+//     as int64, regardless of the size of int on the platform.
+//     Values with the same [tensor.ContentHash] are only stored once,
+//     and all refer to the same chunk.
+//   - The final "INDX" chunk has a JSON encoding of the directory tree,
+//     with a record for each node in directory order, including its
+//     name, modification time, data type, shape, metadata, content hash,
+//     the location of its DATA chunk, the target path of links, and the
+//     column names and node paths of the [DirTable] for directories.
+//   - The file ends with the uint64 offset of the INDX chunk,
+//     followed by the [FileMagic] again.
+//
+// This allows the index to be read directly from the end of the file,
+// and the numeric value data to be memory-mapped from its chunks.
+const (
+	// FileMagic is the identifier at the start and end of
+	// a tensorfs file written by [Save].
+	FileMagic = "TENSORFS"
+
+	// FileVersion is the current version of the tensorfs file format.
+	FileVersion = 1
+)
+
+// fileNode is the INDX record for one node in a tensorfs file.
+type fileNode struct {
+	Name    string
+	ModTime time.Time
+
+	// IsDir is true for a directory, which has its Nodes in order.
+	IsDir bool          `json:",omitempty"`
+	Nodes []*fileNode   `json:",omitempty"`
+	Table *fileDirTable `json:",omitempty"`
+
+	// Link is the target path of a link node.
+	Link string `json:",omitempty"`
+
+	// Type is the data type of a value, empty if it has no tensor.
+	Type  string     `json:",omitempty"`
+	Shape []int      `json:",omitempty"`
+	Meta  []fileMeta `json:",omitempty"`
+
+	// Hash is the [tensor.ContentHash] of a value.
+	Hash string `json:",omitempty"`
+
+	// Offset and Size are the location of the DATA chunk payload,
+	// which can be shared by values with the same Hash.
+	Offset int64 `json:",omitempty"`
+	Size   int64 `json:",omitempty"`
+}
+
+// fileMeta is a metadata item for a value in a tensorfs file.
+// Only items with string, bool, int and float values are saved.
+type fileMeta struct {
+	Key   string
+	Type  string
+	Value any
+}
+
+// fileDirTable records the [DirTable] of a directory, in terms of
+// the paths of the value nodes for each column, relative to the directory.
+type fileDirTable struct {
+	Name    string
+	Columns []string
+	Paths   []string
+}
+
+// Save saves the given directory tree to the given file, in the native
+// tensorfs file format (see [FileMagic]), preserving the data types, shapes,
+// and metadata of the values, the order of nodes, and the [DirTable] of each
+// directory. Values with identical content, as reported by [Node.Duplicates],
+// are only stored once. The file is written to a temporary file that is then
+// renamed, so any existing file is only replaced when the save is complete.
+// Use [Load] to load the file.
+func Save(dir *Node, filename string) error {
+	if err := dir.mustDir("Save", filename); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	sw := &saveWriter{w: bufio.NewWriter(f), chunks: map[tensor.Hash]*fileNode{}}
+	sw.write([]byte(FileMagic))
+	sw.write(binary.LittleEndian.AppendUint64(nil, FileVersion))
+	root := sw.node(dir)
+	idx, err := json.Marshal(root)
+	if err != nil {
+		sw.err = err
+	}
+	ioff := sw.off
+	sw.chunk("INDX", idx)
+	sw.write(binary.LittleEndian.AppendUint64(nil, uint64(ioff)))
+	sw.write([]byte(FileMagic))
+	if sw.err == nil {
+		sw.err = sw.w.Flush()
+	}
+	err = errors.Join(sw.err, f.Close())
+	if err == nil {
+		err = os.Rename(f.Name(), filename)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// saveWriter writes chunks of a tensorfs file, keeping track of
+// the current offset and the first error.
+type saveWriter struct {
+	w   *bufio.Writer
+	off int64
+	err error
+
+	// chunks are the records of the values that have been written,
+	// by content hash, so that identical values are only written once.
+	chunks map[tensor.Hash]*fileNode
+}
+
+func (sw *saveWriter) write(b []byte) {
+	if sw.err != nil {
+		return
+	}
+	n, err := sw.w.Write(b)
+	sw.off += int64(n)
+	sw.err = err
+}
+
+// chunk writes a chunk with given tag and payload,
+// returning the offset of the payload.
+func (sw *saveWriter) chunk(tag string, b []byte) int64 {
+	hdr := append([]byte(tag), 0, 0, 0, 0)
+	sw.write(binary.LittleEndian.AppendUint64(hdr, uint64(len(b))))
+	off := sw.off
+	sw.write(b)
+	if pad := (8 - len(b)%8) % 8; pad > 0 {
+		sw.write(make([]byte, pad))
+	}
+	return off
+}
+
+// node writes the DATA chunks for given node and returns its INDX record.
+func (sw *saveWriter) node(nd *Node) *fileNode {
+	fn := &fileNode{Name: nd.name, ModTime: nd.ModTime()}
+	if nd.IsLink() {
+		fn.Link = nd.link
+		return fn
+	}
+	if nd.IsDir() {
+		fn.IsDir = true
+		for _, it := range nd.nodeList() {
+			fn.Nodes = append(fn.Nodes, sw.node(it))
+		}
+		if dt := nd.dirTable(); dt != nil {
+			fn.Table = dirTableRecord(nd, dt)
+		}
+		return fn
+	}
+	tsr := nd.TensorValue()
+	if tsr == nil {
+		return fn
+	}
+	vals := tsr.AsValues()
+	fn.Type = vals.DataType().String()
+	fn.Shape = vals.ShapeSizes()
+	md := *tsr.Metadata()
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		v := md[k]
+		switch v.(type) {
+		case string, bool, int, float32, float64:
+			fn.Meta = append(fn.Meta, fileMeta{Key: k, Type: reflect.TypeOf(v).String(), Value: v})
+		}
+	}
+	hash := tensor.ContentHash(vals)
+	fn.Hash = hash.String()
+	if ex, ok := sw.chunks[hash]; ok {
+		fn.Offset, fn.Size = ex.Offset, ex.Size
+		return fn
+	}
+	b := valueBytes(vals)
+	fn.Size = int64(len(b))
+	fn.Offset = sw.chunk("DATA", b)
+	sw.chunks[hash] = fn
+	return fn
+}
+
+// valueBytes returns the DATA chunk payload for given value,
+// with int values encoded as int64.
+func valueBytes(vals tensor.Values) []byte {
+	iv, ok := vals.(*tensor.Int)
+	if !ok || strconv.IntSize == 64 {
+		return vals.Bytes()
+	}
+	b := make([]byte, 0, 8*len(iv.Values))
+	for _, v := range iv.Values {
+		b = binary.LittleEndian.AppendUint64(b, uint64(int64(v)))
+	}
+	return b
+}
+
+// dirTableRecord returns the record of the [DirTable] for given directory,
+// including only those columns that are values in the directory tree.
+func dirTableRecord(dir *Node, dt *table.Table) *fileDirTable {
+	ft := &fileDirTable{Name: metadata.Name(dt)}
+	nds := dir.NodesFunc(nil)
+	dpath := dir.Path()
+	for i, cl := range dt.Columns.Values {
+		for _, nd := range nds {
+			if tsr := nd.TensorValue(); tsr != nil && any(tsr.AsValues()) == any(cl) {
+				ft.Columns = append(ft.Columns, dt.Columns.Keys[i])
+				ft.Paths = append(ft.Paths, strings.TrimPrefix(nd.Path(), dpath+"/"))
+				break
+			}
+		}
+	}
+	return ft
+}
+
+// Load loads a directory tree from the given file written by [Save],
+// returning the new root directory node. The numeric value data is
+// memory-mapped from the file using [tensor.OpenMmap], so that opening
+// even a very large file is fast, and the data for each value is only
+// read from disk when it is first accessed. The mapping is private, so
+// any changes to the values do not affect the file. The mapping is kept
+// until [Node.Close] is called on the returned root directory.
+// On platforms without memory mapping support (e.g., Windows and js/wasm),
+// the whole file is read into memory when it is loaded.
+func Load(filename string) (*Node, error) {
+	mm, err := tensor.OpenMmap[byte](filename)
+	if err != nil {
+		return nil, err
+	}
+	b := mm.Values
+	root, err := loadIndex(b)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("tensorfs.Load: %q: %w", filename, err), mm.Close())
+	}
+	dir, _ := NewDir(root.Name)
+	var errs []error
+	loadDir(b, dir, root, map[int64]bool{}, &errs)
+	dir.modTime = root.ModTime
+	dir.mapped = mm
+	return dir, errors.Join(errs...)
+}
+
+// Close releases the memory-mapped file data of a root directory
+// returned by [Load]. The numeric values loaded from the file
+// refer directly to that data, so they must not be used after
+// Close is called (copy any that are needed first, e.g., with
+// [tensor.Clone]). It does nothing for other nodes.
+func (dir *Node) Close() error {
+	dir.mu.Lock()
+	mm := dir.mapped
+	dir.mapped = nil
+	dir.mu.Unlock()
+	if mm == nil {
+		return nil
+	}
+	return mm.Close()
+}
+
+// loadIndex checks the header and trailer of given tensorfs file data,
+// and returns its decoded INDX chunk.
+func loadIndex(b []byte) (*fileNode, error) {
+	n := int64(len(b))
+	if n < 32 || string(b[:8]) != FileMagic || string(b[n-8:]) != FileMagic {
+		return nil, errors.New("not a tensorfs file")
+	}
+	if v := binary.LittleEndian.Uint64(b[8:]); v != FileVersion {
+		return nil, fmt.Errorf("unsupported version %d", v)
+	}
+	ioff := int64(binary.LittleEndian.Uint64(b[n-16:]))
+	if ioff < 16 || ioff+16 > n-16 || string(b[ioff:ioff+4]) != "INDX" {
+		return nil, errors.New("invalid index")
+	}
+	isz := int64(binary.LittleEndian.Uint64(b[ioff+8:]))
+	if isz < 0 || ioff+16+isz > n-16 {
+		return nil, errors.New("invalid index")
+	}
+	root := &fileNode{}
+	err := json.Unmarshal(b[ioff+16:ioff+16+isz], root)
+	return root, err
+}
+
+// loadDir adds the nodes in given INDX record to given directory,
+// using given map of the offsets of the DATA chunks already used.
+func loadDir(b []byte, dir *Node, fd *fileNode, used map[int64]bool, errs *[]error) {
+	for _, fn := range fd.Nodes {
+		var tsr tensor.Values
+		if !fn.IsDir && fn.Type != "" {
+			var err error
+			// values that share a chunk must not share memory
+			tsr, err = loadValue(b, fn, used[fn.Offset])
+			used[fn.Offset] = true
+			if err != nil {
+				*errs = append(*errs, fmt.Errorf("tensorfs.Load: %s: %w", path.Join(dir.Path(), fn.Name), err))
+				continue
+			}
+		}
+		nd, err := newNode(dir, fn.Name, func(nd *Node) {
+			nd.modTime = fn.ModTime
+			if fn.Link != "" {
+				nd.link = fn.Link
+			} else if fn.IsDir {
+				nd.nodes = &Nodes{}
+			} else if tsr != nil {
+				nd.Tensor = tsr
+			}
+		})
+		if err != nil {
+			*errs = append(*errs, err)
+			continue
+		}
+		if fn.IsDir && fn.Link == "" {
+			loadDir(b, nd, fn, used, errs)
+		}
+	}
+	if fd.Table != nil {
+		loadDirTable(dir, fd.Table, errs)
+	}
+}
+
+// loadValue returns the tensor for given INDX record of a value,
+// copying its data if copyData is true instead of using it directly.
+func loadValue(b []byte, fn *fileNode, copyData bool) (tensor.Values, error) {
+	kind, err := table.KindFromString(fn.Type)
+	if err != nil {
+		return nil, err
+	}
+	if fn.Offset < 0 || fn.Size < 0 || fn.Offset+fn.Size > int64(len(b)) {
+		return nil, errors.New("invalid data chunk")
+	}
+	data := b[fn.Offset : fn.Offset+fn.Size]
+	if copyData {
+		data = bytes.Clone(data)
+	}
+	var tsr tensor.Values
+	switch kind {
+	case reflect.Float64:
+		tsr, err = mappedNumber[float64](data, fn.Shape)
+	case reflect.Float32:
+		tsr, err = mappedNumber[float32](data, fn.Shape)
+	case reflect.Int:
+		tsr, err = mappedInt(data, fn.Shape)
+	case reflect.Int32:
+		tsr, err = mappedNumber[int32](data, fn.Shape)
+	case reflect.Uint8:
+		tsr, err = mappedNumber[uint8](data, fn.Shape)
+	default:
+		tsr = tensor.NewOfType(kind, fn.Shape...)
+		if len(data) > 0 {
+			tsr.SetFromBytes(data)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	md := tsr.Metadata()
+	for _, m := range fn.Meta {
+		switch v := m.Value.(type) {
+		case float64:
+			switch m.Type {
+			case "int":
+				md.Set(m.Key, int(v))
+			case "float32":
+				md.Set(m.Key, float32(v))
+			default:
+				md.Set(m.Key, v)
+			}
+		default:
+			md.Set(m.Key, v)
+		}
+	}
+	return tsr, nil
+}
+
+// mappedNumber returns a [tensor.Number] with given shape, with its
+// values directly using the given data bytes, which are not copied.
+func mappedNumber[T num.Number](data []byte, shape []int) (tensor.Values, error) {
+	var zv T
+	sz := int(unsafe.Sizeof(zv))
+	n := 1
+	for _, s := range shape {
+		n *= s
+	}
+	if n*sz != len(data) {
+		return nil, errors.New("data size does not match shape")
+	}
+	var vals []T
+	if n > 0 {
+		vals = unsafe.Slice((*T)(unsafe.Pointer(unsafe.SliceData(data))), n)
+	}
+	tsr := tensor.NewNumberFromValues(vals...)
+	tsr.SetShapeSizes(shape...)
+	return tsr, nil
+}
+
+// mappedInt returns a [tensor.Int] with given shape for given data bytes
+// of int64 values, which are used directly if int is 64 bits, and are
+// otherwise converted.
+func mappedInt(data []byte, shape []int) (tensor.Values, error) {
+	if strconv.IntSize == 64 {
+		return mappedNumber[int](data, shape)
+	}
+	i64, err := mappedNumber[int64](data, shape)
+	if err != nil {
+		return nil, err
+	}
+	tsr := tensor.NewInt(shape...)
+	for i, v := range i64.(*tensor.Number[int64]).Values {
+		tsr.Values[i] = int(v)
+	}
+	return tsr, nil
+}
+
+// loadDirTable restores the [DirTable] for given directory.
+func loadDirTable(dir *Node, ft *fileDirTable, errs *[]error) {
+	dt := table.New(ft.Name)
+	for i, p := range ft.Paths {
+		nd, err := dir.NodeAtPath(path.Clean(p))
+		if err != nil || nd.TensorValue() == nil {
+			*errs = append(*errs, fmt.Errorf("tensorfs.Load: DirTable column %q not found in %s", p, dir.Path()))
+			return
+		}
+		dt.AddColumn(ft.Columns[i], nd.TensorValue().AsValues())
+	}
+	dir.setDirTable(dt)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/table"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveLoad(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	// added out of alphabetical order, to check that order is preserved
+	dir.Dir("Stats")
+	dt := table.New("Log")
+	dt.AddIntColumn("Epoch")
+	dt.AddFloat32Column("Act", 2, 3)
+	dt.AddStringColumn("Name")
+	dt.SetNumRows(4)
+	for r := range 4 {
+		dt.Column("Epoch").SetIntRow(r, r, 0)
+		dt.Column("Act").SetFloatRow(float64(r)/2, r, 5)
+		dt.Column("Name").SetStringRow(string(rune('a'+r)), r, 0)
+	}
+	DirFromTable(dir.Dir("Log"), dt)
+	tensor.SetPrecision(dt.Columns.At("Act"), 3)
+	metadata.SetDoc(dt.Columns.At("Act"), "activations")
+	bv := tensor.NewBoolShape(tensor.NewShape(3))
+	bv.SetBool1D(true, 1)
+	dir.Set("Flags", bv)
+	dir.Float64("Empty", 0)
+
+	fname := filepath.Join(t.TempDir(), "data.tensorfs")
+	assert.NoError(t, Save(dir, fname))
+
+	ld, err := Load(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, dir.ListAll(), ld.ListAll())
+	nds, _ := ld.Nodes()
+	assert.Equal(t, "Stats", nds[0].Name())
+	assert.True(t, dir.Node("Log").ModTime().Equal(ld.Node("Log").ModTime()))
+
+	act := ld.Dir("Log").Value("Act")
+	assert.Equal(t, []int{4, 2, 3}, act.ShapeSizes())
+	assert.Equal(t, tensor.AsFloat64(dt.Columns.At("Act")).Values, tensor.AsFloat64(act).Values)
+	prec, err := tensor.Precision(act)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, prec)
+	assert.Equal(t, "activations", metadata.Doc(act))
+	assert.Equal(t, "c", ld.Dir("Log").Value("Name").String1D(2))
+	flags := ld.Value("Flags").(*tensor.Bool)
+	assert.Equal(t, 3, flags.Len())
+	assert.True(t, flags.Bool1D(1))
+	assert.False(t, flags.Bool1D(2))
+	assert.Equal(t, 0, ld.Value("Empty").Len())
+
+	ldt := ld.Dir("Log").DirTable
+	assert.NotNil(t, ldt)
+	assert.Equal(t, "Log", metadata.Name(ldt))
+	assert.Equal(t, []string{"Epoch", "Act", "Name"}, ldt.Columns.Keys)
+	assert.Equal(t, 3, ldt.Column("Epoch").IntRow(3, 0))
+
+	// int values are stored as int64 on all platforms
+	b, err := os.ReadFile(fname)
+	assert.NoError(t, err)
+	idx, err := loadIndex(b)
+	assert.NoError(t, err)
+	epoch := idx.Nodes[1].Nodes[0]
+	assert.Equal(t, "Epoch", epoch.Name)
+	assert.Equal(t, int64(4*8), epoch.Size)
+	assert.Equal(t, uint64(3), binary.LittleEndian.Uint64(b[epoch.Offset+3*8:]))
+
+	// changes to loaded values do not affect the file
+	act.SetFloat1D(100, 0)
+	ld2, err := Load(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, ld2.Dir("Log").Value("Act").Float1D(0))
+
+	// save over the loaded file
+	assert.NoError(t, Save(ld, fname))
+	ld2, err = Load(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, ld2.Dir("Log").Value("Act").Float1D(0))
+
+	// Close releases the mapping, and can be called again
+	keep := tensor.Clone(ld2.Dir("Log").Value("Act"))
+	assert.NoError(t, ld2.Close())
+	assert.Nil(t, ld2.mapped)
+	assert.NoError(t, ld2.Close())
+	assert.NoError(t, ld2.Dir("Log").Close())
+	assert.Equal(t, 100.0, keep.Float1D(0))
+	assert.NoError(t, ld.Close())
+
+	assert.NoError(t, os.WriteFile(fname, []byte("not a tensorfs file at all, just text"), 0666))
+	_, err = Load(fname)
+	assert.Error(t, err)
+}
+
+func TestSaveDuplicates(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	a := dir.Float64("A", 1000)
+	for i := range a.Len() {
+		a.SetFloat1D(float64(i), i)
+	}
+	dir.Dir("Sub").Set("B", a.Clone())
+	dir.Set("C", a.Clone())
+	dir.Set("D", tensor.Reshape(a.Clone(), 10, 100))
+	dir.Float64("E", 1000)
+	dir.Link("L", "A")
+
+	dups := dir.Duplicates()
+	assert.Len(t, dups, 1)
+	if len(dups) == 1 {
+		names := []string{}
+		for _, nd := range dups[0] {
+			names = append(names, string(nd.Path()))
+		}
+		assert.Equal(t, []string{"root/A", "root/Sub/B", "root/C"}, names)
+	}
+
+	fname := filepath.Join(t.TempDir(), "data.tensorfs")
+	assert.NoError(t, Save(dir, fname))
+	st, err := os.Stat(fname)
+	assert.NoError(t, err)
+	// A, B and C are stored once, D and E separately
+	assert.Less(t, st.Size(), int64(4*8000))
+
+	ld, err := Load(fname)
+	assert.NoError(t, err)
+	assert.Equal(t, dir.ListAll(), ld.ListAll())
+	assert.Len(t, ld.Duplicates(), 1)
+	la, lc := ld.Value("A"), ld.Value("C")
+	assert.Equal(t, 999.0, ld.Dir("Sub").Value("B").Float1D(999))
+	assert.Equal(t, []int{10, 100}, ld.Value("D").ShapeSizes())
+
+	// loaded duplicates do not share memory
+	la.SetFloat1D(-1, 0)
+	assert.Equal(t, 0.0, lc.Float1D(0))
+	assert.Equal(t, 0.0, ld.Dir("Sub").Value("B").Float1D(0))
+}
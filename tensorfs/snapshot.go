@@ -0,0 +1,250 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"time"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/lab/tensor"
+)
+
+// Snapshot is a copy of all the values in a directory tree at a point in
+// time, made by [Node.Snapshot], which can be restored by [Node.Restore],
+// and compared using [DiffSnapshots] and [Node.Diff].
+type Snapshot struct {
+	// Name is the name of the snapshot, which is unique within its directory.
+	Name string
+
+	// Time is when the snapshot was made.
+	Time time.Time
+
+	// Paths are the paths of all the nodes in the tree, relative to the
+	// directory, in directory order, with each directory before its nodes.
+	Paths []string
+
+	// Values are the values of the nodes by path, which are nil for
+	// directories. These must not be modified, because they are shared
+	// with the directory tree and other snapshots until they are changed.
+	Values map[string]tensor.Values
+}
+
+// ChangeTypes are the types of [Change] between two snapshots.
+type ChangeTypes int32 //enums:enum -trim-prefix Change
+
+const (
+	// ChangeAdded is a node that is only present in the second snapshot.
+	ChangeAdded ChangeTypes = iota
+
+	// ChangeRemoved is a node that is only present in the first snapshot.
+	ChangeRemoved
+
+	// ChangeModified is a value that is different between the snapshots,
+	// or a node that is a directory in one and a value in the other.
+	ChangeModified
+)
+
+// Change is a difference between two snapshots, returned by [DiffSnapshots].
+type Change struct {
+	// Type is the type of change.
+	Type ChangeTypes
+
+	// Path is the path of the node, relative to the snapshot directory.
+	Path string
+}
+
+func (ch Change) String() string {
+	return ch.Type.String() + " " + ch.Path
+}
+
+// Snapshot makes a [Snapshot] of all the values in this directory tree with
+// given name, which replaces any existing snapshot of that name, and returns it.
+// The snapshot is copy-on-write: it shares the tensors of the values, which
+// are only copied when they are next written, as obtained for writing by
+// [Value] or the other value creation functions (e.g., [Node.Float64]),
+// so values that are not changed share the same storage. Writing directly
+// to a tensor obtained before the snapshot, or from [Node.Value], also
+// changes the snapshot. Link nodes are not included.
+// The snapshots of a directory are available from [Node.Snapshots].
+func (dir *Node) Snapshot(name string) *Snapshot {
+	if err := dir.mustDir("Snapshot", name); errors.Log(err) != nil {
+		return nil
+	}
+	sn := dir.capture(true)
+	sn.Name = name
+	dir.mu.Lock()
+	dir.snapshots = slices.DeleteFunc(dir.snapshots, func(s *Snapshot) bool { return s.Name == name })
+	dir.snapshots = append(dir.snapshots, sn)
+	dir.mu.Unlock()
+	return sn
+}
+
+// Snapshots returns the snapshots made by [Node.Snapshot] on this
+// directory, in the order made.
+func (dir *Node) Snapshots() []*Snapshot {
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	return slices.Clone(dir.snapshots)
+}
+
+// SnapshotByName returns the snapshot of given name made by
+// [Node.Snapshot] on this directory, or nil if not found.
+func (dir *Node) SnapshotByName(name string) *Snapshot {
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	i := slices.IndexFunc(dir.snapshots, func(s *Snapshot) bool { return s.Name == name })
+	if i < 0 {
+		return nil
+	}
+	return dir.snapshots[i]
+}
+
+// DeleteSnapshot deletes the snapshot of given name from this directory,
+// returning false if not found.
+func (dir *Node) DeleteSnapshot(name string) bool {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	n := len(dir.snapshots)
+	dir.snapshots = slices.DeleteFunc(dir.snapshots, func(s *Snapshot) bool { return s.Name == name })
+	return len(dir.snapshots) < n
+}
+
+// Restore restores the contents of this directory tree to the snapshot of
+// given name made by [Node.Snapshot]: nodes that are not in the snapshot
+// are deleted, missing nodes are added, and values that are different are
+// replaced with the snapshot value, which is copied when it is next written
+// as for [Node.Snapshot], so the snapshot is not affected by subsequent
+// changes. Unchanged values are not replaced.
+func (dir *Node) Restore(name string) error {
+	sn := dir.SnapshotByName(name)
+	if sn == nil {
+		return fmt.Errorf("tensorfs Dir %q snapshot not found: %q", dir.Path(), name)
+	}
+	var errs []error
+	for _, pth := range sn.Paths {
+		pdir, nm := dir, path.Base(pth)
+		if dp := path.Dir(pth); dp != "." {
+			pdir = dir.Dir(dp)
+		}
+		val := sn.Values[pth]
+		ex := pdir.Node(nm)
+		if ex != nil && ex.IsDir() != (val == nil) {
+			errs = append(errs, pdir.Delete(nm))
+			ex = nil
+		}
+		switch {
+		case val == nil:
+			pdir.Dir(nm)
+		case ex == nil:
+			nd, err := newNode(pdir, nm, func(nd *Node) { nd.Tensor, nd.shared = val, true })
+			if err == fs.ErrExist {
+				nd.replaceTensor(val, true)
+			}
+		case !valuesEqual(ex.TensorValue(), val):
+			ex.replaceTensor(val, true)
+		}
+	}
+	cur := dir.capture(false)
+	var removed []string
+	for _, pth := range cur.Paths {
+		if _, ok := sn.Values[pth]; ok || slices.ContainsFunc(removed, func(r string) bool { return isWithin(pth, r) }) {
+			continue
+		}
+		removed = append(removed, pth)
+		pdir := dir
+		if dp := path.Dir(pth); dp != "." {
+			pdir = dir.Dir(dp)
+		}
+		errs = append(errs, pdir.Delete(path.Base(pth)))
+	}
+	return errors.Join(errs...)
+}
+
+// Diff returns the changes from the snapshot of given name made by
+// [Node.Snapshot] to the current contents of this directory tree,
+// as in [DiffSnapshots].
+func (dir *Node) Diff(name string) ([]Change, error) {
+	sn := dir.SnapshotByName(name)
+	if sn == nil {
+		return nil, fmt.Errorf("tensorfs Dir %q snapshot not found: %q", dir.Path(), name)
+	}
+	return DiffSnapshots(sn, dir.capture(false)), nil
+}
+
+// DiffSnapshots returns the changes from snapshot a to snapshot b:
+// nodes that are removed or modified in the order of a, followed by
+// nodes that are added in the order of b.
+func DiffSnapshots(a, b *Snapshot) []Change {
+	var chs []Change
+	for _, pth := range a.Paths {
+		bv, ok := b.Values[pth]
+		av := a.Values[pth]
+		switch {
+		case !ok:
+			chs = append(chs, Change{Type: ChangeRemoved, Path: pth})
+		case (av == nil) != (bv == nil) || (av != nil && !valuesEqual(av, bv)):
+			chs = append(chs, Change{Type: ChangeModified, Path: pth})
+		}
+	}
+	for _, pth := range b.Paths {
+		if _, ok := a.Values[pth]; !ok {
+			chs = append(chs, Change{Type: ChangeAdded, Path: pth})
+		}
+	}
+	return chs
+}
+
+// capture returns a new unnamed snapshot of this directory tree. If share
+// is true, the values are marked as shared with the snapshot, so that they
+// are copied when they are next written.
+func (dir *Node) capture(share bool) *Snapshot {
+	sn := &Snapshot{Time: time.Now(), Values: map[string]tensor.Values{}}
+	var walk func(dir *Node, pth string)
+	walk = func(dir *Node, pth string) {
+		for _, it := range dir.nodeList() {
+			if it.IsLink() {
+				continue
+			}
+			ipth := path.Join(pth, it.name)
+			if it.IsDir() {
+				sn.Paths = append(sn.Paths, ipth)
+				sn.Values[ipth] = nil
+				walk(it, ipth)
+				continue
+			}
+			tsr := it.TensorValue()
+			if share {
+				tsr = it.share()
+			}
+			if tsr == nil {
+				continue
+			}
+			sn.Paths = append(sn.Paths, ipth)
+			sn.Values[ipth] = tsr.AsValues()
+		}
+	}
+	walk(dir, "")
+	return sn
+}
+
+// valuesEqual returns true if the given tensors are the same,
+// or have the same data type, shape and values.
+func valuesEqual(a, b tensor.Tensor) bool {
+	if a == nil || b == nil || a == b {
+		return a == b
+	}
+	av, bv := a.AsValues(), b.AsValues()
+	return av.DataType() == bv.DataType() && slices.Equal(av.ShapeSizes(), bv.ShapeSizes()) && bytes.Equal(av.Bytes(), bv.Bytes())
+}
+
+// isWithin returns true if path pth is within directory path dir.
+func isWithin(pth, dir string) bool {
+	return len(pth) > len(dir) && pth[len(dir)] == '/' && pth[:len(dir)] == dir
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"bytes"
+	"testing"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersions(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	stats := dir.Dir("stats")
+	stats.KeepVersions(2)
+	x := stats.Float64("x", 3)
+	for i := range 4 {
+		stats.Set("x", tensor.NewFloat64Full(float64(i), 3))
+	}
+	vs := stats.Node("x").Versions()
+	assert.Equal(t, 2, len(vs))
+	assert.Equal(t, 1.0, vs[0].Tensor.Float1D(0))
+	assert.Equal(t, 2.0, vs[1].Tensor.Float1D(0))
+	assert.False(t, vs[1].Time.Before(vs[0].Time))
+	assert.NotSame(t, x, stats.Value("x"))
+
+	stats.Node("x").KeepVersions(-1)
+	stats.Set("x", tensor.NewFloat64(3))
+	assert.Equal(t, 0, len(stats.Node("x").Versions()))
+
+	dir.Set("y", tensor.NewFloat64(3))
+	dir.Set("y", tensor.NewFloat64(3))
+	assert.Equal(t, 0, len(dir.Node("y").Versions()))
+
+	stats.Float64("z", 1)
+	for i := range 3 {
+		stats.Float64("z").Set1D(float64(i), 0)
+		stats.Node("z").SendUpdate()
+	}
+	vs = stats.Node("z").Versions()
+	assert.Equal(t, 2, len(vs))
+	assert.Equal(t, 0.0, vs[0].Tensor.Float1D(0))
+	assert.Equal(t, 1.0, vs[1].Tensor.Float1D(0))
+	assert.Equal(t, 2.0, stats.Value("z").Float1D(0))
+	stats.Float64("z").Set1D(3, 0)
+	assert.Equal(t, 2.0, stats.Node("z").Versions()[1].Tensor.Float1D(0))
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	stats := dir.Dir("stats")
+	x := stats.Float64("x", 3)
+	x.Set1D(1, 0)
+	stats.StringValue("name", 1).SetString1D("a", 0)
+	dir.Dir("other").Int("n", 2)
+
+	s1 := dir.Snapshot("s1")
+	assert.Equal(t, []string{"stats", "stats/x", "stats/name", "other", "other/n"}, s1.Paths)
+	assert.Same(t, x, s1.Values["stats/x"])
+	chs, err := dir.Diff("s1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(chs))
+
+	var replaced []string
+	cancel := dir.Watch(func(ev Event) {
+		if ev.Type == EventReplaced {
+			replaced = append(replaced, ev.Node.Name())
+		}
+	})
+	x2 := stats.Float64("x")
+	assert.NotSame(t, x, x2)
+	assert.Equal(t, "x", metadata.Name(x2))
+	assert.Same(t, x2, stats.Float64("x"))
+	assert.Equal(t, []string{"x"}, replaced)
+	cancel()
+	x2.Set1D(2, 0)
+	assert.Equal(t, 1.0, x.Float1D(0))
+	assert.NoError(t, dir.Delete("other"))
+	dir.Float32("added", 2)
+	chs, err = dir.Diff("s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{{ChangeModified, "stats/x"}, {ChangeRemoved, "other"}, {ChangeRemoved, "other/n"}, {ChangeAdded, "added"}}, chs)
+
+	s2 := dir.Snapshot("s2")
+	assert.Same(t, s1.Values["stats/name"], s2.Values["stats/name"])
+	assert.NotSame(t, s1.Values["stats/x"], s2.Values["stats/x"])
+	assert.Equal(t, 4, len(DiffSnapshots(s1, s2)))
+	assert.Equal(t, []string{"s1", "s2"}, []string{dir.Snapshots()[0].Name, dir.Snapshots()[1].Name})
+
+	name := stats.Value("name")
+	assert.NoError(t, dir.Restore("s1"))
+	assert.Equal(t, 1.0, stats.Value("x").Float1D(0))
+	assert.Same(t, s1.Values["stats/x"], stats.Value("x"))
+	assert.Equal(t, 2.0, x2.Float1D(0))
+	assert.Nil(t, dir.Node("added"))
+	assert.NotNil(t, dir.Node("other").Node("n"))
+	assert.Same(t, name, stats.Value("name"))
+	chs, err = dir.Diff("s1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(chs))
+
+	stats.Float64("x").Set1D(5, 0)
+	assert.Equal(t, 1.0, s1.Values["stats/x"].Float1D(0))
+	assert.Equal(t, 5.0, stats.Value("x").Float1D(0))
+
+	assert.Error(t, dir.Restore("none"))
+	assert.True(t, dir.DeleteSnapshot("s1"))
+	assert.Nil(t, dir.SnapshotByName("s1"))
+}
+
+func TestHistoryCommands(t *testing.T) {
+	var b bytes.Buffer
+	ListOutput = &b
+	defer func() { ListOutput = nil; CurDir = CurRoot }()
+	CurDir, _ = NewDir("root")
+	CurDir.KeepVersions(5)
+	assert.NoError(t, Set("x", tensor.NewFloat64(2)))
+	assert.NoError(t, TakeSnapshot("first"))
+	assert.NoError(t, Set("x", tensor.NewFloat64(3)))
+	assert.NoError(t, History())
+	assert.Contains(t, b.String(), "first\t")
+	assert.Contains(t, b.String(), "\tModified x\n")
+	b.Reset()
+	assert.NoError(t, History("x"))
+	assert.Contains(t, b.String(), "0\t")
+	assert.NoError(t, RestoreSnapshot("first"))
+	assert.Equal(t, 2, Get("x").Len())
+}
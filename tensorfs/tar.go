@@ -45,7 +45,7 @@ func AllFiles(dir string, exclude ...string) ([]string, error) {
 
 // Tar writes a tar file to given writer, from given source directory,
 // using given include function to select nodes to include (all if nil).
-// If gz is true, then tar is gzipped.
+// Link nodes are not included. If gz is true, then tar is gzipped.
 // The tensor data is written using the [tensor.ToBinary] format, so the
 // files are effectively opaque binary files.
 func Tar(w io.Writer, dir *Node, gz bool, include func(nd *Node) bool) error {
@@ -62,15 +62,15 @@ func Tar(w io.Writer, dir *Node, gz bool, include func(nd *Node) bool) error {
 
 func tarWrite(w *tar.Writer, dir *Node, parPath string, include func(nd *Node) bool) error {
 	var errs []error
-	for _, it := range dir.nodes.Values {
-		if include != nil && !include(it) {
+	for _, it := range dir.nodeList() {
+		if it.IsLink() || (include != nil && !include(it)) {
 			continue
 		}
 		if it.IsDir() {
 			tarWrite(w, it, path.Join(parPath, it.name), include)
 			continue
 		}
-		vtsr := it.Tensor.AsValues()
+		vtsr := it.TensorValue().AsValues()
 		b := tensor.ToBinary(vtsr)
 		fname := path.Join(parPath, it.name)
 		now := time.Now()
@@ -151,14 +151,16 @@ func Untar(r io.Reader, dir *Node, gz bool) error {
 				dr = path.Dir(fname)
 				pdir = dir.Dir(dr)
 			}
-			nd, err := newNode(pdir, fn)
-			if err != nil && err != fs.ErrExist {
+			tsr := tensor.FromBinary(b)
+			nd, err := newNode(pdir, fn, func(nd *Node) { nd.Tensor = tsr })
+			if err == fs.ErrExist {
+				nd.setTensor(tsr)
+			} else if err != nil {
 				if allErr := addErr(err); allErr != nil {
 					return allErr
 				}
 				continue
 			}
-			nd.Tensor = tensor.FromBinary(b)
 		}
 	}
 	return errors.Join(errs...)
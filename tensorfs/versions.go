@@ -0,0 +1,132 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"slices"
+	"time"
+
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/tensor"
+)
+
+// Version is a previous version of the value of a [Node],
+// as kept according to [Node.KeepVersions].
+type Version struct {
+	// Time is when this version of the value was set.
+	Time time.Time
+
+	// Tensor is the value.
+	Tensor tensor.Tensor
+}
+
+// KeepVersions sets the number of previous versions of the value of this
+// node to keep when it is replaced with a different tensor, e.g., by [Set]
+// or [Node.Set], which are available from [Node.Versions]. For a directory,
+// it applies to all values within it that do not have their own setting.
+// The default of 0 uses the setting of the parent directory, and a negative
+// number keeps no versions. Replacing the value makes a new version, and
+// so does writing a value after it has been updated with [Node.SendUpdate],
+// which copies it when it is next obtained for writing by [Value] or the
+// other value creation functions (e.g., [Node.Float64]). Use [SetCopy]
+// for tensors that will be modified by other means after being set.
+func (nd *Node) KeepVersions(n int) {
+	nd.mu.Lock()
+	nd.keepVersions = n
+	nd.mu.Unlock()
+}
+
+// Versions returns the previous versions of the value of this node,
+// oldest first, as kept according to [Node.KeepVersions].
+// The current value is not included.
+func (nd *Node) Versions() []Version {
+	nd.mu.RLock()
+	defer nd.mu.RUnlock()
+	return slices.Clone(nd.versions)
+}
+
+// versionsToKeep returns the number of versions to keep for this node,
+// based on [Node.KeepVersions] for it and its parents.
+func (nd *Node) versionsToKeep() int {
+	for cur := nd; cur != nil; {
+		cur.mu.RLock()
+		n, parent := cur.keepVersions, cur.Parent
+		cur.mu.RUnlock()
+		if n != 0 {
+			return max(n, 0)
+		}
+		cur = parent
+	}
+	return 0
+}
+
+// keepVersion marks the current Tensor of this node to be kept as a
+// version when it is next written, as of the current time.
+func (nd *Node) keepVersion() {
+	nd.mu.Lock()
+	if nd.Tensor != nil {
+		nd.shared = true
+		nd.valueTime = time.Now()
+	}
+	nd.mu.Unlock()
+}
+
+// share marks the current Tensor of this node as shared with a
+// [Snapshot], and returns it.
+func (nd *Node) share() tensor.Tensor {
+	nd.mu.Lock()
+	defer nd.mu.Unlock()
+	if nd.Tensor != nil {
+		nd.shared = true
+	}
+	return nd.Tensor
+}
+
+// writable returns the Tensor of this node for writing: if it is shared
+// with a [Snapshot] or is to be kept as a version, it is first replaced
+// with a copy that has the same metadata, adding the shared tensor to the
+// versions according to [Node.KeepVersions], and an [EventReplaced] event
+// is sent.
+func (nd *Node) writable() tensor.Tensor {
+	nd = nd.resolve()
+	keep := nd.versionsToKeep()
+	nd.mu.Lock()
+	if !nd.shared || nd.Tensor == nil {
+		tsr := nd.Tensor
+		nd.mu.Unlock()
+		return tsr
+	}
+	tsr := tensor.Clone(nd.Tensor)
+	metadata.Copy(tsr, nd.Tensor)
+	nd.addVersion(keep)
+	nd.Tensor = tsr
+	nd.shared = false
+	nd.revision.Add(1)
+	nd.mu.Unlock()
+	nd.send(EventReplaced)
+	return tsr
+}
+
+// addVersion adds the current Tensor to the versions, keeping up to
+// given number of versions, prior to it being replaced.
+// It must be called with mu locked.
+func (nd *Node) addVersion(keep int) {
+	tm := nd.valueTime
+	if tm.IsZero() {
+		tm = nd.modTime
+	}
+	nd.valueTime = time.Now()
+	if keep == 0 {
+		nd.versions = nil
+		return
+	}
+	if nd.Tensor == nil {
+		return
+	}
+	nd.versions = append(nd.versions, Version{Time: tm, Tensor: nd.Tensor})
+	if n := len(nd.versions); n > keep {
+		nd.versions = slices.Delete(nd.versions, 0, n-keep)
+	}
+}
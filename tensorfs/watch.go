@@ -0,0 +1,211 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"cogentcore.org/lab/tensor"
+)
+
+// EventTypes are the types of changes to a [Node] that are sent
+// as an [Event] to the functions registered with [Node.Watch].
+type EventTypes int32 //enums:enum -trim-prefix Event
+
+const (
+	// EventAdded is sent when a node is added to a directory.
+	EventAdded EventTypes = iota
+
+	// EventRemoved is sent when a node is removed from a directory.
+	EventRemoved
+
+	// EventReplaced is sent when the Tensor of an existing node
+	// is replaced with a different one, e.g., by [Node.Set].
+	EventReplaced
+
+	// EventUpdated is sent by [Node.SendUpdate] to signal that
+	// the values of the Tensor of a node have been updated.
+	EventUpdated
+)
+
+// Event is a change to a [Node], which is sent to the functions
+// registered with [Node.Watch] on the node and all of its parent
+// directories.
+type Event struct {
+	// Type is the type of change.
+	Type EventTypes
+
+	// Node is the node that changed.
+	Node *Node
+
+	// run is the computation by [Node.CalcAll] that made the change,
+	// for changes to the computed values.
+	run *calcRun
+}
+
+// watchID is the source of unique ids for [Node.Watch] functions.
+var watchID atomic.Uint64
+
+// Watch registers the given function to be called for each [Event] on
+// this node, and any node within it if it is a directory, returning a
+// function that cancels the registration. Events are sent synchronously
+// in the goroutine that made the change, after it has been made, except
+// that events for changes to nodes in a tree while computed values in that
+// tree are being computed (see [Node.Calc]) are sent after the computation
+// is done, by the goroutine that ran it. The function must be safe for
+// concurrent use and return quickly: use [Throttle] for potentially
+// expensive updates such as GUI refreshes.
+func (nd *Node) Watch(fun func(ev Event)) (cancel func()) {
+	id := watchID.Add(1)
+	nd.mu.Lock()
+	if nd.watchers == nil {
+		nd.watchers = map[uint64]func(ev Event){}
+	}
+	nd.watchers[id] = fun
+	nd.mu.Unlock()
+	return func() {
+		nd.mu.Lock()
+		delete(nd.watchers, id)
+		nd.mu.Unlock()
+	}
+}
+
+// SendUpdate sends an [EventUpdated] event for this node to the
+// [Node.Watch] functions, to signal that the values of its Tensor
+// have been updated, for example after adding a new row of data,
+// and increments its [Node.Revision]. If versions are kept according
+// to [Node.KeepVersions], the updated value becomes a new version when
+// it is next written.
+func (nd *Node) SendUpdate() {
+	t := nd.target()
+	t.revision.Add(1)
+	if t.versionsToKeep() > 0 {
+		t.keepVersion()
+	}
+	nd.send(EventUpdated)
+}
+
+// send sends an event of given type for this node, to the watch
+// functions on the node and all of its parents. Events sent while
+// computed values in the same tree are being computed are queued
+// until it is done.
+func (nd *Node) send(typ EventTypes) {
+	nd.sendEvent(Event{Type: typ, Node: nd})
+}
+
+// sendEvent sends given event for this node, as in [Node.send].
+func (nd *Node) sendEvent(ev Event) {
+	if queueEvent(ev) {
+		return
+	}
+	for cur := nd; cur != nil; cur = cur.ParentDir() {
+		cur.mu.RLock()
+		if len(cur.watchers) == 0 {
+			cur.mu.RUnlock()
+			continue
+		}
+		funs := make([]func(ev Event), 0, len(cur.watchers))
+		for _, fun := range cur.watchers {
+			funs = append(funs, fun)
+		}
+		cur.mu.RUnlock()
+		for _, fun := range funs {
+			fun(ev)
+		}
+	}
+}
+
+// Throttle returns a function for use with [Node.Watch] that calls the
+// given function in a separate goroutine, once per given interval after
+// the first of any number of events within that interval. This is useful
+// for updating a GUI display in response to frequent changes.
+func Throttle(interval time.Duration, fun func()) func(ev Event) {
+	var pending atomic.Bool
+	return func(ev Event) {
+		if !pending.CompareAndSwap(false, true) {
+			return
+		}
+		go func() {
+			time.Sleep(interval)
+			pending.Store(false)
+			fun()
+		}()
+	}
+}
+
+// ParentDir returns the Parent of this node, protected against
+// concurrent changes by [Node.Add] and [Node.Delete]. Use this instead
+// of the Parent field when the tree may be changed by other goroutines.
+func (nd *Node) ParentDir() *Node {
+	nd.mu.RLock()
+	defer nd.mu.RUnlock()
+	return nd.Parent
+}
+
+// nodeAt returns the node with given name in this directory,
+// which must be a directory, and whether it was found.
+func (dir *Node) nodeAt(name string) (*Node, bool) {
+	dir = dir.resolve()
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	return dir.nodes.AtTry(name)
+}
+
+// nodeList returns a copy of the list of nodes in this directory,
+// which must be a directory, in directory order.
+func (dir *Node) nodeList() []*Node {
+	dir = dir.resolve()
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	return slices.Clone(dir.nodes.Values)
+}
+
+// nodeNames returns a copy of the names of the nodes in this
+// directory, which must be a directory, in directory order.
+func (dir *Node) nodeNames() []string {
+	dir = dir.resolve()
+	dir.mu.RLock()
+	defer dir.mu.RUnlock()
+	return slices.Clone(dir.nodes.Keys)
+}
+
+// TensorValue returns the Tensor of this node, following links and
+// loading the value from a mounted filesystem if needed, protected
+// against concurrent replacement of the value. Use this instead of the
+// Tensor field, which is nil for links and for mounted values that have
+// not yet been loaded. It returns nil for directories.
+func (nd *Node) TensorValue() tensor.Tensor {
+	nd = nd.resolve()
+	nd.mu.RLock()
+	defer nd.mu.RUnlock()
+	return nd.Tensor
+}
+
+// setTensor sets the Tensor of this node, sending an [EventReplaced]
+// event if it is different from the current one.
+func (nd *Node) setTensor(tsr tensor.Tensor) {
+	nd.replaceTensor(tsr, false)
+}
+
+// replaceTensor sets the Tensor of this node as in [Node.setTensor],
+// marking it as shared with a [Snapshot] if shared is true.
+func (nd *Node) replaceTensor(tsr tensor.Tensor, shared bool) {
+	nd = nd.resolve()
+	keep := nd.versionsToKeep()
+	nd.mu.Lock()
+	if nd.Tensor == tsr {
+		nd.shared = nd.shared || shared
+		nd.mu.Unlock()
+		return
+	}
+	nd.addVersion(keep)
+	nd.Tensor = tsr
+	nd.shared = shared
+	nd.revision.Add(1)
+	nd.mu.Unlock()
+	nd.send(EventReplaced)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cogentcore.org/lab/tensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatch(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	stats := dir.Dir("Stats")
+
+	var mu sync.Mutex
+	var evs []string
+	cancel := dir.Watch(func(ev Event) {
+		mu.Lock()
+		evs = append(evs, ev.Type.String()+" "+ev.Node.Path())
+		mu.Unlock()
+	})
+	var nstats int
+	stats.Watch(func(ev Event) { nstats++ })
+
+	x := stats.Float64("X", 3)
+	stats.Float64("X", 3) // existing: no event
+	stats.Set("X", tensor.NewFloat64(4))
+	stats.Set("X", stats.Value("X"))
+	stats.Node("X").SendUpdate()
+	dir.Dir("Other").Int("Y")
+	assert.NoError(t, stats.Delete("X"))
+	assert.Error(t, stats.Delete("X"))
+	assert.Nil(t, stats.Node("X"))
+	assert.Equal(t, 3, x.Len())
+
+	assert.Equal(t, []string{"Added root/Stats/X", "Replaced root/Stats/X", "Updated root/Stats/X", "Added root/Other", "Added root/Other/Y", "Removed root/Stats/X"}, evs)
+	assert.Equal(t, 4, nstats)
+
+	cancel()
+	stats.Float64("Z")
+	assert.Equal(t, 6, len(evs))
+	assert.Equal(t, 5, nstats)
+}
+
+func TestConcurrent(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	var nadd atomic.Int64
+	dir.Watch(func(ev Event) {
+		if ev.Type == EventAdded {
+			nadd.Add(1)
+		}
+	})
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				sd := dir.Dir(fmt.Sprintf("Run%d", i%4))
+				sd.Float64(fmt.Sprintf("Val%d", i%10), 1)
+				sd.Set(fmt.Sprintf("G%d", g), tensor.NewIntScalar(i))
+				dir.ValuesFunc(nil)
+				dir.ReadDir(".")
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 4, len(dir.nodeList()))
+	assert.Equal(t, 5+8, len(dir.Dir("Run0").nodeList()))
+	assert.Equal(t, int64(4+4*13), nadd.Load())
+}
+
+func TestConcurrentDelete(t *testing.T) {
+	// run with -race: sending updates while deleting must not race
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	sd := dir.Dir("Sub")
+	dir.Watch(func(ev Event) {})
+	for range 50 {
+		sd.Float64("x", 1)
+		nd := sd.Node("x")
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				nd.SendUpdate()
+				nd.Path()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, sd.Delete("x"))
+		}()
+		wg.Wait()
+		assert.Nil(t, nd.Parent)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	dir, err := NewDir("root")
+	assert.NoError(t, err)
+	var n atomic.Int64
+	dir.Watch(Throttle(20*time.Millisecond, func() { n.Add(1) }))
+	for i := range 100 {
+		dir.Float64(fmt.Sprintf("X%d", i))
+	}
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int64(1), n.Load())
+}
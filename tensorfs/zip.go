@@ -0,0 +1,109 @@
+// Copyright (c) 2026, Cogent Core. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensorfs
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"cogentcore.org/core/base/errors"
+	"cogentcore.org/core/base/metadata"
+	"cogentcore.org/lab/tensor"
+)
+
+// Zip writes a zip file to given writer, from given source directory,
+// using given include function to select nodes to include (all if nil).
+// Link nodes are not included. Each value is written as a NumPy .npy
+// file using [tensor.WriteNPY], with a .npy extension added to its path,
+// so the zip file is also a NumPy .npz file that can be read by numpy.load,
+// with the paths of the values as the keys. It can be read using [Unzip],
+// or mounted using [Node.Mount] with a [zip.Reader].
+func Zip(w io.Writer, dir *Node, include func(nd *Node) bool) error {
+	zw := zip.NewWriter(w)
+	err := zipWrite(zw, dir, "", include)
+	return errors.Join(err, zw.Close())
+}
+
+func zipWrite(w *zip.Writer, dir *Node, parPath string, include func(nd *Node) bool) error {
+	var errs []error
+	for _, it := range dir.nodeList() {
+		if it.IsLink() || (include != nil && !include(it)) {
+			continue
+		}
+		fname := path.Join(parPath, it.name)
+		if it.IsDir() {
+			if _, err := w.CreateHeader(&zip.FileHeader{Name: fname + "/", Modified: it.ModTime()}); err != nil {
+				errs = append(errs, err)
+				break
+			}
+			errs = append(errs, zipWrite(w, it, fname, include))
+			continue
+		}
+		tsr := it.TensorValue()
+		if tsr == nil {
+			continue
+		}
+		fw, err := w.CreateHeader(&zip.FileHeader{Name: fname + ".npy", Method: zip.Deflate, Modified: it.ModTime()})
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		errs = append(errs, tensor.WriteNPY(fw, tsr))
+	}
+	return errors.Join(errs...)
+}
+
+// Unzip extracts a zip file from given reader with given size, into given
+// directory node, reading each .npy file as a value named without the
+// extension, as written by [Zip]. Other files are skipped.
+// Existing values of the same name are replaced.
+func Unzip(r io.ReaderAt, size int64, dir *Node) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, f := range zr.File {
+		fname := strings.TrimSuffix(f.Name, "/")
+		if f.FileInfo().IsDir() {
+			dir.Dir(fname)
+			continue
+		}
+		if !strings.EqualFold(path.Ext(fname), ".npy") {
+			continue
+		}
+		tsr, err := unzipNPY(f)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		dr, fn := path.Split(strings.TrimSuffix(fname, path.Ext(fname)))
+		pdir := dir
+		if dr != "" {
+			pdir = dir.Dir(path.Clean(dr))
+		}
+		metadata.SetName(tsr, fn)
+		nd, err := newNode(pdir, fn, func(nd *Node) { nd.Tensor = tsr })
+		if err == fs.ErrExist {
+			nd.setTensor(tsr)
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// unzipNPY reads the .npy value in given zip file.
+func unzipNPY(f *zip.File) (tensor.Values, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return tensor.ReadNPY(rc)
+}
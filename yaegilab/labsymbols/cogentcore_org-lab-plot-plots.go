@@ -15,6 +15,7 @@ func init() {
 		"BarType":        reflect.ValueOf(constant.MakeFromLiteral("\"Bar\"", token.STRING, 0)),
 		"LabelsType":     reflect.ValueOf(constant.MakeFromLiteral("\"Labels\"", token.STRING, 0)),
 		"NewBar":         reflect.ValueOf(plots.NewBar),
+		"NewFunction":    reflect.ValueOf(plots.NewFunction),
 		"NewLabels":      reflect.ValueOf(plots.NewLabels),
 		"NewLine":        reflect.ValueOf(plots.NewLine),
 		"NewPointLine":   reflect.ValueOf(plots.NewPointLine),
@@ -28,6 +29,7 @@ func init() {
 
 		// type definitions
 		"Bar":        reflect.ValueOf((*plots.Bar)(nil)),
+		"Function":   reflect.ValueOf((*plots.Function)(nil)),
 		"Labels":     reflect.ValueOf((*plots.Labels)(nil)),
 		"XErrorBars": reflect.ValueOf((*plots.XErrorBars)(nil)),
 		"XY":         reflect.ValueOf((*plots.XY)(nil)),
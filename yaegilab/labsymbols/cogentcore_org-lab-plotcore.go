@@ -14,6 +14,7 @@ func init() {
 		"NewPlot":           reflect.ValueOf(plotcore.NewPlot),
 		"NewPlotterChooser": reflect.ValueOf(plotcore.NewPlotterChooser),
 		"NewSubPlot":        reflect.ValueOf(plotcore.NewSubPlot),
+		"WatchInterval":     reflect.ValueOf(&plotcore.WatchInterval).Elem(),
 
 		// type definitions
 		"Editor":         reflect.ValueOf((*plotcore.Editor)(nil)),
@@ -13,8 +13,10 @@ func init() {
 	Symbols["cogentcore.org/lab/tensorcore/tensorcore"] = map[string]reflect.Value{
 		// function, constant and variable definitions
 		"AddGridStylerTo":    reflect.ValueOf(tensorcore.AddGridStylerTo),
+		"DiffStyler":         reflect.ValueOf(tensorcore.DiffStyler),
 		"GetGridStylersFrom": reflect.ValueOf(tensorcore.GetGridStylersFrom),
 		"LabelSpace":         reflect.ValueOf(constant.MakeFromLiteral("8", token.INT, 0)),
+		"NewDiffTables":      reflect.ValueOf(tensorcore.NewDiffTables),
 		"NewGridStyle":       reflect.ValueOf(tensorcore.NewGridStyle),
 		"NewTable":           reflect.ValueOf(tensorcore.NewTable),
 		"NewTableButton":     reflect.ValueOf(tensorcore.NewTableButton),
@@ -25,6 +27,7 @@ func init() {
 		"SetGridStylersTo":   reflect.ValueOf(tensorcore.SetGridStylersTo),
 
 		// type definitions
+		"DiffTables":   reflect.ValueOf((*tensorcore.DiffTables)(nil)),
 		"GridStyle":    reflect.ValueOf((*tensorcore.GridStyle)(nil)),
 		"GridStylers":  reflect.ValueOf((*tensorcore.GridStylers)(nil)),
 		"Layout":       reflect.ValueOf((*tensorcore.Layout)(nil)),
@@ -0,0 +1,96 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/base/randx'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/base/randx"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/base/randx/randx"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Beta":             reflect.ValueOf(randx.Beta),
+		"BetaGen":          reflect.ValueOf(randx.BetaGen),
+		"Binomial":         reflect.ValueOf(randx.Binomial),
+		"BinomialGen":      reflect.ValueOf(randx.BinomialGen),
+		"BoolP":            reflect.ValueOf(randx.BoolP),
+		"BoolP32":          reflect.ValueOf(randx.BoolP32),
+		"Gamma":            reflect.ValueOf(randx.Gamma),
+		"GammaGen":         reflect.ValueOf(randx.GammaGen),
+		"Gaussian":         reflect.ValueOf(randx.Gaussian),
+		"GaussianGen":      reflect.ValueOf(randx.GaussianGen),
+		"InitSysRand":      reflect.ValueOf(randx.InitSysRand),
+		"IntMeanRange":     reflect.ValueOf(randx.IntMeanRange),
+		"IntMinMax":        reflect.ValueOf(randx.IntMinMax),
+		"IntZeroN":         reflect.ValueOf(randx.IntZeroN),
+		"Mean":             reflect.ValueOf(randx.Mean),
+		"NewGlobalRand":    reflect.ValueOf(randx.NewGlobalRand),
+		"NewSysRand":       reflect.ValueOf(randx.NewSysRand),
+		"PChoose32":        reflect.ValueOf(randx.PChoose32),
+		"PChoose64":        reflect.ValueOf(randx.PChoose64),
+		"PermuteFloat32s":  reflect.ValueOf(randx.PermuteFloat32s),
+		"PermuteFloat64s":  reflect.ValueOf(randx.PermuteFloat64s),
+		"PermuteInts":      reflect.ValueOf(randx.PermuteInts),
+		"PermuteStrings":   reflect.ValueOf(randx.PermuteStrings),
+		"Poisson":          reflect.ValueOf(randx.Poisson),
+		"PoissonGen":       reflect.ValueOf(randx.PoissonGen),
+		"RandDistsN":       reflect.ValueOf(randx.RandDistsN),
+		"RandDistsValues":  reflect.ValueOf(randx.RandDistsValues),
+		"SequentialInts":   reflect.ValueOf(randx.SequentialInts),
+		"Uniform":          reflect.ValueOf(randx.Uniform),
+		"UniformMeanRange": reflect.ValueOf(randx.UniformMeanRange),
+		"UniformMinMax":    reflect.ValueOf(randx.UniformMinMax),
+		"ZeroOne":          reflect.ValueOf(randx.ZeroOne),
+
+		// type definitions
+		"Rand":       reflect.ValueOf((*randx.Rand)(nil)),
+		"RandDists":  reflect.ValueOf((*randx.RandDists)(nil)),
+		"RandParams": reflect.ValueOf((*randx.RandParams)(nil)),
+		"Seeds":      reflect.ValueOf((*randx.Seeds)(nil)),
+		"SysRand":    reflect.ValueOf((*randx.SysRand)(nil)),
+
+		// interface wrapper definitions
+		"_Rand": reflect.ValueOf((*_cogentcore_org_lab_base_randx_Rand)(nil)),
+	}
+}
+
+// _cogentcore_org_lab_base_randx_Rand is an interface wrapper for Rand type
+type _cogentcore_org_lab_base_randx_Rand struct {
+	IValue       interface{}
+	WExpFloat64  func() float64
+	WFloat32     func() float32
+	WFloat64     func() float64
+	WInit        func(seed int64)
+	WInt         func() int
+	WInt31       func() int32
+	WInt31n      func(n int32) int32
+	WInt63       func() int64
+	WInt63n      func(n int64) int64
+	WIntn        func(n int) int
+	WNormFloat64 func() float64
+	WPerm        func(n int) []int
+	WSeed        func(seed int64)
+	WShuffle     func(n int, swap func(i int, j int))
+	WUint32      func() uint32
+	WUint64      func() uint64
+}
+
+func (W _cogentcore_org_lab_base_randx_Rand) ExpFloat64() float64  { return W.WExpFloat64() }
+func (W _cogentcore_org_lab_base_randx_Rand) Float32() float32     { return W.WFloat32() }
+func (W _cogentcore_org_lab_base_randx_Rand) Float64() float64     { return W.WFloat64() }
+func (W _cogentcore_org_lab_base_randx_Rand) Init(seed int64)      { W.WInit(seed) }
+func (W _cogentcore_org_lab_base_randx_Rand) Int() int             { return W.WInt() }
+func (W _cogentcore_org_lab_base_randx_Rand) Int31() int32         { return W.WInt31() }
+func (W _cogentcore_org_lab_base_randx_Rand) Int31n(n int32) int32 { return W.WInt31n(n) }
+func (W _cogentcore_org_lab_base_randx_Rand) Int63() int64         { return W.WInt63() }
+func (W _cogentcore_org_lab_base_randx_Rand) Int63n(n int64) int64 { return W.WInt63n(n) }
+func (W _cogentcore_org_lab_base_randx_Rand) Intn(n int) int       { return W.WIntn(n) }
+func (W _cogentcore_org_lab_base_randx_Rand) NormFloat64() float64 { return W.WNormFloat64() }
+func (W _cogentcore_org_lab_base_randx_Rand) Perm(n int) []int     { return W.WPerm(n) }
+func (W _cogentcore_org_lab_base_randx_Rand) Seed(seed int64)      { W.WSeed(seed) }
+func (W _cogentcore_org_lab_base_randx_Rand) Shuffle(n int, swap func(i int, j int)) {
+	W.WShuffle(n, swap)
+}
+func (W _cogentcore_org_lab_base_randx_Rand) Uint32() uint32 { return W.WUint32() }
+func (W _cogentcore_org_lab_base_randx_Rand) Uint64() uint64 { return W.WUint64() }
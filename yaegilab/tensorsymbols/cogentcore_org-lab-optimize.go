@@ -0,0 +1,32 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/optimize'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/optimize"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/optimize/optimize"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"BFGS":              reflect.ValueOf(optimize.BFGS),
+		"CMAES":             reflect.ValueOf(optimize.CMAES),
+		"CoordinateDescent": reflect.ValueOf(optimize.CoordinateDescent),
+		"LBFGS":             reflect.ValueOf(optimize.LBFGS),
+		"MethodN":           reflect.ValueOf(optimize.MethodN),
+		"MethodValues":      reflect.ValueOf(optimize.MethodValues),
+		"Minimize":          reflect.ValueOf(optimize.Minimize),
+		"NelderMead":        reflect.ValueOf(optimize.NelderMead),
+		"NewSettings":       reflect.ValueOf(optimize.NewSettings),
+		"TableLogger":       reflect.ValueOf(optimize.TableLogger),
+
+		// type definitions
+		"Func":     reflect.ValueOf((*optimize.Func)(nil)),
+		"GradFunc": reflect.ValueOf((*optimize.GradFunc)(nil)),
+		"Method":   reflect.ValueOf((*optimize.Method)(nil)),
+		"Problem":  reflect.ValueOf((*optimize.Problem)(nil)),
+		"Result":   reflect.ValueOf((*optimize.Result)(nil)),
+		"Settings": reflect.ValueOf((*optimize.Settings)(nil)),
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/stats/dist'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/base/randx"
+	"cogentcore.org/lab/stats/dist"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/stats/dist/dist"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"BetaCDF":             reflect.ValueOf(dist.BetaCDF),
+		"BetaLogPDF":          reflect.ValueOf(dist.BetaLogPDF),
+		"BetaPDF":             reflect.ValueOf(dist.BetaPDF),
+		"BetaQuantile":        reflect.ValueOf(dist.BetaQuantile),
+		"BinomialCDF":         reflect.ValueOf(dist.BinomialCDF),
+		"BinomialLogPDF":      reflect.ValueOf(dist.BinomialLogPDF),
+		"BinomialPDF":         reflect.ValueOf(dist.BinomialPDF),
+		"BinomialQuantile":    reflect.ValueOf(dist.BinomialQuantile),
+		"ChiSquaredCDF":       reflect.ValueOf(dist.ChiSquaredCDF),
+		"ChiSquaredLogPDF":    reflect.ValueOf(dist.ChiSquaredLogPDF),
+		"ChiSquaredPDF":       reflect.ValueOf(dist.ChiSquaredPDF),
+		"ChiSquaredQuantile":  reflect.ValueOf(dist.ChiSquaredQuantile),
+		"ExponentialCDF":      reflect.ValueOf(dist.ExponentialCDF),
+		"ExponentialLogPDF":   reflect.ValueOf(dist.ExponentialLogPDF),
+		"ExponentialPDF":      reflect.ValueOf(dist.ExponentialPDF),
+		"ExponentialQuantile": reflect.ValueOf(dist.ExponentialQuantile),
+		"FCDF":                reflect.ValueOf(dist.FCDF),
+		"FLogPDF":             reflect.ValueOf(dist.FLogPDF),
+		"FPDF":                reflect.ValueOf(dist.FPDF),
+		"FQuantile":           reflect.ValueOf(dist.FQuantile),
+		"GammaCDF":            reflect.ValueOf(dist.GammaCDF),
+		"GammaLogPDF":         reflect.ValueOf(dist.GammaLogPDF),
+		"GammaPDF":            reflect.ValueOf(dist.GammaPDF),
+		"GammaQuantile":       reflect.ValueOf(dist.GammaQuantile),
+		"NormalCDF":           reflect.ValueOf(dist.NormalCDF),
+		"NormalLogPDF":        reflect.ValueOf(dist.NormalLogPDF),
+		"NormalPDF":           reflect.ValueOf(dist.NormalPDF),
+		"NormalQuantile":      reflect.ValueOf(dist.NormalQuantile),
+		"PoissonCDF":          reflect.ValueOf(dist.PoissonCDF),
+		"PoissonLogPDF":       reflect.ValueOf(dist.PoissonLogPDF),
+		"PoissonPDF":          reflect.ValueOf(dist.PoissonPDF),
+		"PoissonQuantile":     reflect.ValueOf(dist.PoissonQuantile),
+		"StudentTCDF":         reflect.ValueOf(dist.StudentTCDF),
+		"StudentTLogPDF":      reflect.ValueOf(dist.StudentTLogPDF),
+		"StudentTPDF":         reflect.ValueOf(dist.StudentTPDF),
+		"StudentTQuantile":    reflect.ValueOf(dist.StudentTQuantile),
+		"UniformCDF":          reflect.ValueOf(dist.UniformCDF),
+		"UniformLogPDF":       reflect.ValueOf(dist.UniformLogPDF),
+		"UniformPDF":          reflect.ValueOf(dist.UniformPDF),
+		"UniformQuantile":     reflect.ValueOf(dist.UniformQuantile),
+
+		// type definitions
+		"Beta":        reflect.ValueOf((*dist.Beta)(nil)),
+		"Binomial":    reflect.ValueOf((*dist.Binomial)(nil)),
+		"ChiSquared":  reflect.ValueOf((*dist.ChiSquared)(nil)),
+		"Dist":        reflect.ValueOf((*dist.Dist)(nil)),
+		"Exponential": reflect.ValueOf((*dist.Exponential)(nil)),
+		"F":           reflect.ValueOf((*dist.F)(nil)),
+		"Gamma":       reflect.ValueOf((*dist.Gamma)(nil)),
+		"Normal":      reflect.ValueOf((*dist.Normal)(nil)),
+		"Poisson":     reflect.ValueOf((*dist.Poisson)(nil)),
+		"StudentT":    reflect.ValueOf((*dist.StudentT)(nil)),
+		"Uniform":     reflect.ValueOf((*dist.Uniform)(nil)),
+
+		// interface wrapper definitions
+		"_Dist": reflect.ValueOf((*_cogentcore_org_lab_stats_dist_Dist)(nil)),
+	}
+}
+
+// _cogentcore_org_lab_stats_dist_Dist is an interface wrapper for Dist type
+type _cogentcore_org_lab_stats_dist_Dist struct {
+	IValue    interface{}
+	WCDF      func(x float64) float64
+	WLogPDF   func(x float64) float64
+	WMean     func() float64
+	WPDF      func(x float64) float64
+	WQuantile func(p float64) float64
+	WRand     func(rnd randx.Rand) float64
+	WStd      func() float64
+	WSurvival func(x float64) float64
+	WVariance func() float64
+}
+
+func (W _cogentcore_org_lab_stats_dist_Dist) CDF(x float64) float64       { return W.WCDF(x) }
+func (W _cogentcore_org_lab_stats_dist_Dist) LogPDF(x float64) float64    { return W.WLogPDF(x) }
+func (W _cogentcore_org_lab_stats_dist_Dist) Mean() float64               { return W.WMean() }
+func (W _cogentcore_org_lab_stats_dist_Dist) PDF(x float64) float64       { return W.WPDF(x) }
+func (W _cogentcore_org_lab_stats_dist_Dist) Quantile(p float64) float64  { return W.WQuantile(p) }
+func (W _cogentcore_org_lab_stats_dist_Dist) Rand(rnd randx.Rand) float64 { return W.WRand(rnd) }
+func (W _cogentcore_org_lab_stats_dist_Dist) Std() float64                { return W.WStd() }
+func (W _cogentcore_org_lab_stats_dist_Dist) Survival(x float64) float64  { return W.WSurvival(x) }
+func (W _cogentcore_org_lab_stats_dist_Dist) Variance() float64           { return W.WVariance() }
@@ -0,0 +1,29 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/stats/fit'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/stats/fit"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/stats/fit/fit"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Curve":       reflect.ValueOf(fit.Curve),
+		"ErrSingular": reflect.ValueOf(&fit.ErrSingular).Elem(),
+		"ErrTooFew":   reflect.ValueOf(&fit.ErrTooFew).Elem(),
+		"Exponential": reflect.ValueOf(&fit.Exponential).Elem(),
+		"Gaussian":    reflect.ValueOf(&fit.Gaussian).Elem(),
+		"Logistic":    reflect.ValueOf(&fit.Logistic).Elem(),
+		"Models":      reflect.ValueOf(&fit.Models).Elem(),
+		"NewOptions":  reflect.ValueOf(fit.NewOptions),
+		"PowerLaw":    reflect.ValueOf(&fit.PowerLaw).Elem(),
+
+		// type definitions
+		"Func":    reflect.ValueOf((*fit.Func)(nil)),
+		"Model":   reflect.ValueOf((*fit.Model)(nil)),
+		"Options": reflect.ValueOf((*fit.Options)(nil)),
+		"Result":  reflect.ValueOf((*fit.Result)(nil)),
+	}
+}
@@ -10,9 +10,28 @@ import (
 func init() {
 	Symbols["cogentcore.org/lab/stats/glm/glm"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"NewGLM": reflect.ValueOf(glm.NewGLM),
+		"Binomial":            reflect.ValueOf(glm.Binomial),
+		"ErrSingular":         reflect.ValueOf(&glm.ErrSingular).Elem(),
+		"ErrTooFew":           reflect.ValueOf(&glm.ErrTooFew).Elem(),
+		"FamilyN":             reflect.ValueOf(glm.FamilyN),
+		"FamilyValues":        reflect.ValueOf(glm.FamilyValues),
+		"Gaussian":            reflect.ValueOf(glm.Gaussian),
+		"NewGLM":              reflect.ValueOf(glm.NewGLM),
+		"NewGeneralizedModel": reflect.ValueOf(glm.NewGeneralizedModel),
+		"NewLinearModel":      reflect.ValueOf(glm.NewLinearModel),
+		"NewMixedModel":       reflect.ValueOf(glm.NewMixedModel),
+		"NewMultinomial":      reflect.ValueOf(glm.NewMultinomial),
+		"ParseFormula":        reflect.ValueOf(glm.ParseFormula),
+		"ParseMixedFormula":   reflect.ValueOf(glm.ParseMixedFormula),
+		"Poisson":             reflect.ValueOf(glm.Poisson),
 
 		// type definitions
-		"GLM": reflect.ValueOf((*glm.GLM)(nil)),
+		"Family":           reflect.ValueOf((*glm.Family)(nil)),
+		"Formula":          reflect.ValueOf((*glm.Formula)(nil)),
+		"GLM":              reflect.ValueOf((*glm.GLM)(nil)),
+		"GeneralizedModel": reflect.ValueOf((*glm.GeneralizedModel)(nil)),
+		"LinearModel":      reflect.ValueOf((*glm.LinearModel)(nil)),
+		"MixedModel":       reflect.ValueOf((*glm.MixedModel)(nil)),
+		"Multinomial":      reflect.ValueOf((*glm.Multinomial)(nil)),
 	}
 }
@@ -0,0 +1,28 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/stats/manifold'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/stats/manifold"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/stats/manifold/manifold"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ClassicalMDS":   reflect.ValueOf(manifold.ClassicalMDS),
+		"ErrDims":        reflect.ValueOf(&manifold.ErrDims).Elem(),
+		"ErrShape":       reflect.ValueOf(&manifold.ErrShape).Elem(),
+		"ErrTooFew":      reflect.ValueOf(&manifold.ErrTooFew).Elem(),
+		"MetricMDS":      reflect.ValueOf(manifold.MetricMDS),
+		"NewTSNEOptions": reflect.ValueOf(manifold.NewTSNEOptions),
+		"NewUMAPOptions": reflect.ValueOf(manifold.NewUMAPOptions),
+		"Plot":           reflect.ValueOf(manifold.Plot),
+		"TSNE":           reflect.ValueOf(manifold.TSNE),
+		"UMAP":           reflect.ValueOf(manifold.UMAP),
+
+		// type definitions
+		"TSNEOptions": reflect.ValueOf((*manifold.TSNEOptions)(nil)),
+		"UMAPOptions": reflect.ValueOf((*manifold.UMAPOptions)(nil)),
+	}
+}
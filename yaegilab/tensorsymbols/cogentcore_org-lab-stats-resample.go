@@ -0,0 +1,23 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/stats/resample'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/stats/resample"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/stats/resample/resample"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Bootstrap":             reflect.ValueOf(resample.Bootstrap),
+		"BootstrapMetric":       reflect.ValueOf(resample.BootstrapMetric),
+		"ErrTooFew":             reflect.ValueOf(&resample.ErrTooFew).Elem(),
+		"PermutationTest":       reflect.ValueOf(resample.PermutationTest),
+		"PermutationTestMetric": reflect.ValueOf(resample.PermutationTestMetric),
+
+		// type definitions
+		"BootstrapResult":   reflect.ValueOf((*resample.BootstrapResult)(nil)),
+		"PermutationResult": reflect.ValueOf((*resample.PermutationResult)(nil)),
+	}
+}
@@ -0,0 +1,32 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/stats/tests'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/stats/tests"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/stats/tests/tests"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ANOVA":         reflect.ValueOf(tests.ANOVA),
+		"ANOVARM":       reflect.ValueOf(tests.ANOVARM),
+		"ChiSquare":     reflect.ValueOf(tests.ChiSquare),
+		"ChiSquareGOF":  reflect.ValueOf(tests.ChiSquareGOF),
+		"ErrMismatch":   reflect.ValueOf(&tests.ErrMismatch).Elem(),
+		"ErrTooFew":     reflect.ValueOf(&tests.ErrTooFew).Elem(),
+		"Groups":        reflect.ValueOf(tests.Groups),
+		"KruskalWallis": reflect.ValueOf(tests.KruskalWallis),
+		"MannWhitney":   reflect.ValueOf(tests.MannWhitney),
+		"TTest":         reflect.ValueOf(tests.TTest),
+		"TTest2":        reflect.ValueOf(tests.TTest2),
+		"TTestPaired":   reflect.ValueOf(tests.TTestPaired),
+		"TableGroups":   reflect.ValueOf(tests.TableGroups),
+		"Welch":         reflect.ValueOf(tests.Welch),
+		"Wilcoxon":      reflect.ValueOf(tests.Wilcoxon),
+
+		// type definitions
+		"Result": reflect.ValueOf((*tests.Result)(nil)),
+	}
+}
@@ -4,34 +4,71 @@ package tensorsymbols
 
 import (
 	"cogentcore.org/lab/table"
+	"go/constant"
+	"go/token"
 	"reflect"
 )
 
 func init() {
 	Symbols["cogentcore.org/lab/table/table"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"CleanCatTSV":            reflect.ValueOf(table.CleanCatTSV),
-		"ConfigFromDataValues":   reflect.ValueOf(table.ConfigFromDataValues),
-		"ConfigFromHeaders":      reflect.ValueOf(table.ConfigFromHeaders),
-		"ConfigFromTableHeaders": reflect.ValueOf(table.ConfigFromTableHeaders),
-		"DetectTableHeaders":     reflect.ValueOf(table.DetectTableHeaders),
-		"ErrLogNoNewRows":        reflect.ValueOf(&table.ErrLogNoNewRows).Elem(),
-		"Headers":                reflect.ValueOf(table.Headers),
-		"InferDataType":          reflect.ValueOf(table.InferDataType),
-		"New":                    reflect.ValueOf(table.New),
-		"NewColumns":             reflect.ValueOf(table.NewColumns),
-		"NewSliceTable":          reflect.ValueOf(table.NewSliceTable),
-		"NewView":                reflect.ValueOf(table.NewView),
-		"NoHeaders":              reflect.ValueOf(table.NoHeaders),
-		"ShapeFromString":        reflect.ValueOf(table.ShapeFromString),
-		"TableColumnType":        reflect.ValueOf(table.TableColumnType),
-		"TableHeaderChar":        reflect.ValueOf(table.TableHeaderChar),
-		"TableHeaderToType":      reflect.ValueOf(&table.TableHeaderToType).Elem(),
-		"UpdateSliceTable":       reflect.ValueOf(table.UpdateSliceTable),
+		"CleanCatTSV":              reflect.ValueOf(table.CleanCatTSV),
+		"ConfigFromDataValues":     reflect.ValueOf(table.ConfigFromDataValues),
+		"ConfigFromHeaders":        reflect.ValueOf(table.ConfigFromHeaders),
+		"ConfigFromTableHeaders":   reflect.ValueOf(table.ConfigFromTableHeaders),
+		"DetectTableHeaders":       reflect.ValueOf(table.DetectTableHeaders),
+		"Diff":                     reflect.ValueOf(table.Diff),
+		"DiffAdded":                reflect.ValueOf(table.DiffAdded),
+		"DiffChanged":              reflect.ValueOf(table.DiffChanged),
+		"DiffKindsN":               reflect.ValueOf(table.DiffKindsN),
+		"DiffKindsValues":          reflect.ValueOf(table.DiffKindsValues),
+		"DiffRemoved":              reflect.ValueOf(table.DiffRemoved),
+		"DiffSchema":               reflect.ValueOf(table.DiffSchema),
+		"ErrLogNoNewRows":          reflect.ValueOf(&table.ErrLogNoNewRows).Elem(),
+		"Except":                   reflect.ValueOf(table.Except),
+		"FillBackward":             reflect.ValueOf(table.FillBackward),
+		"FillForward":              reflect.ValueOf(table.FillForward),
+		"FillMean":                 reflect.ValueOf(table.FillMean),
+		"FillMethodsN":             reflect.ValueOf(table.FillMethodsN),
+		"FillMethodsValues":        reflect.ValueOf(table.FillMethodsValues),
+		"FillValue":                reflect.ValueOf(table.FillValue),
+		"Headers":                  reflect.ValueOf(table.Headers),
+		"InferDataType":            reflect.ValueOf(table.InferDataType),
+		"InterpolateLinear":        reflect.ValueOf(table.InterpolateLinear),
+		"InterpolateMethodsN":      reflect.ValueOf(table.InterpolateMethodsN),
+		"InterpolateMethodsValues": reflect.ValueOf(table.InterpolateMethodsValues),
+		"InterpolateNearest":       reflect.ValueOf(table.InterpolateNearest),
+		"Intersect":                reflect.ValueOf(table.Intersect),
+		"KindFromString":           reflect.ValueOf(table.KindFromString),
+		"New":                      reflect.ValueOf(table.New),
+		"NewColumns":               reflect.ValueOf(table.NewColumns),
+		"NewSliceTable":            reflect.ValueOf(table.NewSliceTable),
+		"NewView":                  reflect.ValueOf(table.NewView),
+		"NoHeaders":                reflect.ValueOf(table.NoHeaders),
+		"OpenSchema":               reflect.ValueOf(table.OpenSchema),
+		"OpenSchemaFS":             reflect.ValueOf(table.OpenSchemaFS),
+		"SchemaCoerce":             reflect.ValueOf(table.SchemaCoerce),
+		"SchemaExt":                reflect.ValueOf(constant.MakeFromLiteral("\".schema.json\"", token.STRING, 0)),
+		"SchemaModesN":             reflect.ValueOf(table.SchemaModesN),
+		"SchemaModesValues":        reflect.ValueOf(table.SchemaModesValues),
+		"SchemaValidate":           reflect.ValueOf(table.SchemaValidate),
+		"ShapeFromString":          reflect.ValueOf(table.ShapeFromString),
+		"TableColumnType":          reflect.ValueOf(table.TableColumnType),
+		"TableHeaderChar":          reflect.ValueOf(table.TableHeaderChar),
+		"TableHeaderToType":        reflect.ValueOf(&table.TableHeaderToType).Elem(),
+		"Union":                    reflect.ValueOf(table.Union),
+		"UpdateSliceTable":         reflect.ValueOf(table.UpdateSliceTable),
 
 		// type definitions
-		"Columns":    reflect.ValueOf((*table.Columns)(nil)),
-		"FilterFunc": reflect.ValueOf((*table.FilterFunc)(nil)),
-		"Table":      reflect.ValueOf((*table.Table)(nil)),
+		"ColumnSchema":       reflect.ValueOf((*table.ColumnSchema)(nil)),
+		"Columns":            reflect.ValueOf((*table.Columns)(nil)),
+		"DiffKinds":          reflect.ValueOf((*table.DiffKinds)(nil)),
+		"FillMethods":        reflect.ValueOf((*table.FillMethods)(nil)),
+		"FilterFunc":         reflect.ValueOf((*table.FilterFunc)(nil)),
+		"InterpolateMethods": reflect.ValueOf((*table.InterpolateMethods)(nil)),
+		"Schema":             reflect.ValueOf((*table.Schema)(nil)),
+		"SchemaError":        reflect.ValueOf((*table.SchemaError)(nil)),
+		"SchemaModes":        reflect.ValueOf((*table.SchemaModes)(nil)),
+		"Table":              reflect.ValueOf((*table.Table)(nil)),
 	}
 }
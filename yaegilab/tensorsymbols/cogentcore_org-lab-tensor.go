@@ -56,6 +56,8 @@ func init() {
 		"ContainsFloat":           reflect.ValueOf(tensor.ContainsFloat),
 		"ContainsInt":             reflect.ValueOf(tensor.ContainsInt),
 		"ContainsString":          reflect.ValueOf(tensor.ContainsString),
+		"ContentHash":             reflect.ValueOf(tensor.ContentHash),
+		"ContentHashMetadata":     reflect.ValueOf(tensor.ContentHashMetadata),
 		"CopyFromLargerShape":     reflect.ValueOf(tensor.CopyFromLargerShape),
 		"DefaultNumThreads":       reflect.ValueOf(tensor.DefaultNumThreads),
 		"DelimsN":                 reflect.ValueOf(tensor.DelimsN),
@@ -141,6 +143,7 @@ func init() {
 		"Projection2DValue":       reflect.ValueOf(tensor.Projection2DValue),
 		"Range":                   reflect.ValueOf(tensor.Range),
 		"ReadCSV":                 reflect.ValueOf(tensor.ReadCSV),
+		"ReadNPY":                 reflect.ValueOf(tensor.ReadNPY),
 		"Reshape":                 reflect.ValueOf(tensor.Reshape),
 		"Reslice":                 reflect.ValueOf(tensor.Reslice),
 		"RowMajorStrides":         reflect.ValueOf(tensor.RowMajorStrides),
@@ -154,6 +157,7 @@ func init() {
 		"SetShapeFrom":            reflect.ValueOf(tensor.SetShapeFrom),
 		"SetShapeNames":           reflect.ValueOf(tensor.SetShapeNames),
 		"SetShapeSizesFromTensor": reflect.ValueOf(tensor.SetShapeSizesFromTensor),
+		"SetUnits":                reflect.ValueOf(tensor.SetUnits),
 		"ShapeNames":              reflect.ValueOf(tensor.ShapeNames),
 		"SlicesMagicN":            reflect.ValueOf(tensor.SlicesMagicN),
 		"SlicesMagicValues":       reflect.ValueOf(tensor.SlicesMagicValues),
@@ -170,12 +174,14 @@ func init() {
 		"ThreadingThreshold":      reflect.ValueOf(&tensor.ThreadingThreshold).Elem(),
 		"ToBinary":                reflect.ValueOf(tensor.ToBinary),
 		"Transpose":               reflect.ValueOf(tensor.Transpose),
+		"Units":                   reflect.ValueOf(tensor.Units),
 		"UnstableSort":            reflect.ValueOf(tensor.UnstableSort),
 		"Vectorize":               reflect.ValueOf(tensor.Vectorize),
 		"VectorizeOnThreads":      reflect.ValueOf(tensor.VectorizeOnThreads),
 		"VectorizeThreaded":       reflect.ValueOf(tensor.VectorizeThreaded),
 		"WrapIndex1D":             reflect.ValueOf(tensor.WrapIndex1D),
 		"WriteCSV":                reflect.ValueOf(tensor.WriteCSV),
+		"WriteNPY":                reflect.ValueOf(tensor.WriteNPY),
 
 		// type definitions
 		"Arg":         reflect.ValueOf((*tensor.Arg)(nil)),
@@ -186,6 +192,7 @@ func init() {
 		"Float32":     reflect.ValueOf((*tensor.Float32)(nil)),
 		"Float64":     reflect.ValueOf((*tensor.Float64)(nil)),
 		"Func":        reflect.ValueOf((*tensor.Func)(nil)),
+		"Hash":        reflect.ValueOf((*tensor.Hash)(nil)),
 		"Indexed":     reflect.ValueOf((*tensor.Indexed)(nil)),
 		"Int":         reflect.ValueOf((*tensor.Int)(nil)),
 		"Int32":       reflect.ValueOf((*tensor.Int32)(nil)),
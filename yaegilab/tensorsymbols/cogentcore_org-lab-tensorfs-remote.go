@@ -0,0 +1,23 @@
+// Code generated by 'yaegi extract cogentcore.org/lab/tensorfs/remote'. DO NOT EDIT.
+
+package tensorsymbols
+
+import (
+	"cogentcore.org/lab/tensorfs/remote"
+	"reflect"
+)
+
+func init() {
+	Symbols["cogentcore.org/lab/tensorfs/remote/remote"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ListenAndServe": reflect.ValueOf(remote.ListenAndServe),
+		"NewClient":      reflect.ValueOf(remote.NewClient),
+		"NewServer":      reflect.ValueOf(remote.NewServer),
+
+		// type definitions
+		"Client": reflect.ValueOf((*remote.Client)(nil)),
+		"Event":  reflect.ValueOf((*remote.Event)(nil)),
+		"Info":   reflect.ValueOf((*remote.Info)(nil)),
+		"Server": reflect.ValueOf((*remote.Server)(nil)),
+	}
+}
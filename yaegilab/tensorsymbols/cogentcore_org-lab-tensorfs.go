@@ -4,41 +4,74 @@ package tensorsymbols
 
 import (
 	"cogentcore.org/lab/tensorfs"
+	"go/constant"
+	"go/token"
 	"reflect"
 )
 
 func init() {
 	Symbols["cogentcore.org/lab/tensorfs/tensorfs"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"AllFiles":     reflect.ValueOf(tensorfs.AllFiles),
-		"Chdir":        reflect.ValueOf(tensorfs.Chdir),
-		"CurDir":       reflect.ValueOf(&tensorfs.CurDir).Elem(),
-		"CurRoot":      reflect.ValueOf(&tensorfs.CurRoot).Elem(),
-		"DirFromTable": reflect.ValueOf(tensorfs.DirFromTable),
-		"DirOnly":      reflect.ValueOf(tensorfs.DirOnly),
-		"DirTable":     reflect.ValueOf(tensorfs.DirTable),
-		"Get":          reflect.ValueOf(tensorfs.Get),
-		"List":         reflect.ValueOf(tensorfs.List),
-		"ListOutput":   reflect.ValueOf(&tensorfs.ListOutput).Elem(),
-		"Long":         reflect.ValueOf(tensorfs.Long),
-		"Mkdir":        reflect.ValueOf(tensorfs.Mkdir),
-		"NewDir":       reflect.ValueOf(tensorfs.NewDir),
-		"Overwrite":    reflect.ValueOf(tensorfs.Overwrite),
-		"Preserve":     reflect.ValueOf(tensorfs.Preserve),
-		"Record":       reflect.ValueOf(tensorfs.Record),
-		"Recursive":    reflect.ValueOf(tensorfs.Recursive),
-		"Set":          reflect.ValueOf(tensorfs.Set),
-		"SetCopy":      reflect.ValueOf(tensorfs.SetCopy),
-		"SetTensor":    reflect.ValueOf(tensorfs.SetTensor),
-		"Short":        reflect.ValueOf(tensorfs.Short),
-		"Tar":          reflect.ValueOf(tensorfs.Tar),
-		"Untar":        reflect.ValueOf(tensorfs.Untar),
-		"ValueType":    reflect.ValueOf(tensorfs.ValueType),
+		"AllFiles":          reflect.ValueOf(tensorfs.AllFiles),
+		"ChangeAdded":       reflect.ValueOf(tensorfs.ChangeAdded),
+		"ChangeModified":    reflect.ValueOf(tensorfs.ChangeModified),
+		"ChangeRemoved":     reflect.ValueOf(tensorfs.ChangeRemoved),
+		"ChangeTypesN":      reflect.ValueOf(tensorfs.ChangeTypesN),
+		"ChangeTypesValues": reflect.ValueOf(tensorfs.ChangeTypesValues),
+		"Chdir":             reflect.ValueOf(tensorfs.Chdir),
+		"CurDir":            reflect.ValueOf(&tensorfs.CurDir).Elem(),
+		"CurRoot":           reflect.ValueOf(&tensorfs.CurRoot).Elem(),
+		"DiffSnapshots":     reflect.ValueOf(tensorfs.DiffSnapshots),
+		"DirFromTable":      reflect.ValueOf(tensorfs.DirFromTable),
+		"DirOnly":           reflect.ValueOf(tensorfs.DirOnly),
+		"DirTable":          reflect.ValueOf(tensorfs.DirTable),
+		"DirTableView":      reflect.ValueOf(tensorfs.DirTableView),
+		"EventAdded":        reflect.ValueOf(tensorfs.EventAdded),
+		"EventRemoved":      reflect.ValueOf(tensorfs.EventRemoved),
+		"EventReplaced":     reflect.ValueOf(tensorfs.EventReplaced),
+		"EventTypesN":       reflect.ValueOf(tensorfs.EventTypesN),
+		"EventTypesValues":  reflect.ValueOf(tensorfs.EventTypesValues),
+		"EventUpdated":      reflect.ValueOf(tensorfs.EventUpdated),
+		"FileMagic":         reflect.ValueOf(constant.MakeFromLiteral("\"TENSORFS\"", token.STRING, 0)),
+		"FileVersion":       reflect.ValueOf(constant.MakeFromLiteral("1", token.INT, 0)),
+		"Get":               reflect.ValueOf(tensorfs.Get),
+		"History":           reflect.ValueOf(tensorfs.History),
+		"List":              reflect.ValueOf(tensorfs.List),
+		"ListOutput":        reflect.ValueOf(&tensorfs.ListOutput).Elem(),
+		"Load":              reflect.ValueOf(tensorfs.Load),
+		"Long":              reflect.ValueOf(tensorfs.Long),
+		"Mkdir":             reflect.ValueOf(tensorfs.Mkdir),
+		"NewDir":            reflect.ValueOf(tensorfs.NewDir),
+		"Overwrite":         reflect.ValueOf(tensorfs.Overwrite),
+		"Preserve":          reflect.ValueOf(tensorfs.Preserve),
+		"Record":            reflect.ValueOf(tensorfs.Record),
+		"Recursive":         reflect.ValueOf(tensorfs.Recursive),
+		"RestoreSnapshot":   reflect.ValueOf(tensorfs.RestoreSnapshot),
+		"Save":              reflect.ValueOf(tensorfs.Save),
+		"SaveHDF5":          reflect.ValueOf(tensorfs.SaveHDF5),
+		"Set":               reflect.ValueOf(tensorfs.Set),
+		"SetCopy":           reflect.ValueOf(tensorfs.SetCopy),
+		"SetTensor":         reflect.ValueOf(tensorfs.SetTensor),
+		"Short":             reflect.ValueOf(tensorfs.Short),
+		"TakeSnapshot":      reflect.ValueOf(tensorfs.TakeSnapshot),
+		"Tar":               reflect.ValueOf(tensorfs.Tar),
+		"Throttle":          reflect.ValueOf(tensorfs.Throttle),
+		"Untar":             reflect.ValueOf(tensorfs.Untar),
+		"Unzip":             reflect.ValueOf(tensorfs.Unzip),
+		"ValueType":         reflect.ValueOf(tensorfs.ValueType),
+		"WriteHDF5":         reflect.ValueOf(tensorfs.WriteHDF5),
+		"Zip":               reflect.ValueOf(tensorfs.Zip),
 
 		// type definitions
-		"DirFile": reflect.ValueOf((*tensorfs.DirFile)(nil)),
-		"File":    reflect.ValueOf((*tensorfs.File)(nil)),
-		"Node":    reflect.ValueOf((*tensorfs.Node)(nil)),
-		"Nodes":   reflect.ValueOf((*tensorfs.Nodes)(nil)),
+		"Change":      reflect.ValueOf((*tensorfs.Change)(nil)),
+		"ChangeTypes": reflect.ValueOf((*tensorfs.ChangeTypes)(nil)),
+		"DirFile":     reflect.ValueOf((*tensorfs.DirFile)(nil)),
+		"Event":       reflect.ValueOf((*tensorfs.Event)(nil)),
+		"EventTypes":  reflect.ValueOf((*tensorfs.EventTypes)(nil)),
+		"File":        reflect.ValueOf((*tensorfs.File)(nil)),
+		"Node":        reflect.ValueOf((*tensorfs.Node)(nil)),
+		"Nodes":       reflect.ValueOf((*tensorfs.Nodes)(nil)),
+		"Snapshot":    reflect.ValueOf((*tensorfs.Snapshot)(nil)),
+		"Version":     reflect.ValueOf((*tensorfs.Version)(nil)),
 	}
 }